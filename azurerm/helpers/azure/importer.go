@@ -0,0 +1,26 @@
+package azure
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// IDValidationFunc is a function which validates that the specified Resource ID
+// is for the expected type of Resource
+type IDValidationFunc func(id string) error
+
+// ValidateResourceIDPriorToImport returns an Importer which validates the Resource ID
+// being imported is for the expected type of Resource before passing it through,
+// so that `terraform import` fails early with a clear message when given the wrong ID
+func ValidateResourceIDPriorToImport(idValidator IDValidationFunc) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if err := idValidator(d.Id()); err != nil {
+				return nil, fmt.Errorf("Error parsing Resource ID %q: %+v", d.Id(), err)
+			}
+
+			return schema.ImportStatePassthrough(d, meta)
+		},
+	}
+}
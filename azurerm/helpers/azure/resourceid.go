@@ -3,6 +3,7 @@ package azure
 import (
 	"fmt"
 	"net/url"
+	"sort"
 	"strings"
 )
 
@@ -92,3 +93,51 @@ func ParseAzureResourceID(id string) (*ResourceID, error) {
 
 	return idObj, nil
 }
+
+// PopSegment retrieves a segment from the Path, removing it from the Path
+// and returning the value - or returning an error if it's not present.
+//
+// The key is matched case-sensitively first, falling back to a
+// case-insensitive match since some Azure APIs return IDs using
+// inconsistent casing
+func (id *ResourceID) PopSegment(name string) (string, error) {
+	if val, ok := id.Path[name]; ok {
+		delete(id.Path, name)
+		return val, nil
+	}
+
+	for key, val := range id.Path {
+		if strings.EqualFold(key, name) {
+			delete(id.Path, key)
+			return val, nil
+		}
+	}
+
+	return "", fmt.Errorf("ID was missing the `%s` element", name)
+}
+
+// ValidateProvider ensures the Resource Provider for this ID matches the expected value
+// (case-insensitively), returning an error if it doesn't
+func (id *ResourceID) ValidateProvider(input string, provider string) error {
+	if !strings.EqualFold(id.Provider, provider) {
+		return fmt.Errorf("ID %q was expected to be for the Resource Provider %q but got %q", input, provider, id.Provider)
+	}
+
+	return nil
+}
+
+// ValidateNoEmptySegments ensures that every segment in the Path has been consumed
+// via PopSegment - meaning that the ID isn't for a different (e.g. nested) Resource
+func (id *ResourceID) ValidateNoEmptySegments(sourceId string) error {
+	if len(id.Path) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(id.Path))
+	for k := range id.Path {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return fmt.Errorf("ID %q contained unexpected segments: %s", sourceId, strings.Join(keys, ", "))
+}
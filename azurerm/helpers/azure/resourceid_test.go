@@ -175,3 +175,68 @@ func TestParseAzureResourceID(t *testing.T) {
 		}
 	}
 }
+
+func TestResourceIDPopSegment(t *testing.T) {
+	testCases := []struct {
+		segment     string
+		expected    string
+		expectError bool
+	}{
+		{
+			segment:  "virtualNetworks",
+			expected: "network1",
+		},
+		{
+			// keys are matched case-insensitively
+			segment:  "VIRTUALNETWORKS",
+			expected: "network1",
+		},
+		{
+			segment:     "subnets",
+			expectError: true,
+		},
+	}
+
+	for _, test := range testCases {
+		id, err := ParseAzureResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1")
+		if err != nil {
+			t.Fatalf("Error parsing ID: %+v", err)
+		}
+
+		actual, err := id.PopSegment(test.segment)
+		if err != nil {
+			if test.expectError {
+				continue
+			}
+
+			t.Fatalf("Unexpected error popping segment %q: %+v", test.segment, err)
+		}
+
+		if test.expectError {
+			t.Fatalf("Expected an error popping segment %q but didn't get one", test.segment)
+		}
+
+		if actual != test.expected {
+			t.Fatalf("Expected %q but got %q", test.expected, actual)
+		}
+
+		if err := id.ValidateNoEmptySegments("example"); err != nil {
+			t.Fatalf("Expected no segments to remain but got: %+v", err)
+		}
+	}
+}
+
+func TestResourceIDValidateNoEmptySegments(t *testing.T) {
+	id, err := ParseAzureResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1")
+	if err != nil {
+		t.Fatalf("Error parsing ID: %+v", err)
+	}
+
+	if _, err := id.PopSegment("virtualNetworks"); err != nil {
+		t.Fatalf("Error popping segment: %+v", err)
+	}
+
+	if err := id.ValidateNoEmptySegments("example"); err == nil {
+		t.Fatalf("Expected an error since the `subnets` segment remains but didn't get one")
+	}
+}
@@ -0,0 +1,56 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// EnvironmentName returns the name of the Azure Environment which the Acceptance Tests are running in
+func EnvironmentName() string {
+	envName, exists := os.LookupEnv("ARM_ENVIRONMENT")
+	if !exists {
+		envName = "public"
+	}
+
+	return envName
+}
+
+// Environment returns the Azure Environment which the Acceptance Tests are running in
+func Environment() (*azure.Environment, error) {
+	envName := EnvironmentName()
+	return authentication.DetermineEnvironment(envName)
+}
+
+// GetAuthConfig returns the Authentication Config used to build a Client outside of the Provider,
+// skipping the test if the Acceptance Tests aren't being run
+func GetAuthConfig(t *testing.T) *authentication.Config {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skip(fmt.Sprintf("Integration test skipped unless env '%s' set", resource.TestEnvVar))
+		return nil
+	}
+
+	environment := EnvironmentName()
+
+	builder := authentication.Builder{
+		SubscriptionID: os.Getenv("ARM_SUBSCRIPTION_ID"),
+		ClientID:       os.Getenv("ARM_CLIENT_ID"),
+		TenantID:       os.Getenv("ARM_TENANT_ID"),
+		ClientSecret:   os.Getenv("ARM_CLIENT_SECRET"),
+		Environment:    environment,
+
+		// we intentionally only support Client Secret auth for tests (since those variables are used all over)
+		SupportsClientSecretAuth: true,
+	}
+	config, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Error building ARM Client: %+v", err)
+		return nil
+	}
+
+	return config
+}
@@ -0,0 +1,22 @@
+package acceptance
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azuread/azuread"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm"
+)
+
+// AzureProvider is the instance of the AzureRM Provider used by the Acceptance Tests
+var AzureProvider *schema.Provider
+
+// SupportedProviders contains the Providers which can be used within the Acceptance Tests
+var SupportedProviders map[string]terraform.ResourceProvider
+
+func init() {
+	AzureProvider = azurerm.Provider().(*schema.Provider)
+	SupportedProviders = map[string]terraform.ResourceProvider{
+		"azurerm": AzureProvider,
+		"azuread": azuread.Provider().(*schema.Provider),
+	}
+}
@@ -0,0 +1,50 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+)
+
+// PreCheck ensures that the Environment Variables required to run the Acceptance Tests have been set
+func PreCheck(t *testing.T) {
+	variables := []string{
+		"ARM_CLIENT_ID",
+		"ARM_CLIENT_SECRET",
+		"ARM_SUBSCRIPTION_ID",
+		"ARM_TENANT_ID",
+		"ARM_TEST_LOCATION",
+		"ARM_TEST_LOCATION_ALT",
+		"ARM_TEST_LOCATION_ALT2",
+	}
+
+	for _, variable := range variables {
+		value := os.Getenv(variable)
+		if value == "" {
+			t.Fatalf("`%s` must be set for acceptance tests!", variable)
+		}
+	}
+}
+
+// Location returns the Primary Location which should be used for Acceptance Tests
+func Location() string {
+	return os.Getenv("ARM_TEST_LOCATION")
+}
+
+// AltLocation returns the Secondary Location which should be used for Acceptance Tests
+func AltLocation() string {
+	return os.Getenv("ARM_TEST_LOCATION_ALT")
+}
+
+// AltLocation2 returns the Tertiary Location which should be used for Acceptance Tests
+func AltLocation2() string {
+	return os.Getenv("ARM_TEST_LOCATION_ALT2")
+}
+
+// RequiresImportError returns the error expected when a resource which already exists
+// needs to be imported into the State before it can be managed by Terraform
+func RequiresImportError(resourceName string) *regexp.Regexp {
+	message := "to be managed via Terraform this resource needs to be imported into the State. Please see the resource documentation for %q for more information."
+	return regexp.MustCompile(fmt.Sprintf(message, resourceName))
+}
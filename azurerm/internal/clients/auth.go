@@ -0,0 +1,31 @@
+package clients
+
+import (
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+)
+
+// ResourceManagerAccount contains information about the Account which has been authenticated
+// against Azure Resource Manager, and the Subscription/Tenant being used
+type ResourceManagerAccount struct {
+	AuthenticatedAsAServicePrincipal bool
+	ClientId                         string
+	Environment                      azure.Environment
+	PartnerId                        string
+	SkipResourceProviderRegistration bool
+	SubscriptionId                   string
+	TenantId                         string
+}
+
+// NewResourceManagerAccount returns the Account information for the specified Authentication Config
+func NewResourceManagerAccount(config *authentication.Config, env azure.Environment, partnerId string, skipProviderRegistration bool) *ResourceManagerAccount {
+	return &ResourceManagerAccount{
+		AuthenticatedAsAServicePrincipal: config.AuthenticatedAsAServicePrincipal,
+		ClientId:                         config.ClientID,
+		Environment:                      env,
+		PartnerId:                        partnerId,
+		SkipResourceProviderRegistration: skipProviderRegistration,
+		SubscriptionId:                   config.SubscriptionID,
+		TenantId:                         config.TenantID,
+	}
+}
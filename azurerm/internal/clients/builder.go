@@ -0,0 +1,107 @@
+package clients
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/go-azure-helpers/sender"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/oidc"
+)
+
+// ClientBuilder contains the Provider-level settings used to configure the ARM Client
+type ClientBuilder struct {
+	AuthConfig                  *authentication.Config
+	DisableCorrelationRequestID bool
+	MaxConcurrentRequests       int
+	PartnerId                   string
+	SkipProviderRegistration    bool
+	StorageUseAzureAD           bool
+
+	// OIDC contains the configuration used to authenticate using a federated OpenID Connect token,
+	// when set this is used to obtain Access Tokens rather than the Authentication Config
+	OIDC *oidc.Config
+}
+
+// authorizerProvider returns the Authorizers used to authenticate requests to Azure, which is
+// implemented by both the Authentication Config and the OIDC Config
+type authorizerProvider interface {
+	GetAuthorizationToken(sender autorest.Sender, oauth *authentication.OAuthConfig, endpoint string) (autorest.Authorizer, error)
+	BearerAuthorizerCallback(sender autorest.Sender, oauth *authentication.OAuthConfig) *autorest.BearerAuthorizerCallback
+}
+
+// Build is a helper method which returns a fully instantiated
+// *Client based on the Builder's current settings.
+func Build(builder ClientBuilder) (*Client, error) {
+	authConfig := builder.AuthConfig
+	env, err := authentication.DetermineEnvironment(authConfig.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	// client declarations:
+	client := Client{
+		Account: NewResourceManagerAccount(authConfig, *env, builder.PartnerId, builder.SkipProviderRegistration),
+	}
+
+	var authorizers authorizerProvider = authConfig
+	if builder.OIDC != nil {
+		authorizers = *builder.OIDC
+	}
+
+	oauthConfig, err := authConfig.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// OAuthConfigForTenant returns a pointer, which can be nil.
+	if oauthConfig == nil {
+		return nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s", authConfig.TenantID)
+	}
+
+	sender := sender.BuildSender("AzureRM")
+
+	// Resource Manager endpoints
+	endpoint := env.ResourceManagerEndpoint
+	auth, err := authorizers.GetAuthorizationToken(sender, oauthConfig, env.TokenAudience)
+	if err != nil {
+		return nil, err
+	}
+
+	// Graph Endpoints
+	graphEndpoint := env.GraphEndpoint
+	graphAuth, err := authorizers.GetAuthorizationToken(sender, oauthConfig, graphEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// Storage Endpoints
+	storageAuth := authorizers.BearerAuthorizerCallback(sender, oauthConfig)
+
+	// Key Vault Endpoints
+	keyVaultAuth := authorizers.BearerAuthorizerCallback(sender, oauthConfig)
+
+	o := &common.ClientOptions{
+		SubscriptionId:              authConfig.SubscriptionID,
+		TenantID:                    authConfig.TenantID,
+		PartnerId:                   builder.PartnerId,
+		GraphAuthorizer:             graphAuth,
+		GraphEndpoint:               graphEndpoint,
+		KeyVaultAuthorizer:          keyVaultAuth,
+		ResourceManagerAuthorizer:   auth,
+		ResourceManagerEndpoint:     endpoint,
+		StorageAuthorizer:           storageAuth,
+		StorageUseAzureAD:           builder.StorageUseAzureAD,
+		PollingDuration:             180 * time.Minute,
+		RequestLimiter:              common.NewRequestLimiter(builder.MaxConcurrentRequests),
+		SkipProviderReg:             builder.SkipProviderRegistration,
+		DisableCorrelationRequestID: builder.DisableCorrelationRequestID,
+		Environment:                 *env,
+	}
+
+	client.Build(o)
+
+	return &client, nil
+}
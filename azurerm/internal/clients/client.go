@@ -2,11 +2,202 @@ package clients
 
 import (
 	"context"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	analysisServices "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/analysisservices/client"
+	apiManagement "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimanagement/client"
+	applicationInsights "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/applicationinsights/client"
+	authorization "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/authorization/client"
+	automation "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/automation/client"
+	batch "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/batch/client"
+	bot "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/bot/client"
+	cdn "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cdn/client"
+	cognitive "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cognitive/client"
+	compute "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/client"
+	containerServices "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers/client"
+	cosmosdb "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/client"
+	databricks "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/databricks/client"
+	datafactory "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datafactory/client"
+	datalake "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datalake/client"
+	devspace "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/devspace/client"
+	devtestlabs "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/devtestlabs/client"
+	dns "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/dns/client"
+	eventgrid "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventgrid/client"
+	eventhub "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventhub/client"
+	frontdoor "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/frontdoor/client"
+	graph "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/graph/client"
+	hdinsight "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/hdinsight/client"
+	iothub "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/iothub/client"
+	keyvault "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/client"
+	kusto "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/kusto/client"
+	loganalytics "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/client"
+	logic "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/logic/client"
+	managementgroup "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managementgroup/client"
+	maps "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/maps/client"
+	mariadb "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mariadb/client"
+	media "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/media/client"
+	monitor "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/client"
+	msi "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/client"
+	mssql "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/client"
+	mysql "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mysql/client"
+	network "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/client"
+	notificationhub "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/notificationhub/client"
+	policy "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy/client"
+	portal "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/portal/client"
+	postgres "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/postgres/client"
+	privatedns "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/privatedns/client"
+	recoveryServices "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/recoveryservices/client"
+	redis "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/redis/client"
+	relay "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/relay/client"
+	resource "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/client"
+	scheduler "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/scheduler/client"
+	search "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/search/client"
+	securityCenter "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/securitycenter/client"
+	serviceBus "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/client"
+	serviceFabric "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicefabric/client"
+	signalr "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/signalr/client"
+	sql "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sql/client"
+	storage "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/client"
+	streamAnalytics "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/streamanalytics/client"
+	subscription "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/subscription/client"
+	trafficManager "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/trafficmanager/client"
+	web "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/client"
 )
 
+// Client contains the handles to all of the Azure Resource Manager SDK Clients used within the Provider
 type Client struct {
 	// StopContext is used for propagating control from Terraform Core (e.g. Ctrl/Cmd+C)
 	StopContext context.Context
 
-	Compute ComputeClient
+	// Account contains information about the authenticated Account, Subscription and Tenant
+	Account *ResourceManagerAccount
+
+	// Features contains the behaviours which have been opted into via the `features` block in the Provider
+	Features features.UserFeatures
+
+	// DefaultTags contains the Tags defined in the `default_tags` block in the Provider, which are
+	// merged into the Tags defined on each resource
+	DefaultTags map[string]interface{}
+
+	AnalysisServices *analysisServices.Client
+	ApiManagement    *apiManagement.Client
+	AppInsights      *applicationInsights.Client
+	Automation       *automation.Client
+	Authorization    *authorization.Client
+	Batch            *batch.Client
+	Bot              *bot.Client
+	Cdn              *cdn.Client
+	Cognitive        *cognitive.Client
+	Compute          *compute.Client
+	Containers       *containerServices.Client
+	Cosmos           *cosmosdb.Client
+	DataBricks       *databricks.Client
+	DataFactory      *datafactory.Client
+	Datalake         *datalake.Client
+	DevSpace         *devspace.Client
+	DevTestLabs      *devtestlabs.Client
+	Dns              *dns.Client
+	PrivateDns       *privatedns.Client
+	EventGrid        *eventgrid.Client
+	Eventhub         *eventhub.Client
+	Frontdoor        *frontdoor.Client
+	Graph            *graph.Client
+	HDInsight        *hdinsight.Client
+	IoTHub           *iothub.Client
+	KeyVault         *keyvault.Client
+	Kusto            *kusto.Client
+	LogAnalytics     *loganalytics.Client
+	Logic            *logic.Client
+	ManagementGroups *managementgroup.Client
+	Maps             *maps.Client
+	MariaDB          *mariadb.Client
+	Media            *media.Client
+	Monitor          *monitor.Client
+	MySQL            *mysql.Client
+	Msi              *msi.Client
+	MSSQL            *mssql.Client
+	Network          *network.Client
+	NotificationHubs *notificationhub.Client
+	Policy           *policy.Client
+	Portal           *portal.Client
+	Postgres         *postgres.Client
+	RecoveryServices *recoveryServices.Client
+	Redis            *redis.Client
+	Relay            *relay.Client
+	Resource         *resource.Client
+	Scheduler        *scheduler.Client
+	Search           *search.Client
+	SecurityCenter   *securityCenter.Client
+	ServiceBus       *serviceBus.Client
+	ServiceFabric    *serviceFabric.Client
+	SignalR          *signalr.Client
+	Storage          *storage.Client
+	StreamAnalytics  *streamAnalytics.Client
+	Subscription     *subscription.Client
+	Sql              *sql.Client
+	TrafficManager   *trafficManager.Client
+	Web              *web.Client
+}
+
+// Build configures each of the Service Clients using the specified Client Options
+func (client *Client) Build(o *common.ClientOptions) {
+	client.AnalysisServices = analysisServices.BuildClient(o)
+	client.ApiManagement = apiManagement.BuildClient(o)
+	client.AppInsights = applicationInsights.BuildClient(o)
+	client.Automation = automation.BuildClient(o)
+	client.Authorization = authorization.BuildClient(o)
+	client.Batch = batch.BuildClient(o)
+	client.Bot = bot.BuildClient(o)
+	client.Cdn = cdn.BuildClient(o)
+	client.Cognitive = cognitive.BuildClient(o)
+	client.Compute = compute.BuildClient(o)
+	client.Containers = containerServices.BuildClient(o)
+	client.Cosmos = cosmosdb.BuildClient(o)
+	client.DataBricks = databricks.BuildClient(o)
+	client.DataFactory = datafactory.BuildClient(o)
+	client.Datalake = datalake.BuildClient(o)
+	client.DevSpace = devspace.BuildClient(o)
+	client.DevTestLabs = devtestlabs.BuildClient(o)
+	client.Dns = dns.BuildClient(o)
+	client.PrivateDns = privatedns.BuildClient(o)
+	client.EventGrid = eventgrid.BuildClient(o)
+	client.Eventhub = eventhub.BuildClient(o)
+	client.Frontdoor = frontdoor.BuildClient(o)
+	client.Graph = graph.BuildClient(o)
+	client.HDInsight = hdinsight.BuildClient(o)
+	client.IoTHub = iothub.BuildClient(o)
+	client.KeyVault = keyvault.BuildClient(o)
+	client.Kusto = kusto.BuildClient(o)
+	client.LogAnalytics = loganalytics.BuildClient(o)
+	client.Logic = logic.BuildClient(o)
+	client.ManagementGroups = managementgroup.BuildClient(o)
+	client.Maps = maps.BuildClient(o)
+	client.MariaDB = mariadb.BuildClient(o)
+	client.Media = media.BuildClient(o)
+	client.Monitor = monitor.BuildClient(o)
+	client.MySQL = mysql.BuildClient(o)
+	client.Msi = msi.BuildClient(o)
+	client.MSSQL = mssql.BuildClient(o)
+	client.Network = network.BuildClient(o)
+	client.NotificationHubs = notificationhub.BuildClient(o)
+	client.Policy = policy.BuildClient(o)
+	client.Portal = portal.BuildClient(o)
+	client.Postgres = postgres.BuildClient(o)
+	client.RecoveryServices = recoveryServices.BuildClient(o)
+	client.Redis = redis.BuildClient(o)
+	client.Relay = relay.BuildClient(o)
+	client.Resource = resource.BuildClient(o)
+	client.Scheduler = scheduler.BuildClient(o)
+	client.Search = search.BuildClient(o)
+	client.SecurityCenter = securityCenter.BuildClient(o)
+	client.ServiceBus = serviceBus.BuildClient(o)
+	client.ServiceFabric = serviceFabric.BuildClient(o)
+	client.SignalR = signalr.BuildClient(o)
+	client.Storage = storage.BuildClient(o)
+	client.StreamAnalytics = streamAnalytics.BuildClient(o)
+	client.Subscription = subscription.BuildClient(o)
+	client.Sql = sql.BuildClient(o)
+	client.TrafficManager = trafficManager.BuildClient(o)
+	client.Web = web.BuildClient(o)
 }
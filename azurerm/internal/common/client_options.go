@@ -25,8 +25,10 @@ type ClientOptions struct {
 	ResourceManagerAuthorizer autorest.Authorizer
 	ResourceManagerEndpoint   string
 	StorageAuthorizer         autorest.Authorizer
+	StorageUseAzureAD         bool
 
 	PollingDuration             time.Duration
+	RequestLimiter              *RequestLimiter
 	SkipProviderReg             bool
 	DisableCorrelationRequestID bool
 	Environment                 azure.Environment
@@ -38,7 +40,7 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	}
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	c.Sender = buildSender(o.RequestLimiter)
 	c.PollingDuration = o.PollingDuration
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
@@ -63,3 +65,15 @@ func setUserAgent(client *autorest.Client, partnerID string) {
 
 	log.Printf("[DEBUG] AzureRM Client User Agent: %s\n", client.UserAgent)
 }
+
+// buildSender returns the Sender used for requests to Azure, which logs each request, retries requests
+// which have been throttled and (optionally) limits the number of concurrent requests. Throttled requests
+// are only retried here, rather than also by the Azure SDK (see disableSDKThrottlingRetries)
+func buildSender(limiter *RequestLimiter) autorest.Sender {
+	disableSDKThrottlingRetries()
+
+	return autorest.DecorateSender(sender.BuildSender("AzureRM"),
+		WithConcurrencyLimit(limiter),
+		WithThrottlingRetries(DefaultThrottlingOptions()),
+	)
+}
@@ -0,0 +1,221 @@
+package common
+
+import (
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// HeaderRetryAfter is the header returned by Azure specifying how long to wait before retrying a throttled request
+	HeaderRetryAfter = "Retry-After"
+
+	// headerRateLimitRemainingPrefix is the prefix of the headers returned by Azure Resource Manager which
+	// specify how many requests remain before requests within this scope are throttled,
+	// e.g. `x-ms-ratelimit-remaining-subscription-reads`
+	headerRateLimitRemainingPrefix = "X-Ms-Ratelimit-Remaining-"
+
+	// rateLimitWarningThreshold is the number of remaining requests below which a warning is logged
+	rateLimitWarningThreshold = 25
+)
+
+var disableSDKThrottlingRetriesOnce sync.Once
+
+// ThrottlingOptions configures how requests which have been throttled by Azure are retried
+type ThrottlingOptions struct {
+	// MaxAttempts is the maximum number of times a request will be sent, including the first attempt
+	MaxAttempts int
+
+	// MinBackoff is the delay used for the first retry when no `Retry-After` header is returned
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between retries when no `Retry-After` header is returned
+	MaxBackoff time.Duration
+}
+
+// DefaultThrottlingOptions returns the ThrottlingOptions used for requests to Azure
+func DefaultThrottlingOptions() ThrottlingOptions {
+	return ThrottlingOptions{
+		MaxAttempts: 10,
+		MinBackoff:  5 * time.Second,
+		MaxBackoff:  2 * time.Minute,
+	}
+}
+
+// RequestLimiter restricts the number of requests to Azure which can be in-flight at once
+type RequestLimiter struct {
+	slots chan struct{}
+}
+
+// NewRequestLimiter returns a RequestLimiter which allows up to `maxConcurrentRequests` requests
+// to be in-flight at once - or nil (meaning requests aren't limited) when this is zero
+func NewRequestLimiter(maxConcurrentRequests int) *RequestLimiter {
+	if maxConcurrentRequests <= 0 {
+		return nil
+	}
+
+	return &RequestLimiter{
+		slots: make(chan struct{}, maxConcurrentRequests),
+	}
+}
+
+// WithConcurrencyLimit returns a SendDecorator which waits for a slot in the specified RequestLimiter
+// to become available before sending the request - when the RequestLimiter is nil requests are sent as-is
+func WithConcurrencyLimit(limiter *RequestLimiter) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		if limiter == nil {
+			return s
+		}
+
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			select {
+			case limiter.slots <- struct{}{}:
+			case <-r.Context().Done():
+				return nil, r.Context().Err()
+			}
+			defer func() {
+				<-limiter.slots
+			}()
+
+			return s.Do(r)
+		})
+	}
+}
+
+// WithThrottlingRetries returns a SendDecorator which retries requests which have been throttled
+// by Azure (returning a 429) - waiting for the duration specified in the `Retry-After` header
+// when it's present, or otherwise backing off exponentially (with jitter) between attempts
+func WithThrottlingRetries(options ThrottlingOptions) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+
+			var resp *http.Response
+			var err error
+			for attempt := 0; ; attempt++ {
+				if err = rr.Prepare(); err != nil {
+					return resp, err
+				}
+
+				resp, err = s.Do(rr.Request())
+				if err != nil || resp == nil {
+					return resp, err
+				}
+
+				logRemainingRateLimits(resp)
+
+				if resp.StatusCode != http.StatusTooManyRequests || attempt+1 >= options.MaxAttempts {
+					return resp, err
+				}
+
+				delay, ok := retryAfter(resp, time.Now())
+				if !ok {
+					delay = exponentialBackoff(attempt, options.MinBackoff, options.MaxBackoff)
+				}
+				log.Printf("[DEBUG] Request to %s was throttled (attempt %d of %d) - retrying in %s", r.URL, attempt+1, options.MaxAttempts, delay)
+
+				// the body of the throttled response needs to be drained so the connection can be re-used
+				if closeErr := autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing()); closeErr != nil {
+					log.Printf("[DEBUG] Error closing the body of the throttled response: %+v", closeErr)
+				}
+
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return resp, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
+// disableSDKThrottlingRetries removes 429's from the status codes which the Azure SDK retries. The SDK's clients send
+// each request via `azure.DoRetryWithRegistration` and `autorest.DoRetryForStatusCodes`, which retry throttled requests
+// until the context is cancelled (without counting these as an attempt) - which would otherwise multiply the retries
+// made by WithThrottlingRetries. Since the SDK reads these from a package-level variable this applies to all clients,
+// as such retrying throttled requests is owned by WithThrottlingRetries (which is part of every client's Sender).
+func disableSDKThrottlingRetries() {
+	disableSDKThrottlingRetriesOnce.Do(func() {
+		codes := make([]int, 0, len(autorest.StatusCodesForRetry))
+		for _, code := range autorest.StatusCodesForRetry {
+			if code != http.StatusTooManyRequests {
+				codes = append(codes, code)
+			}
+		}
+
+		autorest.StatusCodesForRetry = codes
+	})
+}
+
+// retryAfter parses the `Retry-After` header from the specified response, which can either
+// be a number of seconds or a HTTP Date - returning false if this can't be determined
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	value := strings.TrimSpace(resp.Header.Get(HeaderRetryAfter))
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := date.Sub(now)
+		if delay < 0 {
+			delay = 0
+		}
+
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// exponentialBackoff returns the delay before the next retry, doubling the minimum delay for
+// each attempt (up to the maximum) and applying jitter so that concurrent requests are spread out
+func exponentialBackoff(attempt int, minDelay, maxDelay time.Duration) time.Duration {
+	delay := minDelay
+	for i := 0; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	// use "equal jitter" - half of the delay is fixed, the other half is random
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// logRemainingRateLimits logs a warning when Azure Resource Manager reports that the number of
+// requests remaining before requests are throttled is running low
+func logRemainingRateLimits(resp *http.Response) {
+	for name, values := range resp.Header {
+		if !strings.HasPrefix(http.CanonicalHeaderKey(name), headerRateLimitRemainingPrefix) || len(values) == 0 {
+			continue
+		}
+
+		remaining, err := strconv.Atoi(values[0])
+		if err != nil {
+			continue
+		}
+
+		if remaining < rateLimitWarningThreshold {
+			log.Printf("[WARN] Azure reports only %d requests remain for %q before requests are throttled", remaining, strings.ToLower(name))
+		}
+	}
+}
@@ -0,0 +1,358 @@
+package common
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func testThrottlingOptions() ThrottlingOptions {
+	return ThrottlingOptions{
+		MaxAttempts: 3,
+		MinBackoff:  10 * time.Millisecond,
+		MaxBackoff:  40 * time.Millisecond,
+	}
+}
+
+func TestWithThrottlingRetries_RetryAfter(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set(HeaderRetryAfter, "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sender := autorest.DecorateSender(server.Client(), WithThrottlingRetries(testThrottlingOptions()))
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+
+	start := time.Now()
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected a 200 but got %d", resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(&attempts); actual != 2 {
+		t.Fatalf("Expected 2 attempts but got %d", actual)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("Expected the `Retry-After` header to be honoured but the request completed in %s", elapsed)
+	}
+}
+
+func TestWithThrottlingRetries_ExponentialBackoff(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sender := autorest.DecorateSender(server.Client(), WithThrottlingRetries(testThrottlingOptions()))
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected a 200 but got %d", resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(&attempts); actual != 3 {
+		t.Fatalf("Expected 3 attempts but got %d", actual)
+	}
+}
+
+func TestWithThrottlingRetries_MaxAttempts(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	sender := autorest.DecorateSender(server.Client(), WithThrottlingRetries(testThrottlingOptions()))
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("Expected a 429 but got %d", resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(&attempts); actual != 3 {
+		t.Fatalf("Expected 3 attempts but got %d", actual)
+	}
+}
+
+func TestWithThrottlingRetries_NotRetriedBySDK(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set(HeaderRetryAfter, "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	disableSDKThrottlingRetries()
+	for _, code := range autorest.StatusCodesForRetry {
+		if code == http.StatusTooManyRequests {
+			t.Fatalf("Expected 429's to be removed from the status codes retried by the Azure SDK")
+		}
+	}
+
+	// the SDK's clients send requests via `autorest.DoRetryForStatusCodes`, which would otherwise retry the
+	// throttled response returned once WithThrottlingRetries has given up, multiplying the number of attempts
+	sender := autorest.DecorateSender(server.Client(), WithThrottlingRetries(testThrottlingOptions()))
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+
+	resp, err := autorest.SendWithSender(sender, req, autorest.DoRetryForStatusCodes(3, 0, autorest.StatusCodesForRetry...))
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("Expected a 429 but got %d", resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(&attempts); actual != 3 {
+		t.Fatalf("Expected 3 attempts but got %d", actual)
+	}
+}
+
+func TestWithThrottlingRetries_NotThrottled(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	sender := autorest.DecorateSender(server.Client(), WithThrottlingRetries(testThrottlingOptions()))
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("Expected a 500 but got %d", resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(&attempts); actual != 1 {
+		t.Fatalf("Expected 1 attempt but got %d", actual)
+	}
+}
+
+func TestWithThrottlingRetries_ReplaysBody(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "hello" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sender := autorest.DecorateSender(server.Client(), WithThrottlingRetries(testThrottlingOptions()))
+	req, _ := http.NewRequest(http.MethodPut, server.URL, strings.NewReader("hello"))
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected a 200 but got %d", resp.StatusCode)
+	}
+}
+
+func TestWithThrottlingRetries_Cancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(HeaderRetryAfter, "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	sender := autorest.DecorateSender(server.Client(), WithThrottlingRetries(testThrottlingOptions()))
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	req = req.WithContext(ctx)
+
+	start := time.Now()
+	if _, err := sender.Do(req); err == nil {
+		t.Fatalf("Expected an error when the context was cancelled but didn't get one")
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("Expected the retry to be cancelled but it took %s", elapsed)
+	}
+}
+
+func TestWithConcurrencyLimit(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			existing := atomic.LoadInt32(&maxInFlight)
+			if current <= existing || atomic.CompareAndSwapInt32(&maxInFlight, existing, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sender := autorest.DecorateSender(server.Client(), WithConcurrencyLimit(NewRequestLimiter(2)))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+			resp, err := sender.Do(req)
+			if err != nil {
+				t.Errorf("Expected no error but got: %+v", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if actual := atomic.LoadInt32(&maxInFlight); actual > 2 {
+		t.Fatalf("Expected at most 2 concurrent requests but got %d", actual)
+	}
+}
+
+func TestNewRequestLimiter_Unlimited(t *testing.T) {
+	if limiter := NewRequestLimiter(0); limiter != nil {
+		t.Fatalf("Expected no limiter when the maximum is 0 but got %+v", limiter)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{
+			value: "",
+			ok:    false,
+		},
+		{
+			value:    "17",
+			expected: 17 * time.Second,
+			ok:       true,
+		},
+		{
+			value: "-5",
+			ok:    false,
+		},
+		{
+			value:    now.Add(30 * time.Second).Format(http.TimeFormat),
+			expected: 30 * time.Second,
+			ok:       true,
+		},
+		{
+			value:    now.Add(-30 * time.Second).Format(http.TimeFormat),
+			expected: 0,
+			ok:       true,
+		},
+		{
+			value: "soon",
+			ok:    false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.value)
+
+		resp := &http.Response{
+			Header: http.Header{},
+		}
+		if v.value != "" {
+			resp.Header.Set(HeaderRetryAfter, v.value)
+		}
+
+		actual, ok := retryAfter(resp, now)
+		if ok != v.ok {
+			t.Fatalf("Expected ok to be %t but got %t", v.ok, ok)
+		}
+		if actual != v.expected {
+			t.Fatalf("Expected %s but got %s", v.expected, actual)
+		}
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	minDelay := 1 * time.Second
+	maxDelay := 8 * time.Second
+
+	testData := []struct {
+		attempt int
+		upper   time.Duration
+	}{
+		{attempt: 0, upper: 1 * time.Second},
+		{attempt: 1, upper: 2 * time.Second},
+		{attempt: 2, upper: 4 * time.Second},
+		{attempt: 3, upper: 8 * time.Second},
+		{attempt: 10, upper: 8 * time.Second},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing attempt %d..", v.attempt)
+
+		for i := 0; i < 50; i++ {
+			actual := exponentialBackoff(v.attempt, minDelay, maxDelay)
+			if actual < v.upper/2 || actual > v.upper {
+				t.Fatalf("Expected a delay between %s and %s but got %s", v.upper/2, v.upper, actual)
+			}
+		}
+	}
+}
@@ -6,7 +6,7 @@ import (
 )
 
 // ShouldResourcesBeImported returns whether the feature Requiring Resources to be Imported
-// should be enabled.
+// should be enabled, based on the `ARM_PROVIDER_STRICT` Environment Variable.
 //
 // This feature prevents Terraform from 'adopting' resources which already exist, which is the
 // behaviour used by ARM Templates which will update these resources rather than overwriting them
@@ -18,7 +18,11 @@ import (
 // for v2.0 of the Azure Provider: https://github.com/terraform-providers/terraform-provider-azurerm/issues/2807
 //
 // Operators wishing to adopt this behaviour can opt-into this behaviour in 1.x versions of the
-// Azure Provider by setting the Environment Variable 'ARM_PROVIDER_STRICT' to 'true'
+// Azure Provider by setting `require_import` to `true` within the `resources` block of the `features`
+// block - which resources should check via `meta.(*clients.Client).Features.Resources.RequireImport`.
+//
+// The Environment Variable is deprecated and is only used as the default value for `require_import`
+// when it isn't specified within the Provider block.
 func ShouldResourcesBeImported() bool {
 	// NOTE: we'll need to add an infobox to the following resources when this goes live:
 	// * App Service Source Control Token
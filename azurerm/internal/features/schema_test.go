@@ -0,0 +1,177 @@
+package features
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestExpandFeatures(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    []interface{}
+		expected UserFeatures
+	}{
+		{
+			name:     "Empty Block",
+			input:    []interface{}{},
+			expected: Default(),
+		},
+		{
+			name: "Empty Nested Blocks",
+			input: []interface{}{
+				map[string]interface{}{
+					"key_vault":                 []interface{}{},
+					"resources":                 []interface{}{},
+					"virtual_machine":           []interface{}{},
+					"virtual_machine_scale_set": []interface{}{},
+				},
+			},
+			expected: Default(),
+		},
+		{
+			name: "Complete Enabled",
+			input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": true,
+						},
+					},
+					"resources": []interface{}{
+						map[string]interface{}{
+							"require_import": true,
+						},
+					},
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion": true,
+						},
+					},
+					"virtual_machine_scale_set": []interface{}{
+						map[string]interface{}{
+							"roll_instances_when_required": true,
+						},
+					},
+				},
+			},
+			expected: UserFeatures{
+				KeyVault: KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+				},
+				Resources: ResourcesFeatures{
+					RequireImport: true,
+				},
+				VirtualMachine: VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: true,
+				},
+				VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
+					RollInstancesWhenRequired: true,
+				},
+			},
+		},
+		{
+			name: "Partially Enabled",
+			input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": true,
+						},
+					},
+				},
+			},
+			expected: UserFeatures{
+				KeyVault: KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+				},
+				Resources: ResourcesFeatures{
+					RequireImport: false,
+				},
+				VirtualMachine: VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: false,
+				},
+				VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
+					RollInstancesWhenRequired: false,
+				},
+			},
+		},
+	}
+
+	os.Unsetenv("ARM_PROVIDER_STRICT")
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q..", v.name)
+
+		actual := Expand(v.input)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("Expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestExpandFeaturesRequireImportFallback(t *testing.T) {
+	testData := []struct {
+		name        string
+		environment string
+		input       []interface{}
+		expected    bool
+	}{
+		{
+			name:        "Not Specified",
+			environment: "true",
+			input:       []interface{}{},
+			expected:    true,
+		},
+		{
+			name:        "Not Specified in the Features Block",
+			environment: "true",
+			input: []interface{}{
+				map[string]interface{}{
+					"resources": []interface{}{},
+				},
+			},
+			expected: true,
+		},
+		{
+			name:        "Disabled in the Features Block",
+			environment: "true",
+			input: []interface{}{
+				map[string]interface{}{
+					"resources": []interface{}{
+						map[string]interface{}{
+							"require_import": false,
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name:        "Enabled in the Features Block",
+			environment: "",
+			input: []interface{}{
+				map[string]interface{}{
+					"resources": []interface{}{
+						map[string]interface{}{
+							"require_import": true,
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+	}
+
+	defer os.Unsetenv("ARM_PROVIDER_STRICT")
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q..", v.name)
+
+		os.Setenv("ARM_PROVIDER_STRICT", v.environment)
+		actual := Expand(v.input)
+		if actual.Resources.RequireImport != v.expected {
+			t.Fatalf("Expected %t but got %t", v.expected, actual.Resources.RequireImport)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package features
+
+// UserFeatures contains the behaviours which can be opted into by users
+// via the `features` block within the Provider configuration
+type UserFeatures struct {
+	KeyVault               KeyVaultFeatures
+	Resources              ResourcesFeatures
+	VirtualMachine         VirtualMachineFeatures
+	VirtualMachineScaleSet VirtualMachineScaleSetFeatures
+}
+
+type KeyVaultFeatures struct {
+	// PurgeSoftDeleteOnDestroy specifies whether a Key Vault which has Soft Delete enabled
+	// should be purged when it's destroyed, freeing up the name for re-use
+	PurgeSoftDeleteOnDestroy bool
+}
+
+type ResourcesFeatures struct {
+	// RequireImport specifies whether resources which already exist must be imported into the
+	// Terraform State, rather than being adopted when they're created
+	RequireImport bool
+}
+
+type VirtualMachineFeatures struct {
+	// DeleteOSDiskOnDeletion specifies whether the OS Disk attached to a Virtual Machine
+	// should be deleted when the Virtual Machine is destroyed
+	DeleteOSDiskOnDeletion bool
+}
+
+type VirtualMachineScaleSetFeatures struct {
+	// RollInstancesWhenRequired specifies whether the instances within a Virtual Machine Scale Set
+	// using the `Manual` Upgrade Policy should be updated to the latest model when it changes
+	RollInstancesWhenRequired bool
+}
+
+// Default returns the default set of features, used when the `features` block isn't specified
+func Default() UserFeatures {
+	return UserFeatures{
+		KeyVault: KeyVaultFeatures{
+			PurgeSoftDeleteOnDestroy: false,
+		},
+		Resources: ResourcesFeatures{
+			// the `ARM_PROVIDER_STRICT` Environment Variable is deprecated, but used as the default
+			// until the `require_import` field within the `resources` block is specified
+			RequireImport: ShouldResourcesBeImported(),
+		},
+		VirtualMachine: VirtualMachineFeatures{
+			DeleteOSDiskOnDeletion: false,
+		},
+		VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
+			RollInstancesWhenRequired: false,
+		},
+	}
+}
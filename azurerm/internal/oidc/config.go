@@ -0,0 +1,142 @@
+package oidc
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Config contains the information required to authenticate as a Service Principal
+// by exchanging a federated OpenID Connect token (a JWT issued by e.g. a CI system)
+// for Access Tokens for Azure Active Directory
+type Config struct {
+	ClientID       string
+	Environment    string
+	SubscriptionID string
+	TenantID       string
+
+	// Token is the federated OIDC token which should be exchanged for Access Tokens
+	Token string
+
+	// TokenFilePath is the path to a file containing the federated OIDC token - this file
+	// is read each time an Access Token is requested, since these tokens are short-lived
+	// and can be rotated whilst Terraform is running
+	TokenFilePath string
+}
+
+// Validate ensures that the information required to authenticate using OIDC has been specified
+func (c Config) Validate() error {
+	var err *multierror.Error
+
+	fmtErrorMessage := "A %s must be configured when authenticating as a Service Principal using OpenID Connect."
+
+	if c.SubscriptionID == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Subscription ID"))
+	}
+	if c.ClientID == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Client ID"))
+	}
+	if c.TenantID == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Tenant ID"))
+	}
+	if c.Token == "" && c.TokenFilePath == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "OIDC Token or OIDC Token File Path"))
+	}
+	if c.Token != "" && c.TokenFilePath != "" {
+		err = multierror.Append(err, fmt.Errorf("Only one of the OIDC Token or OIDC Token File Path can be specified"))
+	}
+
+	return err.ErrorOrNil()
+}
+
+// AuthConfig returns the Authentication Config for this Service Principal, which
+// contains the information about the Account/Tenant being authenticated against
+func (c Config) AuthConfig() *authentication.Config {
+	return &authentication.Config{
+		ClientID:                         c.ClientID,
+		Environment:                      c.Environment,
+		SubscriptionID:                   c.SubscriptionID,
+		TenantID:                         c.TenantID,
+		AuthenticatedAsAServicePrincipal: true,
+	}
+}
+
+// GetAuthorizationToken returns an Authorizer which exchanges the federated OIDC token
+// for an Access Token for the specified endpoint, refreshing it as necessary
+func (c Config) GetAuthorizationToken(sender autorest.Sender, oauth *authentication.OAuthConfig, endpoint string) (autorest.Authorizer, error) {
+	if oauth == nil || oauth.OAuth == nil {
+		return nil, fmt.Errorf("Error getting Authorization Token for OIDC auth: an OAuth token wasn't configured correctly; please file a bug with more details")
+	}
+
+	spt, err := adal.NewServicePrincipalTokenWithSecret(*oauth.OAuth, c.ClientID, endpoint, federatedTokenSecret{config: c})
+	if err != nil {
+		return nil, err
+	}
+	spt.SetSender(sender)
+
+	return autorest.NewBearerAuthorizer(spt), nil
+}
+
+// BearerAuthorizerCallback returns a BearerAuthorizer valid only for the Primary Tenant, which
+// obtains an Access Token for the resource specified in the challenge returned by the API
+func (c Config) BearerAuthorizerCallback(sender autorest.Sender, oauth *authentication.OAuthConfig) *autorest.BearerAuthorizerCallback {
+	return autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
+		// a BearerAuthorizer is only valid for the primary tenant
+		newAuthConfig := &authentication.OAuthConfig{
+			OAuth: oauth.OAuth,
+		}
+
+		auth, err := c.GetAuthorizationToken(sender, newAuthConfig, resource)
+		if err != nil {
+			return nil, err
+		}
+
+		cast, ok := auth.(*autorest.BearerAuthorizer)
+		if !ok {
+			return nil, fmt.Errorf("Error converting %+v to a BearerAuthorizer", auth)
+		}
+
+		return cast, nil
+	})
+}
+
+func (c Config) federatedToken() (string, error) {
+	if c.TokenFilePath == "" {
+		return c.Token, nil
+	}
+
+	contents, err := ioutil.ReadFile(c.TokenFilePath)
+	if err != nil {
+		return "", fmt.Errorf("Error reading the OIDC Token from %q: %+v", c.TokenFilePath, err)
+	}
+
+	token := strings.TrimSpace(string(contents))
+	if token == "" {
+		return "", fmt.Errorf("The OIDC Token File %q was empty", c.TokenFilePath)
+	}
+
+	return token, nil
+}
+
+// federatedTokenSecret authenticates the request for an Access Token using the federated OIDC token
+// as a Client Assertion, in place of a Client Secret or Client Certificate
+type federatedTokenSecret struct {
+	config Config
+}
+
+func (s federatedTokenSecret) SetAuthenticationValues(_ *adal.ServicePrincipalToken, values *url.Values) error {
+	token, err := s.config.federatedToken()
+	if err != nil {
+		return err
+	}
+
+	values.Set("client_assertion", token)
+	values.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	return nil
+}
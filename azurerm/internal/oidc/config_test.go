@@ -0,0 +1,211 @@
+package oidc
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+)
+
+const testTenantID = "00000000-0000-0000-0000-000000000000"
+
+// testTokenEndpoint returns a local token endpoint which exchanges the expected federated
+// token for an Access Token, returning the Access Token and a count of the requests made
+func testTokenEndpoint(t *testing.T, expectedAssertion string) (*httptest.Server, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.URL.Path != fmt.Sprintf("/%s/oauth2/token", testTenantID) {
+			t.Errorf("Unexpected request to %q", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Error parsing form: %+v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		expected := map[string]string{
+			"client_id":             "my-client-id",
+			"grant_type":            "client_credentials",
+			"client_assertion":      expectedAssertion,
+			"client_assertion_type": "urn:ietf:params:oauth:client-assertion-type:jwt-bearer",
+		}
+		for k, v := range expected {
+			if actual := r.PostForm.Get(k); actual != v {
+				t.Errorf("Expected %q to be %q but got %q", k, v, actual)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		}
+
+		resource := r.PostForm.Get("resource")
+		expiresOn := time.Now().Add(time.Hour).Unix()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-for-%s","expires_in":"3600","expires_on":"%d","not_before":"%d","resource":%q,"token_type":"Bearer"}`, resource, expiresOn, time.Now().Unix(), resource)
+	}))
+
+	return server, &requests
+}
+
+func testAuthorizationHeader(t *testing.T, auth autorest.Authorizer) string {
+	req, err := autorest.Prepare(&http.Request{Header: http.Header{}}, auth.WithAuthorization())
+	if err != nil {
+		t.Fatalf("Error authorizing request: %+v", err)
+	}
+
+	return req.Header.Get("Authorization")
+}
+
+func TestGetAuthorizationToken(t *testing.T) {
+	server, requests := testTokenEndpoint(t, "my-federated-token")
+	defer server.Close()
+
+	config := Config{
+		ClientID:       "my-client-id",
+		SubscriptionID: "11111111-1111-1111-1111-111111111111",
+		TenantID:       testTenantID,
+		Token:          "my-federated-token",
+	}
+	oauthConfig, err := config.AuthConfig().BuildOAuthConfig(server.URL)
+	if err != nil {
+		t.Fatalf("Error building OAuth Config: %+v", err)
+	}
+
+	for _, resource := range []string{"https://management.azure.com/", "https://graph.windows.net/"} {
+		auth, err := config.GetAuthorizationToken(server.Client(), oauthConfig, resource)
+		if err != nil {
+			t.Fatalf("Error building Authorizer for %q: %+v", resource, err)
+		}
+
+		expected := fmt.Sprintf("Bearer token-for-%s", resource)
+		if actual := testAuthorizationHeader(t, auth); actual != expected {
+			t.Fatalf("Expected the Authorization header to be %q but got %q", expected, actual)
+		}
+	}
+
+	if *requests != 2 {
+		t.Fatalf("Expected 2 requests to the token endpoint but got %d", *requests)
+	}
+}
+
+func TestGetAuthorizationToken_TokenFilePath(t *testing.T) {
+	server, _ := testTokenEndpoint(t, "token-from-file")
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "oidc")
+	if err != nil {
+		t.Fatalf("Error creating temp directory: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "token")
+	if err := ioutil.WriteFile(path, []byte("token-from-file\n"), 0600); err != nil {
+		t.Fatalf("Error writing token file: %+v", err)
+	}
+
+	config := Config{
+		ClientID:       "my-client-id",
+		SubscriptionID: "11111111-1111-1111-1111-111111111111",
+		TenantID:       testTenantID,
+		TokenFilePath:  path,
+	}
+	oauthConfig, err := config.AuthConfig().BuildOAuthConfig(server.URL)
+	if err != nil {
+		t.Fatalf("Error building OAuth Config: %+v", err)
+	}
+
+	auth, err := config.GetAuthorizationToken(server.Client(), oauthConfig, "https://vault.azure.net")
+	if err != nil {
+		t.Fatalf("Error building Authorizer: %+v", err)
+	}
+
+	if actual := testAuthorizationHeader(t, auth); actual != "Bearer token-for-https://vault.azure.net" {
+		t.Fatalf("Expected an Access Token for Key Vault but got %q", actual)
+	}
+}
+
+func TestGetAuthorizationToken_MissingOAuthConfig(t *testing.T) {
+	config := Config{
+		ClientID: "my-client-id",
+		TenantID: testTenantID,
+		Token:    "my-federated-token",
+	}
+
+	if _, err := config.GetAuthorizationToken(nil, &authentication.OAuthConfig{}, "https://management.azure.com/"); err == nil {
+		t.Fatalf("Expected an error when the OAuth Config is missing but didn't get one")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	testData := []struct {
+		name     string
+		config   Config
+		expected bool
+	}{
+		{
+			name:     "empty",
+			config:   Config{},
+			expected: false,
+		},
+		{
+			name: "token",
+			config: Config{
+				ClientID:       "my-client-id",
+				SubscriptionID: "11111111-1111-1111-1111-111111111111",
+				TenantID:       testTenantID,
+				Token:          "my-federated-token",
+			},
+			expected: true,
+		},
+		{
+			name: "token file path",
+			config: Config{
+				ClientID:       "my-client-id",
+				SubscriptionID: "11111111-1111-1111-1111-111111111111",
+				TenantID:       testTenantID,
+				TokenFilePath:  "/var/run/secrets/token",
+			},
+			expected: true,
+		},
+		{
+			name: "token and token file path",
+			config: Config{
+				ClientID:       "my-client-id",
+				SubscriptionID: "11111111-1111-1111-1111-111111111111",
+				TenantID:       testTenantID,
+				Token:          "my-federated-token",
+				TokenFilePath:  "/var/run/secrets/token",
+			},
+			expected: false,
+		},
+		{
+			name: "no token",
+			config: Config{
+				ClientID:       "my-client-id",
+				SubscriptionID: "11111111-1111-1111-1111-111111111111",
+				TenantID:       testTenantID,
+			},
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		err := v.config.Validate()
+		if valid := err == nil; valid != v.expected {
+			t.Fatalf("Expected valid to be %t but got %t: %+v", v.expected, valid, err)
+		}
+	}
+}
@@ -1,4 +1,4 @@
-package analysisservices
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/analysisservices/mgmt/2017-08-01/analysisservices"
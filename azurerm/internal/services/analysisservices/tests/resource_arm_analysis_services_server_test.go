@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -18,12 +20,12 @@ func TestAccAzureRMAnalysisServicesServer_basic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMAnalysisServicesServerDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMAnalysisServicesServer_basic(ri, testLocation()),
+				Config: testAccAzureRMAnalysisServicesServer_basic(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMAnalysisServicesServerExists(resourceName),
 				),
@@ -40,12 +42,12 @@ func TestAccAzureRMAnalysisServicesServer_basic(t *testing.T) {
 func TestAccAzureRMAnalysisServicesServer_withTags(t *testing.T) {
 	resourceName := "azurerm_analysis_services_server.test"
 	ri := tf.AccRandTimeInt()
-	preConfig := testAccAzureRMAnalysisServicesServer_withTags(ri, testLocation())
-	postConfig := testAccAzureRMAnalysisServicesServer_withTagsUpdate(ri, testLocation())
+	preConfig := testAccAzureRMAnalysisServicesServer_withTags(ri, acceptance.Location())
+	postConfig := testAccAzureRMAnalysisServicesServer_withTagsUpdate(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMAnalysisServicesServerDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -72,12 +74,12 @@ func TestAccAzureRMAnalysisServicesServer_withTags(t *testing.T) {
 func TestAccAzureRMAnalysisServicesServer_querypoolConnectionMode(t *testing.T) {
 	resourceName := "azurerm_analysis_services_server.test"
 	ri := tf.AccRandTimeInt()
-	preConfig := testAccAzureRMAnalysisServicesServer_querypoolConnectionMode(ri, testLocation(), "All")
-	postConfig := testAccAzureRMAnalysisServicesServer_querypoolConnectionMode(ri, testLocation(), "ReadOnly")
+	preConfig := testAccAzureRMAnalysisServicesServer_querypoolConnectionMode(ri, acceptance.Location(), "All")
+	postConfig := testAccAzureRMAnalysisServicesServer_querypoolConnectionMode(ri, acceptance.Location(), "ReadOnly")
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMAnalysisServicesServerDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -102,15 +104,15 @@ func TestAccAzureRMAnalysisServicesServer_firewallSettings(t *testing.T) {
 	resourceName := "azurerm_analysis_services_server.test"
 	ri := tf.AccRandTimeInt()
 
-	config1 := testAccAzureRMAnalysisServicesServer_firewallSettings1(ri, testLocation(), true)
+	config1 := testAccAzureRMAnalysisServicesServer_firewallSettings1(ri, acceptance.Location(), true)
 
-	config2 := testAccAzureRMAnalysisServicesServer_firewallSettings2(ri, testLocation(), false)
+	config2 := testAccAzureRMAnalysisServicesServer_firewallSettings2(ri, acceptance.Location(), false)
 
-	config3 := testAccAzureRMAnalysisServicesServer_firewallSettings3(ri, testLocation(), true)
+	config3 := testAccAzureRMAnalysisServicesServer_firewallSettings3(ri, acceptance.Location(), true)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMAnalysisServicesServerDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -165,12 +167,12 @@ func TestAccAzureRMAnalysisServicesServer_adminUsers(t *testing.T) {
 	email2 := os.Getenv(ArmAccAdminEmail2)
 	preAdminUsers := []string{email1}
 	postAdminUsers := []string{email1, email2}
-	preConfig := testAccAzureRMAnalysisServicesServer_adminUsers(ri, testLocation(), preAdminUsers)
-	postConfig := testAccAzureRMAnalysisServicesServer_adminUsers(ri, testLocation(), postAdminUsers)
+	preConfig := testAccAzureRMAnalysisServicesServer_adminUsers(ri, acceptance.Location(), preAdminUsers)
+	postConfig := testAccAzureRMAnalysisServicesServer_adminUsers(ri, acceptance.Location(), postAdminUsers)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMAnalysisServicesServerDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -198,12 +200,12 @@ func TestAccAzureRMAnalysisServicesServer_serverFullName(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMAnalysisServicesServerDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMAnalysisServicesServer_serverFullName(ri, testLocation()),
+				Config: testAccAzureRMAnalysisServicesServer_serverFullName(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMAnalysisServicesServerExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "server_full_name"),
@@ -224,12 +226,12 @@ func TestAccAzureRMAnalysisServicesServer_backupBlobContainerUri(t *testing.T) {
 	rs := acctest.RandString(6)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMAnalysisServicesServerDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMAnalysisServicesServer_backupBlobContainerUri(ri, testLocation(), rs),
+				Config: testAccAzureRMAnalysisServicesServer_backupBlobContainerUri(ri, acceptance.Location(), rs),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMAnalysisServicesServerExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "backup_blob_container_uri"),
@@ -467,7 +469,7 @@ resource "azurerm_analysis_services_server" "test" {
 }
 
 func testCheckAzureRMAnalysisServicesServerDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).analysisservices.ServerClient
+	client := acceptance.AzureProvider.Meta().(*clients.Client).AnalysisServices.ServerClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_analysis_services_server" {
@@ -477,7 +479,7 @@ func testCheckAzureRMAnalysisServicesServerDestroy(s *terraform.State) error {
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 		resp, err := client.GetDetails(ctx, resourceGroup, name)
 
 		if err != nil {
@@ -507,8 +509,8 @@ func testCheckAzureRMAnalysisServicesServerExists(resourceName string) resource.
 			return fmt.Errorf("Bad: no resource group found in state for Analysis Services Server: %s", analysisServicesServerName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).analysisservices.ServerClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).AnalysisServices.ServerClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 		resp, err := client.GetDetails(ctx, resourceGroup, analysisServicesServerName)
 		if err != nil {
 			if utils.ResponseWasNotFound(resp.Response) {
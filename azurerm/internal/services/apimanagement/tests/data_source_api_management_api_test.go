@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -6,16 +6,17 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 )
 
 func TestAccDataSourceAzureRMApiManagementApi_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_api_management_api.test"
 	rInt := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceApiManagementApi_basic(rInt, location),
@@ -36,11 +37,11 @@ func TestAccDataSourceAzureRMApiManagementApi_basic(t *testing.T) {
 func TestAccDataSourceAzureRMApiManagementApi_complete(t *testing.T) {
 	dataSourceName := "data.azurerm_api_management_api.test"
 	rInt := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceApiManagementApi_complete(rInt, location),
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -14,11 +16,11 @@ import (
 func TestAccAzureRMApiManagementApi_basic(t *testing.T) {
 	resourceName := "azurerm_api_management_api.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMApiManagementApiDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -43,11 +45,11 @@ func TestAccAzureRMApiManagementApi_basic(t *testing.T) {
 func TestAccAzureRMApiManagementApi_basicClassic(t *testing.T) {
 	resourceName := "azurerm_api_management_api.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMApiManagementApiDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -71,11 +73,11 @@ func TestAccAzureRMApiManagementApi_basicClassic(t *testing.T) {
 func TestAccAzureRMApiManagementApi_wordRevision(t *testing.T) {
 	resourceName := "azurerm_api_management_api.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMApiManagementApiDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -102,11 +104,11 @@ func TestAccAzureRMApiManagementApi_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_api_management_api.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMApiManagementApiDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -117,7 +119,7 @@ func TestAccAzureRMApiManagementApi_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMApiManagementApi_requiresImport(ri, location),
-				ExpectError: testRequiresImportError("azurerm_api_management_api"),
+				ExpectError: acceptance.RequiresImportError("azurerm_api_management_api"),
 			},
 		},
 	})
@@ -126,11 +128,11 @@ func TestAccAzureRMApiManagementApi_requiresImport(t *testing.T) {
 func TestAccAzureRMApiManagementApi_soapPassthrough(t *testing.T) {
 	resourceName := "azurerm_api_management_api.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMApiManagementApiDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -151,11 +153,11 @@ func TestAccAzureRMApiManagementApi_soapPassthrough(t *testing.T) {
 func TestAccAzureRMApiManagementApi_importSwagger(t *testing.T) {
 	resourceName := "azurerm_api_management_api.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMApiManagementApiDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -180,11 +182,11 @@ func TestAccAzureRMApiManagementApi_importSwagger(t *testing.T) {
 func TestAccAzureRMApiManagementApi_importWsdl(t *testing.T) {
 	resourceName := "azurerm_api_management_api.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMApiManagementApiDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -209,11 +211,11 @@ func TestAccAzureRMApiManagementApi_importWsdl(t *testing.T) {
 func TestAccAzureRMApiManagementApi_importUpdate(t *testing.T) {
 	resourceName := "azurerm_api_management_api.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMApiManagementApiDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -253,11 +255,11 @@ func TestAccAzureRMApiManagementApi_importUpdate(t *testing.T) {
 func TestAccAzureRMApiManagementApi_complete(t *testing.T) {
 	resourceName := "azurerm_api_management_api.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMApiManagementApiDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -276,7 +278,7 @@ func TestAccAzureRMApiManagementApi_complete(t *testing.T) {
 }
 
 func testCheckAzureRMApiManagementApiDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).apiManagement.ApiClient
+	conn := acceptance.AzureProvider.Meta().(*clients.Client).ApiManagement.ApiClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_api_management_api" {
@@ -287,7 +289,7 @@ func testCheckAzureRMApiManagementApiDestroy(s *terraform.State) error {
 		serviceName := rs.Primary.Attributes["api_management_name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 		revision := rs.Primary.Attributes["revision"]
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 		apiId := fmt.Sprintf("%s;rev=%s", name, revision)
 
 		resp, err := conn.Get(ctx, resourceGroup, serviceName, apiId)
@@ -318,8 +320,8 @@ func testCheckAzureRMApiManagementApiExists(name string) resource.TestCheckFunc
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 		revision := rs.Primary.Attributes["revision"]
 
-		conn := testAccProvider.Meta().(*ArmClient).apiManagement.ApiClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		conn := acceptance.AzureProvider.Meta().(*clients.Client).ApiManagement.ApiClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		apiId := fmt.Sprintf("%s;rev=%s", name, revision)
 		resp, err := conn.Get(ctx, resourceGroup, serviceName, apiId)
@@ -1,4 +1,4 @@
-package applicationinsights
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
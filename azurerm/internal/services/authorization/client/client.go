@@ -1,4 +1,4 @@
-package authorization
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-09-01-preview/authorization"
@@ -1,4 +1,4 @@
-package azurerm
+package authorization
 
 import (
 	"fmt"
@@ -6,6 +6,8 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
 func dataSourceArmClientConfig() *schema.Resource {
@@ -38,15 +40,16 @@ func dataSourceArmClientConfig() *schema.Resource {
 }
 
 func dataSourceArmClientConfigRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client)
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	var servicePrincipal *graphrbac.ServicePrincipal
-	if client.usingServicePrincipal {
-		spClient := client.graph.ServicePrincipalsClient
+	if client.Account.AuthenticatedAsAServicePrincipal {
+		spClient := client.Graph.ServicePrincipalsClient
 		// Application & Service Principal is 1:1 per tenant. Since we know the appId (client_id)
 		// here, we can query for the Service Principal whose appId matches.
-		filter := fmt.Sprintf("appId eq '%s'", client.clientId)
+		filter := fmt.Sprintf("appId eq '%s'", client.Account.ClientId)
 		listResult, listErr := spClient.List(ctx, filter)
 
 		if listErr != nil {
@@ -61,9 +64,9 @@ func dataSourceArmClientConfigRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	d.SetId(time.Now().UTC().String())
-	d.Set("client_id", client.clientId)
-	d.Set("tenant_id", client.tenantId)
-	d.Set("subscription_id", client.subscriptionId)
+	d.Set("client_id", client.Account.ClientId)
+	d.Set("tenant_id", client.Account.TenantId)
+	d.Set("subscription_id", client.Account.SubscriptionId)
 
 	if principal := servicePrincipal; principal != nil {
 		d.Set("service_principal_application_id", principal.AppID)
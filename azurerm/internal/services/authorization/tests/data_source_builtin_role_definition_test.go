@@ -1,17 +1,18 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 )
 
 func TestAccDataSourceAzureRMBuiltInRoleDefinition_contributor(t *testing.T) {
 	dataSourceName := "data.azurerm_builtin_role_definition.test"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceBuiltInRoleDefinition("Contributor"),
@@ -37,8 +38,8 @@ func TestAccDataSourceAzureRMBuiltInRoleDefinition_contributor(t *testing.T) {
 func TestAccDataSourceAzureRMBuiltInRoleDefinition_owner(t *testing.T) {
 	dataSourceName := "data.azurerm_builtin_role_definition.test"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceBuiltInRoleDefinition("Owner"),
@@ -59,8 +60,8 @@ func TestAccDataSourceAzureRMBuiltInRoleDefinition_owner(t *testing.T) {
 func TestAccDataSourceAzureRMBuiltInRoleDefinition_reader(t *testing.T) {
 	dataSourceName := "data.azurerm_builtin_role_definition.test"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceBuiltInRoleDefinition("Reader"),
@@ -81,8 +82,8 @@ func TestAccDataSourceAzureRMBuiltInRoleDefinition_reader(t *testing.T) {
 func TestAccDataSourceAzureRMBuiltInRoleDefinition_virtualMachineContributor(t *testing.T) {
 	dataSourceName := "data.azurerm_builtin_role_definition.test"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceBuiltInRoleDefinition("VirtualMachineContributor"),
@@ -1,4 +1,4 @@
-package automation
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
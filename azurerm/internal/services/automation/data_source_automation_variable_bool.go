@@ -1,4 +1,4 @@
-package azurerm
+package automation
 
 import (
 	"github.com/hashicorp/terraform/helper/schema"
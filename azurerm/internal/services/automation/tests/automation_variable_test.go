@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func testCheckAzureRMAutomationVariableExists(resourceName string, varType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Automation %s Variable not found: %s", varType, resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		accountName := rs.Primary.Attributes["automation_account_name"]
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Automation.VariableClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		if resp, err := client.Get(ctx, resourceGroup, accountName, name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Automation %s Variable %q (Automation Account Name %q / Resource Group %q) does not exist", varType, name, accountName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on automationVariableClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAutomationVariableDestroy(s *terraform.State, varType string) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Automation.VariableClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	resourceName := fmt.Sprintf("azurerm_automation_variable_%s", strings.ToLower(varType))
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceName {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		accountName := rs.Primary.Attributes["automation_account_name"]
+
+		if resp, err := client.Get(ctx, resourceGroup, accountName, name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on automationVariableClient: %+v", err)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
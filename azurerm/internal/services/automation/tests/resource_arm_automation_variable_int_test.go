@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -7,16 +7,17 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 )
 
 func TestAccAzureRMAutomationVariableInt_basic(t *testing.T) {
 	resourceName := "azurerm_automation_variable_int.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMAutomationVariableIntDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -38,11 +39,11 @@ func TestAccAzureRMAutomationVariableInt_basic(t *testing.T) {
 func TestAccAzureRMAutomationVariableInt_complete(t *testing.T) {
 	resourceName := "azurerm_automation_variable_int.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMAutomationVariableIntDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -65,11 +66,11 @@ func TestAccAzureRMAutomationVariableInt_complete(t *testing.T) {
 func TestAccAzureRMAutomationVariableInt_basicCompleteUpdate(t *testing.T) {
 	resourceName := "azurerm_automation_variable_int.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMAutomationVariableIntDestroy,
 		Steps: []resource.TestStep{
 			{
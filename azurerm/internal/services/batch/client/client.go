@@ -1,4 +1,4 @@
-package batch
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2018-12-01/batch"
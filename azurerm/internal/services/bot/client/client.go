@@ -1,4 +1,4 @@
-package bot
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/botservice/mgmt/2018-07-12/botservice"
@@ -1,4 +1,4 @@
-package azurerm
+package bot
 
 import (
 	"fmt"
@@ -10,7 +10,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -66,13 +67,14 @@ func resourceArmBotChannelSlack() *schema.Resource {
 }
 
 func resourceArmBotChannelSlackCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).bot.ChannelClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Bot.ChannelClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	botName := d.Get("bot_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, string(botservice.ChannelNameSlackChannel), botName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -119,8 +121,9 @@ func resourceArmBotChannelSlackCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmBotChannelSlackRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).bot.ChannelClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Bot.ChannelClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -155,8 +158,9 @@ func resourceArmBotChannelSlackRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceArmBotChannelSlackUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).bot.ChannelClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Bot.ChannelClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	botName := d.Get("bot_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -196,8 +200,9 @@ func resourceArmBotChannelSlackUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmBotChannelSlackDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).bot.ChannelClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Bot.ChannelClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -1,4 +1,4 @@
-package azurerm
+package bot
 
 import (
 	"fmt"
@@ -10,8 +10,9 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -83,14 +84,15 @@ func resourceArmBotConnection() *schema.Resource {
 }
 
 func resourceArmBotConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).bot.ConnectionClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Bot.ConnectionClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 	botName := d.Get("bot_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name, botName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -134,8 +136,9 @@ func resourceArmBotConnectionCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceArmBotConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).bot.ConnectionClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Bot.ConnectionClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -173,8 +176,9 @@ func resourceArmBotConnectionRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceArmBotConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).bot.ConnectionClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Bot.ConnectionClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -212,8 +216,9 @@ func resourceArmBotConnectionUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceArmBotConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).bot.ConnectionClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Bot.ConnectionClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -1,4 +1,4 @@
-package azurerm
+package bot
 
 import (
 	"fmt"
@@ -11,8 +11,9 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -113,13 +114,14 @@ func resourceArmBotWebApp() *schema.Resource {
 }
 
 func resourceArmBotWebAppCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).bot.BotClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Bot.BotClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -174,8 +176,9 @@ func resourceArmBotWebAppCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmBotWebAppRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).bot.BotClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Bot.BotClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -215,8 +218,9 @@ func resourceArmBotWebAppRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmBotWebAppUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).bot.BotClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Bot.BotClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -263,8 +267,9 @@ func resourceArmBotWebAppUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmBotWebAppDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).bot.BotClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Bot.BotClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
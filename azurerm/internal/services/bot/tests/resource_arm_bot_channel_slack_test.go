@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -18,12 +20,12 @@ func testAccAzureRMBotChannelSlack_basic(t *testing.T) {
 		t.Skip("Skipping as one of `ARM_TEST_SLACK_CLIENT_ID`, `ARM_TEST_SLACK_CLIENT_SECRET`, or `ARM_TEST_SLACK_VERIFICATION_TOKEN` was not specified")
 	}
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMBotChannelSlack_basicConfig(ri, testLocation())
+	config := testAccAzureRMBotChannelSlack_basicConfig(ri, acceptance.Location())
 	resourceName := "azurerm_bot_channel_slack.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMBotChannelSlackDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -51,13 +53,13 @@ func testAccAzureRMBotChannelSlack_update(t *testing.T) {
 		t.Skip("Skipping as one of `ARM_TEST_SLACK_CLIENT_ID`, `ARM_TEST_SLACK_CLIENT_SECRET`, or `ARM_TEST_SLACK_VERIFICATION_TOKEN` was not specified")
 	}
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMBotChannelSlack_basicConfig(ri, testLocation())
-	config2 := testAccAzureRMBotChannelSlack_basicUpdate(ri, testLocation())
+	config := testAccAzureRMBotChannelSlack_basicConfig(ri, acceptance.Location())
+	config2 := testAccAzureRMBotChannelSlack_basicUpdate(ri, acceptance.Location())
 	resourceName := "azurerm_bot_channel_slack.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMBotChannelSlackDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -110,8 +112,8 @@ func testCheckAzureRMBotChannelSlackExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for Bot Channel Slack")
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).bot.ChannelClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Bot.ChannelClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, botName, string(botservice.ChannelNameSlackChannel))
 		if err != nil {
@@ -127,8 +129,8 @@ func testCheckAzureRMBotChannelSlackExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMBotChannelSlackDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).bot.ChannelClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Bot.ChannelClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_bot_channel_slack" {
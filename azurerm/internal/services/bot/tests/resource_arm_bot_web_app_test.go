@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -8,17 +8,19 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func testAccAzureRMBotWebApp_basic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMBotWebApp_basicConfig(ri, testLocation())
+	config := testAccAzureRMBotWebApp_basicConfig(ri, acceptance.Location())
 	resourceName := "azurerm_bot_web_app.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMBotWebAppDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -39,13 +41,13 @@ func testAccAzureRMBotWebApp_basic(t *testing.T) {
 
 func testAccAzureRMBotWebApp_update(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMBotWebApp_basicConfig(ri, testLocation())
-	config2 := testAccAzureRMBotWebApp_updateConfig(ri, testLocation())
+	config := testAccAzureRMBotWebApp_basicConfig(ri, acceptance.Location())
+	config2 := testAccAzureRMBotWebApp_updateConfig(ri, acceptance.Location())
 	resourceName := "azurerm_bot_web_app.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMBotWebAppDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -78,12 +80,12 @@ func testAccAzureRMBotWebApp_update(t *testing.T) {
 
 func testAccAzureRMBotWebApp_complete(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMBotWebApp_completeConfig(ri, testLocation())
+	config := testAccAzureRMBotWebApp_completeConfig(ri, acceptance.Location())
 	resourceName := "azurerm_bot_web_app.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMBotWebAppDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -116,8 +118,8 @@ func testCheckAzureRMBotWebAppExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for Bot Web App: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).bot.BotClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Bot.BotClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
@@ -133,8 +135,8 @@ func testCheckAzureRMBotWebAppExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMBotWebAppDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).bot.BotClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Bot.BotClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_bot" {
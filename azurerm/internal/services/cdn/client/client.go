@@ -1,4 +1,4 @@
-package cdn
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2017-10-12/cdn"
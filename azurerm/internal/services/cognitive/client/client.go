@@ -1,4 +1,4 @@
-package cognitive
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/cognitiveservices/mgmt/2017-04-18/cognitiveservices"
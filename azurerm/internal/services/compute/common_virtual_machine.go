@@ -0,0 +1,887 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the schema, expand and flatten functions in this file are shared between the
+// `azurerm_linux_virtual_machine` and `azurerm_windows_virtual_machine` resources
+
+func virtualMachineAdminSSHKeySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"public_key": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"username": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineAdminSSHKeys(input []interface{}) *[]compute.SSHPublicKey {
+	results := make([]compute.SSHPublicKey, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		username := v["username"].(string)
+		results = append(results, compute.SSHPublicKey{
+			KeyData: utils.String(v["public_key"].(string)),
+			Path:    utils.String(fmt.Sprintf("/home/%s/.ssh/authorized_keys", username)),
+		})
+	}
+
+	return &results
+}
+
+func flattenVirtualMachineAdminSSHKeys(input *compute.SSHConfiguration) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil || input.PublicKeys == nil {
+		return results, nil
+	}
+
+	for _, item := range *input.PublicKeys {
+		if item.KeyData == nil || item.Path == nil {
+			continue
+		}
+
+		// the username is only exposed via the path, which is in the format `/home/{username}/.ssh/authorized_keys`
+		path := *item.Path
+		if !strings.HasPrefix(path, "/home/") || !strings.HasSuffix(path, "/.ssh/authorized_keys") {
+			return nil, fmt.Errorf("Expected the SSH Key Path to be in the format `/home/{username}/.ssh/authorized_keys` but got %q", path)
+		}
+		username := strings.TrimSuffix(strings.TrimPrefix(path, "/home/"), "/.ssh/authorized_keys")
+
+		results = append(results, map[string]interface{}{
+			"public_key": *item.KeyData,
+			"username":   username,
+		})
+	}
+
+	return results, nil
+}
+
+func virtualMachineBootDiagnosticsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"storage_account_uri": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.URLIsHTTPS,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineBootDiagnostics(input []interface{}) *compute.DiagnosticsProfile {
+	if len(input) == 0 {
+		return &compute.DiagnosticsProfile{
+			BootDiagnostics: &compute.BootDiagnostics{
+				Enabled: utils.Bool(false),
+			},
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &compute.DiagnosticsProfile{
+		BootDiagnostics: &compute.BootDiagnostics{
+			Enabled:    utils.Bool(true),
+			StorageURI: utils.String(raw["storage_account_uri"].(string)),
+		},
+	}
+}
+
+func flattenVirtualMachineBootDiagnostics(input *compute.DiagnosticsProfile) []interface{} {
+	if input == nil || input.BootDiagnostics == nil {
+		return []interface{}{}
+	}
+
+	if input.BootDiagnostics.Enabled == nil || !*input.BootDiagnostics.Enabled {
+		return []interface{}{}
+	}
+
+	storageAccountUri := ""
+	if input.BootDiagnostics.StorageURI != nil {
+		storageAccountUri = *input.BootDiagnostics.StorageURI
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"storage_account_uri": storageAccountUri,
+		},
+	}
+}
+
+func virtualMachineIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.ResourceIdentityTypeSystemAssigned),
+						string(compute.ResourceIdentityTypeUserAssigned),
+						string(compute.ResourceIdentityTypeSystemAssignedUserAssigned),
+					}, false),
+				},
+
+				"identity_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+				},
+
+				"principal_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineIdentity(input []interface{}) (*compute.VirtualMachineIdentity, error) {
+	if len(input) == 0 {
+		// the API requires that the Identity is explicitly set to None to remove it
+		return &compute.VirtualMachineIdentity{
+			Type: compute.ResourceIdentityTypeNone,
+		}, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	identity := compute.VirtualMachineIdentity{
+		Type: compute.ResourceIdentityType(raw["type"].(string)),
+	}
+
+	identityIds := raw["identity_ids"].(*schema.Set).List()
+	if len(identityIds) > 0 {
+		if identity.Type != compute.ResourceIdentityTypeUserAssigned && identity.Type != compute.ResourceIdentityTypeSystemAssignedUserAssigned {
+			return nil, fmt.Errorf("`identity_ids` can only be specified when `type` includes `UserAssigned`")
+		}
+
+		userAssignedIdentities := make(map[string]*compute.VirtualMachineIdentityUserAssignedIdentitiesValue)
+		for _, id := range identityIds {
+			userAssignedIdentities[id.(string)] = &compute.VirtualMachineIdentityUserAssignedIdentitiesValue{}
+		}
+		identity.UserAssignedIdentities = userAssignedIdentities
+	}
+
+	return &identity, nil
+}
+
+func flattenVirtualMachineIdentity(input *compute.VirtualMachineIdentity) []interface{} {
+	if input == nil || input.Type == compute.ResourceIdentityTypeNone {
+		return []interface{}{}
+	}
+
+	identityIds := make([]interface{}, 0)
+	for key := range input.UserAssignedIdentities {
+		identityIds = append(identityIds, key)
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(input.Type),
+			"identity_ids": schema.NewSet(schema.HashString, identityIds),
+			"principal_id": principalId,
+		},
+	}
+}
+
+func virtualMachineOSDiskSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"caching": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.CachingTypesNone),
+						string(compute.CachingTypesReadOnly),
+						string(compute.CachingTypesReadWrite),
+					}, false),
+				},
+
+				"storage_account_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.StorageAccountTypesPremiumLRS),
+						string(compute.StorageAccountTypesStandardLRS),
+						string(compute.StorageAccountTypesStandardSSDLRS),
+					}, false),
+				},
+
+				"disk_size_gb": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validateDiskSizeGB,
+				},
+
+				"name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"write_accelerator_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineOSDisk(input []interface{}, osType compute.OperatingSystemTypes) *compute.OSDisk {
+	raw := input[0].(map[string]interface{})
+	disk := compute.OSDisk{
+		Caching: compute.CachingTypes(raw["caching"].(string)),
+		ManagedDisk: &compute.ManagedDiskParameters{
+			StorageAccountType: compute.StorageAccountTypes(raw["storage_account_type"].(string)),
+		},
+		WriteAcceleratorEnabled: utils.Bool(raw["write_accelerator_enabled"].(bool)),
+
+		// these have to be hard-coded so there's no point exposing them
+		CreateOption: compute.DiskCreateOptionTypesFromImage,
+		OsType:       osType,
+	}
+
+	if size := raw["disk_size_gb"].(int); size > 0 {
+		disk.DiskSizeGB = utils.Int32(int32(size))
+	}
+
+	if name := raw["name"].(string); name != "" {
+		disk.Name = utils.String(name)
+	}
+
+	return &disk
+}
+
+func flattenVirtualMachineOSDisk(ctx context.Context, disksClient *compute.DisksClient, input *compute.OSDisk) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	diskSizeGb := 0
+	if input.DiskSizeGB != nil {
+		diskSizeGb = int(*input.DiskSizeGB)
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	storageAccountType := ""
+	if disk := input.ManagedDisk; disk != nil {
+		storageAccountType = string(disk.StorageAccountType)
+
+		// the Size and Storage Account Type of the OS Disk aren't always returned from the Virtual Machine,
+		// as such we need to look these up from the Managed Disk itself
+		if disk.ID != nil {
+			id, err := parse.ParseManagedDiskID(*disk.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			resp, err := disksClient.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				// this is a best-effort lookup, since the Disk may be removed out-of-band
+				if !utils.ResponseWasNotFound(resp.Response) {
+					return nil, fmt.Errorf("Error retrieving OS Disk %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+				}
+			}
+
+			if props := resp.DiskProperties; props != nil && props.DiskSizeGB != nil {
+				diskSizeGb = int(*props.DiskSizeGB)
+			}
+
+			if sku := resp.Sku; sku != nil && sku.Name != "" {
+				storageAccountType = string(sku.Name)
+			}
+		}
+	}
+
+	writeAcceleratorEnabled := false
+	if input.WriteAcceleratorEnabled != nil {
+		writeAcceleratorEnabled = *input.WriteAcceleratorEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"caching":                   string(input.Caching),
+			"disk_size_gb":              diskSizeGb,
+			"name":                      name,
+			"storage_account_type":      storageAccountType,
+			"write_accelerator_enabled": writeAcceleratorEnabled,
+		},
+	}, nil
+}
+
+func virtualMachineSourceImageReferenceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"source_image_id"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"publisher": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"offer": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"sku": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"version": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineSourceImageReference(referenceInput []interface{}, imageId string) (*compute.ImageReference, error) {
+	if imageId != "" {
+		return &compute.ImageReference{
+			ID: utils.String(imageId),
+		}, nil
+	}
+
+	if len(referenceInput) == 0 {
+		return nil, fmt.Errorf("Either a `source_image_id` or a `source_image_reference` block must be specified")
+	}
+
+	raw := referenceInput[0].(map[string]interface{})
+	return &compute.ImageReference{
+		Publisher: utils.String(raw["publisher"].(string)),
+		Offer:     utils.String(raw["offer"].(string)),
+		Sku:       utils.String(raw["sku"].(string)),
+		Version:   utils.String(raw["version"].(string)),
+	}, nil
+}
+
+func flattenVirtualMachineSourceImageReference(input *compute.ImageReference) []interface{} {
+	// since the Image ID is pulled out as a separate field, if that's set we should return an empty block here
+	if input == nil || input.ID != nil {
+		return []interface{}{}
+	}
+
+	publisher := ""
+	if input.Publisher != nil {
+		publisher = *input.Publisher
+	}
+
+	offer := ""
+	if input.Offer != nil {
+		offer = *input.Offer
+	}
+
+	sku := ""
+	if input.Sku != nil {
+		sku = *input.Sku
+	}
+
+	version := ""
+	if input.Version != nil {
+		version = *input.Version
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"publisher": publisher,
+			"offer":     offer,
+			"sku":       sku,
+			"version":   version,
+		},
+	}
+}
+
+func virtualMachinePrioritySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  string(compute.Regular),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(compute.Low),
+			string(compute.Regular),
+		}, false),
+	}
+}
+
+func virtualMachineEvictionPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		// only applicable when `priority` is set to `Low`
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(compute.Deallocate),
+			string(compute.Delete),
+		}, false),
+	}
+}
+
+func virtualMachineMaxBidPriceSchema() *schema.Schema {
+	return &schema.Schema{
+		// only applicable when `priority` is set to `Low` - where `-1` means the on-demand price
+		Type:     schema.TypeFloat,
+		Optional: true,
+		Default:  -1,
+	}
+}
+
+func expandVirtualMachineNetworkInterfaceIDs(input []interface{}) []compute.NetworkInterfaceReference {
+	results := make([]compute.NetworkInterfaceReference, 0)
+
+	for i, item := range input {
+		results = append(results, compute.NetworkInterfaceReference{
+			ID: utils.String(item.(string)),
+			NetworkInterfaceReferenceProperties: &compute.NetworkInterfaceReferenceProperties{
+				// the first Network Interface is used as the Primary
+				Primary: utils.Bool(i == 0),
+			},
+		})
+	}
+
+	return results
+}
+
+func flattenVirtualMachineNetworkInterfaceIDs(input *[]compute.NetworkInterfaceReference) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.ID == nil {
+			continue
+		}
+
+		results = append(results, *item.ID)
+	}
+
+	return results
+}
+
+// virtualMachineUpdateDetails describes the changes to a Virtual Machine which need to be applied,
+// alongside whether the Virtual Machine needs to be shut down and/or deallocated to apply them
+type virtualMachineUpdateDetails struct {
+	update           compute.VirtualMachineUpdate
+	shouldUpdate     bool
+	shouldShutDown   bool
+	shouldDeallocate bool
+	newOSDiskSizeGb  int
+}
+
+// expandVirtualMachineUpdateDetails determines the changes common to both the Linux and Windows Virtual Machine resources
+func expandVirtualMachineUpdateDetails(ctx context.Context, d *schema.ResourceData, client *compute.VirtualMachinesClient, id *parse.VirtualMachineID) (*virtualMachineUpdateDetails, error) {
+	details := virtualMachineUpdateDetails{
+		update: compute.VirtualMachineUpdate{
+			VirtualMachineProperties: &compute.VirtualMachineProperties{},
+		},
+	}
+
+	if d.HasChange("boot_diagnostics") {
+		details.shouldUpdate = true
+		details.update.VirtualMachineProperties.DiagnosticsProfile = expandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{}))
+	}
+
+	if d.HasChange("identity") {
+		identity, err := expandVirtualMachineIdentity(d.Get("identity").([]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("Error expanding `identity`: %+v", err)
+		}
+
+		details.shouldUpdate = true
+		details.update.Identity = identity
+	}
+
+	if d.HasChange("max_bid_price") {
+		// the Max Bid Price can only be changed when the Virtual Machine is deallocated
+		details.shouldUpdate = true
+		details.shouldShutDown = true
+		details.shouldDeallocate = true
+		details.update.VirtualMachineProperties.BillingProfile = &compute.BillingProfile{
+			MaxPrice: utils.Float(d.Get("max_bid_price").(float64)),
+		}
+	}
+
+	if d.HasChange("network_interface_ids") {
+		// the Network Interfaces can only be changed when the Virtual Machine is deallocated
+		details.shouldUpdate = true
+		details.shouldShutDown = true
+		details.shouldDeallocate = true
+
+		networkInterfaces := expandVirtualMachineNetworkInterfaceIDs(d.Get("network_interface_ids").([]interface{}))
+		details.update.VirtualMachineProperties.NetworkProfile = &compute.NetworkProfile{
+			NetworkInterfaces: &networkInterfaces,
+		}
+	}
+
+	if d.HasChange("os_disk.0.caching") || d.HasChange("os_disk.0.write_accelerator_enabled") {
+		details.shouldUpdate = true
+
+		osDisk := d.Get("os_disk").([]interface{})[0].(map[string]interface{})
+		details.update.VirtualMachineProperties.StorageProfile = &compute.StorageProfile{
+			OsDisk: &compute.OSDisk{
+				Caching:                 compute.CachingTypes(osDisk["caching"].(string)),
+				WriteAcceleratorEnabled: utils.Bool(osDisk["write_accelerator_enabled"].(bool)),
+			},
+		}
+
+		// enabling/disabling Write Acceleration requires that the Virtual Machine is deallocated
+		if d.HasChange("os_disk.0.write_accelerator_enabled") {
+			details.shouldShutDown = true
+			details.shouldDeallocate = true
+		}
+	}
+
+	if d.HasChange("os_disk.0.disk_size_gb") {
+		oldRaw, newRaw := d.GetChange("os_disk.0.disk_size_gb")
+		oldSize := oldRaw.(int)
+		newSize := newRaw.(int)
+
+		if newSize < oldSize {
+			return nil, fmt.Errorf("the OS Disk can only be expanded - `disk_size_gb` cannot be reduced from %d to %d", oldSize, newSize)
+		}
+
+		// the OS Disk can only be resized when the Virtual Machine is deallocated
+		details.shouldShutDown = true
+		details.shouldDeallocate = true
+		details.newOSDiskSizeGb = newSize
+	}
+
+	if d.HasChange("size") {
+		details.shouldUpdate = true
+
+		size := d.Get("size").(string)
+		details.update.VirtualMachineProperties.HardwareProfile = &compute.HardwareProfile{
+			VMSize: compute.VirtualMachineSizeTypes(size),
+		}
+
+		// a Virtual Machine can be resized whilst running when the new size is available on the current
+		// hardware cluster - otherwise the Virtual Machine needs to be deallocated before it can be resized
+		sizes, err := client.ListAvailableSizes(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving the available sizes for Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		available := false
+		if sizes.Value != nil {
+			for _, availableSize := range *sizes.Value {
+				if availableSize.Name != nil && strings.EqualFold(*availableSize.Name, size) {
+					available = true
+					break
+				}
+			}
+		}
+
+		if !available {
+			log.Printf("[DEBUG] Size %q isn't available on the current hardware cluster for Virtual Machine %q (Resource Group %q) - deallocating", size, id.Name, id.ResourceGroup)
+			details.shouldShutDown = true
+			details.shouldDeallocate = true
+		}
+	}
+
+	if d.HasChange("tags") {
+		details.shouldUpdate = true
+		details.update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	return &details, nil
+}
+
+// applyVirtualMachineUpdate applies the specified changes to the Virtual Machine - shutting down and deallocating the
+// Virtual Machine where required, and then starting it again if it was running beforehand
+func applyVirtualMachineUpdate(ctx context.Context, meta interface{}, id *parse.VirtualMachineID, details *virtualMachineUpdateDetails) error {
+	client := meta.(*clients.Client).Compute.VMClient
+	disksClient := meta.(*clients.Client).Compute.DisksClient
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, compute.InstanceView)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	shouldTurnBackOn := true
+	if props := existing.VirtualMachineProperties; props != nil {
+		if instanceView := props.InstanceView; instanceView != nil {
+			if statuses := instanceView.Statuses; statuses != nil {
+				for _, status := range *statuses {
+					if status.Code == nil {
+						continue
+					}
+
+					// could also be the provisioning state which we're not bothered with here
+					state := strings.ToLower(*status.Code)
+					if !strings.HasPrefix(state, "powerstate/") {
+						continue
+					}
+
+					state = strings.TrimPrefix(state, "powerstate/")
+					switch strings.ToLower(state) {
+					case "deallocated", "deallocating", "stopped", "stopping":
+						shouldTurnBackOn = false
+					}
+				}
+			}
+		}
+	}
+
+	if details.shouldShutDown {
+		log.Printf("[DEBUG] Shutting Down Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+		future, err := client.PowerOff(ctx, id.ResourceGroup, id.Name, utils.Bool(false))
+		if err != nil {
+			return fmt.Errorf("Error sending Power Off to Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Power Off of Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	if details.shouldDeallocate {
+		log.Printf("[DEBUG] Deallocating Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+		future, err := client.Deallocate(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("Error deallocating Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for deallocation of Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	if details.newOSDiskSizeGb > 0 {
+		if existing.VirtualMachineProperties == nil || existing.VirtualMachineProperties.StorageProfile == nil || existing.VirtualMachineProperties.StorageProfile.OsDisk == nil {
+			return fmt.Errorf("Error resizing OS Disk for Virtual Machine %q (Resource Group %q): `storageProfile.osDisk` was nil", id.Name, id.ResourceGroup)
+		}
+
+		osDisk := existing.VirtualMachineProperties.StorageProfile.OsDisk
+		if osDisk.ManagedDisk == nil || osDisk.ManagedDisk.ID == nil {
+			return fmt.Errorf("Error resizing OS Disk for Virtual Machine %q (Resource Group %q): `storageProfile.osDisk.managedDisk.id` was nil", id.Name, id.ResourceGroup)
+		}
+
+		diskId, err := parse.ParseManagedDiskID(*osDisk.ManagedDisk.ID)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Resizing OS Disk %q (Resource Group %q) to %dGB..", diskId.Name, diskId.ResourceGroup, details.newOSDiskSizeGb)
+		update := compute.DiskUpdate{
+			DiskUpdateProperties: &compute.DiskUpdateProperties{
+				DiskSizeGB: utils.Int32(int32(details.newOSDiskSizeGb)),
+			},
+		}
+
+		future, err := disksClient.Update(ctx, diskId.ResourceGroup, diskId.Name, update)
+		if err != nil {
+			return fmt.Errorf("Error resizing OS Disk %q (Resource Group %q): %+v", diskId.Name, diskId.ResourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, disksClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for resize of OS Disk %q (Resource Group %q): %+v", diskId.Name, diskId.ResourceGroup, err)
+		}
+	}
+
+	if details.shouldUpdate {
+		log.Printf("[DEBUG] Updating Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+		future, err := client.Update(ctx, id.ResourceGroup, id.Name, details.update)
+		if err != nil {
+			return fmt.Errorf("Error updating Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for update of Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	// only turn the Virtual Machine back on if it was running before we shut it down
+	if shouldTurnBackOn && (details.shouldShutDown || details.shouldDeallocate) {
+		log.Printf("[DEBUG] Starting Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+		future, err := client.Start(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("Error starting Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to start: %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteVirtualMachine deletes the Virtual Machine and the OS Disk which was created inline alongside it
+func deleteVirtualMachine(ctx context.Context, meta interface{}, id *parse.VirtualMachineID) error {
+	client := meta.(*clients.Client).Compute.VMClient
+	disksClient := meta.(*clients.Client).Compute.DisksClient
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	// the OS Disk is managed as part of this resource, so it needs to be removed once the Virtual Machine is gone
+	if props := existing.VirtualMachineProperties; props != nil && props.StorageProfile != nil && props.StorageProfile.OsDisk != nil {
+		if disk := props.StorageProfile.OsDisk.ManagedDisk; disk != nil && disk.ID != nil {
+			diskId, err := parse.ParseManagedDiskID(*disk.ID)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] Deleting OS Disk %q (Resource Group %q) from Virtual Machine %q..", diskId.Name, diskId.ResourceGroup, id.Name)
+			diskFuture, err := disksClient.Delete(ctx, diskId.ResourceGroup, diskId.Name)
+			if err != nil {
+				if response.WasNotFound(diskFuture.Response()) {
+					return nil
+				}
+
+				return fmt.Errorf("Error deleting OS Disk %q (Resource Group %q) for Virtual Machine %q: %+v", diskId.Name, diskId.ResourceGroup, id.Name, err)
+			}
+
+			if err := diskFuture.WaitForCompletionRef(ctx, disksClient.Client); err != nil {
+				if !response.WasNotFound(diskFuture.Response()) {
+					return fmt.Errorf("Error waiting for deletion of OS Disk %q (Resource Group %q) for Virtual Machine %q: %+v", diskId.Name, diskId.ResourceGroup, id.Name, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// virtualMachineDefaultComputerName returns the Computer Name if specified, otherwise the name of the Virtual Machine
+func virtualMachineDefaultComputerName(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("computer_name"); ok {
+		return v.(string)
+	}
+
+	return d.Get("name").(string)
+}
+
+// expandVirtualMachinePriority returns the Priority, Eviction Policy and Billing Profile for a Virtual Machine or
+// Virtual Machine Scale Set - since the `eviction_policy` and `max_bid_price` fields are only valid when using Low Priority
+func expandVirtualMachinePriority(d *schema.ResourceData) (compute.VirtualMachinePriorityTypes, compute.VirtualMachineEvictionPolicyTypes, *compute.BillingProfile, error) {
+	priority := compute.VirtualMachinePriorityTypes(d.Get("priority").(string))
+	evictionPolicy := d.Get("eviction_policy").(string)
+	maxBidPrice := d.Get("max_bid_price").(float64)
+
+	if priority != compute.Low {
+		if evictionPolicy != "" {
+			return "", "", nil, fmt.Errorf("An `eviction_policy` can only be specified when `priority` is set to `Low`")
+		}
+
+		if maxBidPrice != -1 {
+			return "", "", nil, fmt.Errorf("A `max_bid_price` can only be specified when `priority` is set to `Low`")
+		}
+
+		return priority, "", nil, nil
+	}
+
+	if evictionPolicy == "" {
+		return "", "", nil, fmt.Errorf("An `eviction_policy` must be specified when `priority` is set to `Low`")
+	}
+
+	billingProfile := &compute.BillingProfile{
+		MaxPrice: utils.Float(maxBidPrice),
+	}
+
+	return priority, compute.VirtualMachineEvictionPolicyTypes(evictionPolicy), billingProfile, nil
+}
+
+func flattenVirtualMachineMaxBidPrice(input *compute.BillingProfile) float64 {
+	// the API only returns the Billing Profile for Low Priority Virtual Machines
+	if input == nil || input.MaxPrice == nil {
+		return -1
+	}
+
+	return *input.MaxPrice
+}
@@ -0,0 +1,1271 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the schema, expand and flatten functions in this file are shared between the
+// `azurerm_linux_virtual_machine_scale_set` and `azurerm_windows_virtual_machine_scale_set` resources
+
+var virtualMachineScaleSetResourceName = "azurerm_virtual_machine_scale_set"
+
+// when rolling the instances within a Scale Set using the `Manual` Upgrade Mode the instances are updated
+// in batches of this percentage of the Scale Set - which matches the default used for Rolling Upgrades
+const virtualMachineScaleSetManualUpgradeMaxBatchInstancePercent = 20
+
+func virtualMachineScaleSetDataDiskSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"caching": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.CachingTypesNone),
+						string(compute.CachingTypesReadOnly),
+						string(compute.CachingTypesReadWrite),
+					}, false),
+				},
+
+				"disk_size_gb": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validateDiskSizeGB,
+				},
+
+				"lun": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 63),
+				},
+
+				"storage_account_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.StorageAccountTypesPremiumLRS),
+						string(compute.StorageAccountTypesStandardLRS),
+						string(compute.StorageAccountTypesStandardSSDLRS),
+					}, false),
+				},
+
+				"write_accelerator_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetDataDisks(input []interface{}) *[]compute.VirtualMachineScaleSetDataDisk {
+	disks := make([]compute.VirtualMachineScaleSetDataDisk, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		disks = append(disks, compute.VirtualMachineScaleSetDataDisk{
+			Caching:    compute.CachingTypes(raw["caching"].(string)),
+			DiskSizeGB: utils.Int32(int32(raw["disk_size_gb"].(int))),
+			Lun:        utils.Int32(int32(raw["lun"].(int))),
+			ManagedDisk: &compute.VirtualMachineScaleSetManagedDiskParameters{
+				StorageAccountType: compute.StorageAccountTypes(raw["storage_account_type"].(string)),
+			},
+			WriteAcceleratorEnabled: utils.Bool(raw["write_accelerator_enabled"].(bool)),
+
+			// Data Disks are created alongside each instance, so there's nothing to source them from
+			CreateOption: compute.DiskCreateOptionTypesEmpty,
+		})
+	}
+
+	return &disks
+}
+
+func flattenVirtualMachineScaleSetDataDisks(input *[]compute.VirtualMachineScaleSetDataDisk) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		diskSizeGb := 0
+		if v.DiskSizeGB != nil {
+			diskSizeGb = int(*v.DiskSizeGB)
+		}
+
+		lun := 0
+		if v.Lun != nil {
+			lun = int(*v.Lun)
+		}
+
+		storageAccountType := ""
+		if v.ManagedDisk != nil {
+			storageAccountType = string(v.ManagedDisk.StorageAccountType)
+		}
+
+		writeAcceleratorEnabled := false
+		if v.WriteAcceleratorEnabled != nil {
+			writeAcceleratorEnabled = *v.WriteAcceleratorEnabled
+		}
+
+		output = append(output, map[string]interface{}{
+			"caching":                   string(v.Caching),
+			"disk_size_gb":              diskSizeGb,
+			"lun":                       lun,
+			"storage_account_type":      storageAccountType,
+			"write_accelerator_enabled": writeAcceleratorEnabled,
+		})
+	}
+
+	return output
+}
+
+func expandVirtualMachineScaleSetIdentity(input []interface{}) (*compute.VirtualMachineScaleSetIdentity, error) {
+	if len(input) == 0 {
+		// the API requires that the Identity is explicitly set to None to remove it
+		return &compute.VirtualMachineScaleSetIdentity{
+			Type: compute.ResourceIdentityTypeNone,
+		}, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	identity := compute.VirtualMachineScaleSetIdentity{
+		Type: compute.ResourceIdentityType(raw["type"].(string)),
+	}
+
+	identityIds := raw["identity_ids"].(*schema.Set).List()
+	if len(identityIds) > 0 {
+		if identity.Type != compute.ResourceIdentityTypeUserAssigned && identity.Type != compute.ResourceIdentityTypeSystemAssignedUserAssigned {
+			return nil, fmt.Errorf("`identity_ids` can only be specified when `type` includes `UserAssigned`")
+		}
+
+		userAssignedIdentities := make(map[string]*compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue)
+		for _, id := range identityIds {
+			userAssignedIdentities[id.(string)] = &compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue{}
+		}
+		identity.UserAssignedIdentities = userAssignedIdentities
+	}
+
+	return &identity, nil
+}
+
+func flattenVirtualMachineScaleSetIdentity(input *compute.VirtualMachineScaleSetIdentity) []interface{} {
+	if input == nil || input.Type == compute.ResourceIdentityTypeNone {
+		return []interface{}{}
+	}
+
+	identityIds := make([]interface{}, 0)
+	for key := range input.UserAssignedIdentities {
+		identityIds = append(identityIds, key)
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(input.Type),
+			"identity_ids": schema.NewSet(schema.HashString, identityIds),
+			"principal_id": principalId,
+		},
+	}
+}
+
+func virtualMachineScaleSetNetworkInterfaceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"ip_configuration": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+
+							"application_gateway_backend_address_pool_ids": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: azure.ValidateResourceID,
+								},
+								Set: schema.HashString,
+							},
+
+							"application_security_group_ids": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: azure.ValidateResourceID,
+								},
+								Set:      schema.HashString,
+								MaxItems: 20,
+							},
+
+							"load_balancer_backend_address_pool_ids": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: azure.ValidateResourceID,
+								},
+								Set: schema.HashString,
+							},
+
+							"load_balancer_inbound_nat_rules_ids": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: azure.ValidateResourceID,
+								},
+								Set: schema.HashString,
+							},
+
+							"primary": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+
+							"subnet_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+
+							"version": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  string(compute.IPv4),
+								ValidateFunc: validation.StringInSlice([]string{
+									string(compute.IPv4),
+									string(compute.IPv6),
+								}, false),
+							},
+						},
+					},
+				},
+
+				"dns_servers": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validate.NoEmptyStrings,
+					},
+				},
+
+				"enable_accelerated_networking": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"enable_ip_forwarding": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"network_security_group_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+
+				"primary": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetNetworkInterfaces(input []interface{}) *[]compute.VirtualMachineScaleSetNetworkConfiguration {
+	output := make([]compute.VirtualMachineScaleSetNetworkConfiguration, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		ipConfigurations := make([]compute.VirtualMachineScaleSetIPConfiguration, 0)
+		for _, configRaw := range raw["ip_configuration"].([]interface{}) {
+			config := configRaw.(map[string]interface{})
+			ipConfigurations = append(ipConfigurations, expandVirtualMachineScaleSetIPConfiguration(config))
+		}
+
+		config := compute.VirtualMachineScaleSetNetworkConfiguration{
+			Name: utils.String(raw["name"].(string)),
+			VirtualMachineScaleSetNetworkConfigurationProperties: &compute.VirtualMachineScaleSetNetworkConfigurationProperties{
+				DNSSettings: &compute.VirtualMachineScaleSetNetworkConfigurationDNSSettings{
+					DNSServers: utils.ExpandStringSlice(raw["dns_servers"].([]interface{})),
+				},
+				EnableAcceleratedNetworking: utils.Bool(raw["enable_accelerated_networking"].(bool)),
+				EnableIPForwarding:          utils.Bool(raw["enable_ip_forwarding"].(bool)),
+				IPConfigurations:            &ipConfigurations,
+				Primary:                     utils.Bool(raw["primary"].(bool)),
+			},
+		}
+
+		if nsgId := raw["network_security_group_id"].(string); nsgId != "" {
+			config.VirtualMachineScaleSetNetworkConfigurationProperties.NetworkSecurityGroup = &compute.SubResource{
+				ID: utils.String(nsgId),
+			}
+		}
+
+		output = append(output, config)
+	}
+
+	return &output
+}
+
+func expandVirtualMachineScaleSetIPConfiguration(raw map[string]interface{}) compute.VirtualMachineScaleSetIPConfiguration {
+	ipConfiguration := compute.VirtualMachineScaleSetIPConfiguration{
+		Name: utils.String(raw["name"].(string)),
+		VirtualMachineScaleSetIPConfigurationProperties: &compute.VirtualMachineScaleSetIPConfigurationProperties{
+			Primary:                               utils.Bool(raw["primary"].(bool)),
+			PrivateIPAddressVersion:               compute.IPVersion(raw["version"].(string)),
+			ApplicationGatewayBackendAddressPools: expandIDsToSubResources(raw["application_gateway_backend_address_pool_ids"].(*schema.Set).List()),
+			ApplicationSecurityGroups:             expandIDsToSubResources(raw["application_security_group_ids"].(*schema.Set).List()),
+			LoadBalancerBackendAddressPools:       expandIDsToSubResources(raw["load_balancer_backend_address_pool_ids"].(*schema.Set).List()),
+			LoadBalancerInboundNatPools:           expandIDsToSubResources(raw["load_balancer_inbound_nat_rules_ids"].(*schema.Set).List()),
+		},
+	}
+
+	if subnetId := raw["subnet_id"].(string); subnetId != "" {
+		ipConfiguration.VirtualMachineScaleSetIPConfigurationProperties.Subnet = &compute.APIEntityReference{
+			ID: utils.String(subnetId),
+		}
+	}
+
+	return ipConfiguration
+}
+
+// expandVirtualMachineScaleSetNetworkInterfacesUpdate converts the Network Interfaces into the (otherwise identical) types used by the Update API
+func expandVirtualMachineScaleSetNetworkInterfacesUpdate(input []interface{}) *[]compute.VirtualMachineScaleSetUpdateNetworkConfiguration {
+	networkInterfaces := expandVirtualMachineScaleSetNetworkInterfaces(input)
+
+	output := make([]compute.VirtualMachineScaleSetUpdateNetworkConfiguration, 0)
+	for _, v := range *networkInterfaces {
+		props := v.VirtualMachineScaleSetNetworkConfigurationProperties
+
+		ipConfigurations := make([]compute.VirtualMachineScaleSetUpdateIPConfiguration, 0)
+		for _, ipConfig := range *props.IPConfigurations {
+			ipProps := ipConfig.VirtualMachineScaleSetIPConfigurationProperties
+			ipConfigurations = append(ipConfigurations, compute.VirtualMachineScaleSetUpdateIPConfiguration{
+				Name: ipConfig.Name,
+				VirtualMachineScaleSetUpdateIPConfigurationProperties: &compute.VirtualMachineScaleSetUpdateIPConfigurationProperties{
+					Subnet:                                ipProps.Subnet,
+					Primary:                               ipProps.Primary,
+					PrivateIPAddressVersion:               ipProps.PrivateIPAddressVersion,
+					ApplicationGatewayBackendAddressPools: ipProps.ApplicationGatewayBackendAddressPools,
+					ApplicationSecurityGroups:             ipProps.ApplicationSecurityGroups,
+					LoadBalancerBackendAddressPools:       ipProps.LoadBalancerBackendAddressPools,
+					LoadBalancerInboundNatPools:           ipProps.LoadBalancerInboundNatPools,
+				},
+			})
+		}
+
+		output = append(output, compute.VirtualMachineScaleSetUpdateNetworkConfiguration{
+			Name: v.Name,
+			VirtualMachineScaleSetUpdateNetworkConfigurationProperties: &compute.VirtualMachineScaleSetUpdateNetworkConfigurationProperties{
+				DNSSettings:                 props.DNSSettings,
+				EnableAcceleratedNetworking: props.EnableAcceleratedNetworking,
+				EnableIPForwarding:          props.EnableIPForwarding,
+				IPConfigurations:            &ipConfigurations,
+				NetworkSecurityGroup:        props.NetworkSecurityGroup,
+				Primary:                     props.Primary,
+			},
+		})
+	}
+
+	return &output
+}
+
+func flattenVirtualMachineScaleSetNetworkInterfaces(input *[]compute.VirtualMachineScaleSetNetworkConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		name := ""
+		if v.Name != nil {
+			name = *v.Name
+		}
+
+		dnsServers := make([]interface{}, 0)
+		enableAcceleratedNetworking := false
+		enableIPForwarding := false
+		networkSecurityGroupId := ""
+		primary := false
+		ipConfigurations := make([]interface{}, 0)
+
+		if props := v.VirtualMachineScaleSetNetworkConfigurationProperties; props != nil {
+			if props.DNSSettings != nil {
+				dnsServers = utils.FlattenStringSlice(props.DNSSettings.DNSServers)
+			}
+			if props.EnableAcceleratedNetworking != nil {
+				enableAcceleratedNetworking = *props.EnableAcceleratedNetworking
+			}
+			if props.EnableIPForwarding != nil {
+				enableIPForwarding = *props.EnableIPForwarding
+			}
+			if props.NetworkSecurityGroup != nil && props.NetworkSecurityGroup.ID != nil {
+				networkSecurityGroupId = *props.NetworkSecurityGroup.ID
+			}
+			if props.Primary != nil {
+				primary = *props.Primary
+			}
+
+			if props.IPConfigurations != nil {
+				for _, config := range *props.IPConfigurations {
+					ipConfigurations = append(ipConfigurations, flattenVirtualMachineScaleSetIPConfiguration(config))
+				}
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                          name,
+			"dns_servers":                   dnsServers,
+			"enable_accelerated_networking": enableAcceleratedNetworking,
+			"enable_ip_forwarding":          enableIPForwarding,
+			"ip_configuration":              ipConfigurations,
+			"network_security_group_id":     networkSecurityGroupId,
+			"primary":                       primary,
+		})
+	}
+
+	return results
+}
+
+func flattenVirtualMachineScaleSetIPConfiguration(input compute.VirtualMachineScaleSetIPConfiguration) map[string]interface{} {
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	output := map[string]interface{}{
+		"name":    name,
+		"primary": false,
+		"version": string(compute.IPv4),
+		"application_gateway_backend_address_pool_ids": schema.NewSet(schema.HashString, []interface{}{}),
+		"application_security_group_ids":               schema.NewSet(schema.HashString, []interface{}{}),
+		"load_balancer_backend_address_pool_ids":       schema.NewSet(schema.HashString, []interface{}{}),
+		"load_balancer_inbound_nat_rules_ids":          schema.NewSet(schema.HashString, []interface{}{}),
+		"subnet_id":                                    "",
+	}
+
+	props := input.VirtualMachineScaleSetIPConfigurationProperties
+	if props == nil {
+		return output
+	}
+
+	if props.Primary != nil {
+		output["primary"] = *props.Primary
+	}
+	if props.PrivateIPAddressVersion != "" {
+		output["version"] = string(props.PrivateIPAddressVersion)
+	}
+	if props.Subnet != nil && props.Subnet.ID != nil {
+		output["subnet_id"] = *props.Subnet.ID
+	}
+
+	output["application_gateway_backend_address_pool_ids"] = schema.NewSet(schema.HashString, flattenSubResourcesToIDs(props.ApplicationGatewayBackendAddressPools))
+	output["application_security_group_ids"] = schema.NewSet(schema.HashString, flattenSubResourcesToIDs(props.ApplicationSecurityGroups))
+	output["load_balancer_backend_address_pool_ids"] = schema.NewSet(schema.HashString, flattenSubResourcesToIDs(props.LoadBalancerBackendAddressPools))
+	output["load_balancer_inbound_nat_rules_ids"] = schema.NewSet(schema.HashString, flattenSubResourcesToIDs(props.LoadBalancerInboundNatPools))
+
+	return output
+}
+
+func virtualMachineScaleSetOSDiskSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"caching": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.CachingTypesNone),
+						string(compute.CachingTypesReadOnly),
+						string(compute.CachingTypesReadWrite),
+					}, false),
+				},
+
+				"storage_account_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.StorageAccountTypesPremiumLRS),
+						string(compute.StorageAccountTypesStandardLRS),
+						string(compute.StorageAccountTypesStandardSSDLRS),
+					}, false),
+				},
+
+				"disk_size_gb": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validateDiskSizeGB,
+				},
+
+				"write_accelerator_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetOSDisk(input []interface{}, osType compute.OperatingSystemTypes) *compute.VirtualMachineScaleSetOSDisk {
+	raw := input[0].(map[string]interface{})
+	disk := compute.VirtualMachineScaleSetOSDisk{
+		Caching: compute.CachingTypes(raw["caching"].(string)),
+		ManagedDisk: &compute.VirtualMachineScaleSetManagedDiskParameters{
+			StorageAccountType: compute.StorageAccountTypes(raw["storage_account_type"].(string)),
+		},
+		WriteAcceleratorEnabled: utils.Bool(raw["write_accelerator_enabled"].(bool)),
+
+		// these have to be hard-coded so there's no point exposing them
+		CreateOption: compute.DiskCreateOptionTypesFromImage,
+		OsType:       osType,
+	}
+
+	if size := raw["disk_size_gb"].(int); size > 0 {
+		disk.DiskSizeGB = utils.Int32(int32(size))
+	}
+
+	return &disk
+}
+
+func expandVirtualMachineScaleSetOSDiskUpdate(input []interface{}) *compute.VirtualMachineScaleSetUpdateOSDisk {
+	raw := input[0].(map[string]interface{})
+	disk := compute.VirtualMachineScaleSetUpdateOSDisk{
+		Caching:                 compute.CachingTypes(raw["caching"].(string)),
+		WriteAcceleratorEnabled: utils.Bool(raw["write_accelerator_enabled"].(bool)),
+	}
+
+	if size := raw["disk_size_gb"].(int); size > 0 {
+		disk.DiskSizeGB = utils.Int32(int32(size))
+	}
+
+	return &disk
+}
+
+func flattenVirtualMachineScaleSetOSDisk(input *compute.VirtualMachineScaleSetOSDisk) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	diskSizeGb := 0
+	if input.DiskSizeGB != nil {
+		diskSizeGb = int(*input.DiskSizeGB)
+	}
+
+	storageAccountType := ""
+	if input.ManagedDisk != nil {
+		storageAccountType = string(input.ManagedDisk.StorageAccountType)
+	}
+
+	writeAcceleratorEnabled := false
+	if input.WriteAcceleratorEnabled != nil {
+		writeAcceleratorEnabled = *input.WriteAcceleratorEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"caching":                   string(input.Caching),
+			"disk_size_gb":              diskSizeGb,
+			"storage_account_type":      storageAccountType,
+			"write_accelerator_enabled": writeAcceleratorEnabled,
+		},
+	}
+}
+
+func virtualMachineScaleSetSourceImageReferenceSchema() *schema.Schema {
+	// unlike a Virtual Machine the image used by a Scale Set can be changed, with the instances then being upgraded
+	s := virtualMachineSourceImageReferenceSchema()
+	s.ForceNew = false
+	for _, v := range s.Elem.(*schema.Resource).Schema {
+		v.ForceNew = false
+	}
+	return s
+}
+
+func virtualMachineScaleSetUpgradeModeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  string(compute.Manual),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(compute.Automatic),
+			string(compute.Manual),
+			string(compute.Rolling),
+		}, false),
+	}
+}
+
+func virtualMachineScaleSetRollingUpgradePolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_batch_instance_percent": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(5, 100),
+				},
+
+				"max_unhealthy_instance_percent": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(5, 100),
+				},
+
+				"max_unhealthy_upgraded_instance_percent": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(5, 100),
+				},
+
+				"pause_time_between_batches": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.ISO8601Duration,
+				},
+			},
+		},
+	}
+}
+
+// expandVirtualMachineScaleSetUpgradePolicy returns the Upgrade Policy for the Scale Set - where a `rolling_upgrade_policy`
+// can only be specified when using the `Rolling` Upgrade Mode (and must be specified in that case)
+func expandVirtualMachineScaleSetUpgradePolicy(d *schema.ResourceData) (*compute.UpgradePolicy, error) {
+	upgradeMode := compute.UpgradeMode(d.Get("upgrade_mode").(string))
+	rollingUpgradePolicyRaw := d.Get("rolling_upgrade_policy").([]interface{})
+
+	policy := compute.UpgradePolicy{
+		Mode: upgradeMode,
+	}
+
+	if upgradeMode != compute.Rolling {
+		if len(rollingUpgradePolicyRaw) > 0 {
+			return nil, fmt.Errorf("A `rolling_upgrade_policy` can only be specified when `upgrade_mode` is set to `Rolling`")
+		}
+
+		return &policy, nil
+	}
+
+	if len(rollingUpgradePolicyRaw) == 0 {
+		return nil, fmt.Errorf("A `rolling_upgrade_policy` must be specified when `upgrade_mode` is set to `Rolling`")
+	}
+
+	raw := rollingUpgradePolicyRaw[0].(map[string]interface{})
+	policy.RollingUpgradePolicy = &compute.RollingUpgradePolicy{
+		MaxBatchInstancePercent:             utils.Int32(int32(raw["max_batch_instance_percent"].(int))),
+		MaxUnhealthyInstancePercent:         utils.Int32(int32(raw["max_unhealthy_instance_percent"].(int))),
+		MaxUnhealthyUpgradedInstancePercent: utils.Int32(int32(raw["max_unhealthy_upgraded_instance_percent"].(int))),
+		PauseTimeBetweenBatches:             utils.String(raw["pause_time_between_batches"].(string)),
+	}
+
+	return &policy, nil
+}
+
+func flattenVirtualMachineScaleSetRollingUpgradePolicy(input *compute.RollingUpgradePolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	maxBatchInstancePercent := 0
+	if input.MaxBatchInstancePercent != nil {
+		maxBatchInstancePercent = int(*input.MaxBatchInstancePercent)
+	}
+
+	maxUnhealthyInstancePercent := 0
+	if input.MaxUnhealthyInstancePercent != nil {
+		maxUnhealthyInstancePercent = int(*input.MaxUnhealthyInstancePercent)
+	}
+
+	maxUnhealthyUpgradedInstancePercent := 0
+	if input.MaxUnhealthyUpgradedInstancePercent != nil {
+		maxUnhealthyUpgradedInstancePercent = int(*input.MaxUnhealthyUpgradedInstancePercent)
+	}
+
+	pauseTimeBetweenBatches := ""
+	if input.PauseTimeBetweenBatches != nil {
+		pauseTimeBetweenBatches = *input.PauseTimeBetweenBatches
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"max_batch_instance_percent":              maxBatchInstancePercent,
+			"max_unhealthy_instance_percent":          maxUnhealthyInstancePercent,
+			"max_unhealthy_upgraded_instance_percent": maxUnhealthyUpgradedInstancePercent,
+			"pause_time_between_batches":              pauseTimeBetweenBatches,
+		},
+	}
+}
+
+// virtualMachineScaleSetUpdateDetails describes the changes to a Virtual Machine Scale Set which need to be applied
+type virtualMachineScaleSetUpdateDetails struct {
+	update compute.VirtualMachineScaleSetUpdate
+
+	// updateInstances specifies whether the Virtual Machine Profile has changed, meaning that the
+	// existing instances within the Scale Set need to be upgraded to the latest model
+	updateInstances bool
+}
+
+// expandVirtualMachineScaleSetUpdateDetails determines the changes common to both the Linux and Windows Virtual Machine Scale Set resources
+func expandVirtualMachineScaleSetUpdateDetails(d *schema.ResourceData) (*virtualMachineScaleSetUpdateDetails, error) {
+	details := virtualMachineScaleSetUpdateDetails{
+		update: compute.VirtualMachineScaleSetUpdate{
+			VirtualMachineScaleSetUpdateProperties: &compute.VirtualMachineScaleSetUpdateProperties{},
+		},
+	}
+	updateProps := details.update.VirtualMachineScaleSetUpdateProperties
+	profile := compute.VirtualMachineScaleSetUpdateVMProfile{}
+	profileChanged := false
+
+	if d.HasChange("boot_diagnostics") {
+		profile.DiagnosticsProfile = expandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{}))
+		profileChanged = true
+	}
+
+	if d.HasChange("data_disk") || d.HasChange("os_disk") || d.HasChange("source_image_id") || d.HasChange("source_image_reference") {
+		storageProfile := compute.VirtualMachineScaleSetUpdateStorageProfile{}
+
+		if d.HasChange("data_disk") {
+			storageProfile.DataDisks = expandVirtualMachineScaleSetDataDisks(d.Get("data_disk").([]interface{}))
+		}
+
+		if d.HasChange("os_disk") {
+			storageProfile.OsDisk = expandVirtualMachineScaleSetOSDiskUpdate(d.Get("os_disk").([]interface{}))
+		}
+
+		if d.HasChange("source_image_id") || d.HasChange("source_image_reference") {
+			sourceImageReference, err := expandVirtualMachineSourceImageReference(d.Get("source_image_reference").([]interface{}), d.Get("source_image_id").(string))
+			if err != nil {
+				return nil, err
+			}
+			storageProfile.ImageReference = sourceImageReference
+		}
+
+		profile.StorageProfile = &storageProfile
+		profileChanged = true
+	}
+
+	if d.HasChange("max_bid_price") {
+		_, _, billingProfile, err := expandVirtualMachinePriority(d)
+		if err != nil {
+			return nil, err
+		}
+
+		profile.BillingProfile = billingProfile
+		profileChanged = true
+	}
+
+	if d.HasChange("network_interface") {
+		profile.NetworkProfile = &compute.VirtualMachineScaleSetUpdateNetworkProfile{
+			NetworkInterfaceConfigurations: expandVirtualMachineScaleSetNetworkInterfacesUpdate(d.Get("network_interface").([]interface{})),
+		}
+		profileChanged = true
+	}
+
+	if profileChanged {
+		updateProps.VirtualMachineProfile = &profile
+		details.updateInstances = true
+	}
+
+	if d.HasChange("identity") {
+		identity, err := expandVirtualMachineScaleSetIdentity(d.Get("identity").([]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("Error expanding `identity`: %+v", err)
+		}
+
+		details.update.Identity = identity
+	}
+
+	if d.HasChange("overprovision") {
+		updateProps.Overprovision = utils.Bool(d.Get("overprovision").(bool))
+	}
+
+	if d.HasChange("single_placement_group") {
+		updateProps.SinglePlacementGroup = utils.Bool(d.Get("single_placement_group").(bool))
+	}
+
+	if d.HasChange("instances") || d.HasChange("sku") {
+		details.update.Sku = &compute.Sku{
+			Name:     utils.String(d.Get("sku").(string)),
+			Capacity: utils.Int64(int64(d.Get("instances").(int))),
+		}
+
+		// changing the SKU requires that the instances are re-created
+		if d.HasChange("sku") {
+			details.updateInstances = true
+		}
+	}
+
+	if d.HasChange("upgrade_mode") || d.HasChange("rolling_upgrade_policy") {
+		upgradePolicy, err := expandVirtualMachineScaleSetUpgradePolicy(d)
+		if err != nil {
+			return nil, err
+		}
+
+		updateProps.UpgradePolicy = upgradePolicy
+	}
+
+	if d.HasChange("tags") {
+		details.update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	return &details, nil
+}
+
+// applyVirtualMachineScaleSetUpdate applies the specified changes to the Virtual Machine Scale Set - and then, where the
+// Virtual Machine Profile has changed, ensures the existing instances are upgraded to the latest model
+func applyVirtualMachineScaleSetUpdate(ctx context.Context, meta interface{}, id *parse.VirtualMachineScaleSetID, details *virtualMachineScaleSetUpdateDetails, upgradeMode compute.UpgradeMode) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetClient
+
+	if details.update.Tags != nil {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		details.update.Tags = withVirtualMachineScaleSetExtensionsTag(details.update.Tags, existing.Tags)
+	}
+
+	log.Printf("[DEBUG] Updating Virtual Machine Scale Set %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	updateStarted := time.Now()
+	future, err := client.Update(ctx, id.ResourceGroup, id.Name, details.update)
+	if err != nil {
+		return fmt.Errorf("Error updating Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Updated Virtual Machine Scale Set %q (Resource Group %q).", id.Name, id.ResourceGroup)
+
+	if !details.updateInstances {
+		return nil
+	}
+
+	return upgradeVirtualMachineScaleSetInstances(ctx, meta, id, upgradeMode, updateStarted)
+}
+
+// upgradeVirtualMachineScaleSetInstances ensures the instances within the Virtual Machine Scale Set are upgraded to
+// the latest model after it's been changed (at `updateStarted`), depending on the Upgrade Mode in use
+func upgradeVirtualMachineScaleSetInstances(ctx context.Context, meta interface{}, id *parse.VirtualMachineScaleSetID, upgradeMode compute.UpgradeMode, updateStarted time.Time) error {
+	switch upgradeMode {
+	case compute.Automatic:
+		// the instances are upgraded automatically (and all at once) by Azure
+		log.Printf("[DEBUG] Virtual Machine Scale Set %q (Resource Group %q) uses the `Automatic` Upgrade Mode - instances will be upgraded by Azure", id.Name, id.ResourceGroup)
+		return nil
+
+	case compute.Manual:
+		if !meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesWhenRequired {
+			log.Printf("[DEBUG] Virtual Machine Scale Set %q (Resource Group %q) uses the `Manual` Upgrade Mode and `roll_instances_when_required` is disabled - skipping upgrading the instances", id.Name, id.ResourceGroup)
+			return nil
+		}
+
+		return rollVirtualMachineScaleSetInstances(ctx, meta, id)
+
+	case compute.Rolling:
+		// Azure starts a Rolling Upgrade when the model changes, so we need to wait for that to complete
+		return waitForVirtualMachineScaleSetRollingUpgrade(ctx, meta, id, updateStarted)
+	}
+
+	return nil
+}
+
+// virtualMachineScaleSetInstance describes the state of a single instance within a Virtual Machine Scale Set
+type virtualMachineScaleSetInstance struct {
+	instanceId         string
+	latestModelApplied bool
+	failed             bool
+	healthy            bool
+}
+
+// listVirtualMachineScaleSetInstances returns the current state of each of the instances within the Virtual Machine Scale Set
+func listVirtualMachineScaleSetInstances(ctx context.Context, client *compute.VirtualMachineScaleSetVMsClient, id *parse.VirtualMachineScaleSetID) ([]virtualMachineScaleSetInstance, error) {
+	results := make([]virtualMachineScaleSetInstance, 0)
+
+	iterator, err := client.ListComplete(ctx, id.ResourceGroup, id.Name, "", "", string(compute.InstanceView))
+	if err != nil {
+		return nil, fmt.Errorf("Error listing the instances within Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	for iterator.NotDone() {
+		vm := iterator.Value()
+		if vm.InstanceID != nil {
+			instance := virtualMachineScaleSetInstance{
+				instanceId: *vm.InstanceID,
+				// instances which don't report their health (e.g. where there's no Health Probe or Application Health Extension) are treated as healthy
+				healthy: true,
+			}
+
+			if props := vm.VirtualMachineScaleSetVMProperties; props != nil {
+				if props.LatestModelApplied != nil {
+					instance.latestModelApplied = *props.LatestModelApplied
+				}
+
+				if props.ProvisioningState != nil {
+					instance.failed = strings.EqualFold(*props.ProvisioningState, "Failed")
+				}
+
+				if view := props.InstanceView; view != nil && view.VMHealth != nil && view.VMHealth.Status != nil && view.VMHealth.Status.Code != nil {
+					instance.healthy = strings.EqualFold(*view.VMHealth.Status.Code, "HealthState/healthy")
+				}
+			}
+
+			results = append(results, instance)
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("Error listing the instances within Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return results, nil
+}
+
+// rollVirtualMachineScaleSetInstances upgrades the instances within a Virtual Machine Scale Set using the `Manual`
+// Upgrade Mode to the latest model in batches - waiting for each batch to become healthy before continuing
+func rollVirtualMachineScaleSetInstances(ctx context.Context, meta interface{}, id *parse.VirtualMachineScaleSetID) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetClient
+	vmsClient := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+
+	instances, err := listVirtualMachineScaleSetInstances(ctx, vmsClient, id)
+	if err != nil {
+		return err
+	}
+
+	instanceIds := make([]string, 0)
+	for _, instance := range instances {
+		if !instance.latestModelApplied {
+			instanceIds = append(instanceIds, instance.instanceId)
+		}
+	}
+
+	if len(instanceIds) == 0 {
+		log.Printf("[DEBUG] All instances within Virtual Machine Scale Set %q (Resource Group %q) are running the latest model", id.Name, id.ResourceGroup)
+		return nil
+	}
+
+	for _, batch := range batchVirtualMachineScaleSetInstanceIds(instanceIds, len(instances)) {
+		log.Printf("[DEBUG] Upgrading instances %q within Virtual Machine Scale Set %q (Resource Group %q) to the latest model..", strings.Join(batch, ", "), id.Name, id.ResourceGroup)
+		input := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+			InstanceIds: &batch,
+		}
+		future, err := client.UpdateInstances(ctx, id.ResourceGroup, id.Name, input)
+		if err != nil {
+			return fmt.Errorf("Error upgrading instances %q within Virtual Machine Scale Set %q (Resource Group %q): %+v", strings.Join(batch, ", "), id.Name, id.ResourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			failedIds := make([]string, 0)
+			if instances, listErr := listVirtualMachineScaleSetInstances(ctx, vmsClient, id); listErr == nil {
+				failedIds, _ = filterVirtualMachineScaleSetInstances(instances, batch)
+			}
+			return fmt.Errorf("Error waiting for instances %q within Virtual Machine Scale Set %q (Resource Group %q) to be upgraded (failed instances: %q): %+v", strings.Join(batch, ", "), id.Name, id.ResourceGroup, strings.Join(failedIds, ", "), err)
+		}
+
+		if err := waitForVirtualMachineScaleSetInstancesToBeHealthy(ctx, vmsClient, id, batch); err != nil {
+			return err
+		}
+		log.Printf("[DEBUG] Upgraded instances %q within Virtual Machine Scale Set %q (Resource Group %q).", strings.Join(batch, ", "), id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+// batchVirtualMachineScaleSetInstanceIds splits the specified instance ID's into batches, each containing at most
+// `virtualMachineScaleSetManualUpgradeMaxBatchInstancePercent` percent of the total number of instances (rounded up)
+func batchVirtualMachineScaleSetInstanceIds(instanceIds []string, totalInstances int) [][]string {
+	batchSize := int(math.Ceil(float64(totalInstances*virtualMachineScaleSetManualUpgradeMaxBatchInstancePercent) / 100))
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	batches := make([][]string, 0)
+	for start := 0; start < len(instanceIds); start += batchSize {
+		end := start + batchSize
+		if end > len(instanceIds) {
+			end = len(instanceIds)
+		}
+		batches = append(batches, instanceIds[start:end])
+	}
+
+	return batches
+}
+
+// filterVirtualMachineScaleSetInstances returns the ID's of the specified instances which have failed and which are unhealthy
+func filterVirtualMachineScaleSetInstances(instances []virtualMachineScaleSetInstance, instanceIds []string) ([]string, []string) {
+	failed := make([]string, 0)
+	unhealthy := make([]string, 0)
+
+	lookup := make(map[string]struct{}, len(instanceIds))
+	for _, id := range instanceIds {
+		lookup[id] = struct{}{}
+	}
+
+	for _, instance := range instances {
+		if _, ok := lookup[instance.instanceId]; !ok {
+			continue
+		}
+
+		if instance.failed {
+			failed = append(failed, instance.instanceId)
+			continue
+		}
+
+		if !instance.healthy {
+			unhealthy = append(unhealthy, instance.instanceId)
+		}
+	}
+
+	sort.Strings(failed)
+	sort.Strings(unhealthy)
+	return failed, unhealthy
+}
+
+func waitForVirtualMachineScaleSetInstancesToBeHealthy(ctx context.Context, client *compute.VirtualMachineScaleSetVMsClient, id *parse.VirtualMachineScaleSetID, instanceIds []string) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("Error waiting for instances within Virtual Machine Scale Set %q (Resource Group %q) to become healthy: context had no deadline", id.Name, id.ResourceGroup)
+	}
+
+	// the Refresh func is run in a separate goroutine, so access to the unhealthy instances needs to be guarded
+	var lock sync.Mutex
+	unhealthyIds := make([]string, 0)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Unhealthy"},
+		Target:     []string{"Healthy"},
+		Timeout:    time.Until(deadline),
+		MinTimeout: 15 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			instances, err := listVirtualMachineScaleSetInstances(ctx, client, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			failedIds, unhealthy := filterVirtualMachineScaleSetInstances(instances, instanceIds)
+			lock.Lock()
+			unhealthyIds = unhealthy
+			lock.Unlock()
+
+			if len(failedIds) > 0 {
+				return nil, "", fmt.Errorf("instances %q failed to be upgraded", strings.Join(failedIds, ", "))
+			}
+
+			if len(unhealthy) > 0 {
+				log.Printf("[DEBUG] Waiting for instances %q within Virtual Machine Scale Set %q (Resource Group %q) to become healthy..", strings.Join(unhealthy, ", "), id.Name, id.ResourceGroup)
+				return instances, "Unhealthy", nil
+			}
+
+			return instances, "Healthy", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		lock.Lock()
+		defer lock.Unlock()
+		return fmt.Errorf("Error waiting for instances within Virtual Machine Scale Set %q (Resource Group %q) to become healthy (unhealthy instances: %q): %+v", id.Name, id.ResourceGroup, strings.Join(unhealthyIds, ", "), err)
+	}
+
+	return nil
+}
+
+// waitForVirtualMachineScaleSetRollingUpgrade waits for the Rolling Upgrade started by Azure when the model of a
+// Virtual Machine Scale Set using the `Rolling` Upgrade Mode changes to complete - surfacing any instances which failed
+func waitForVirtualMachineScaleSetRollingUpgrade(ctx context.Context, meta interface{}, id *parse.VirtualMachineScaleSetID, updateStarted time.Time) error {
+	rollingUpgradesClient := meta.(*clients.Client).Compute.VMScaleSetRollingUpgradesClient
+	vmsClient := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("Error waiting for the Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q): context had no deadline", id.Name, id.ResourceGroup)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Upgrading"},
+		Target:     []string{"Completed"},
+		Timeout:    time.Until(deadline),
+		MinTimeout: 30 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			instances, err := listVirtualMachineScaleSetInstances(ctx, vmsClient, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			allInstanceIds := make([]string, 0)
+			outdatedIds := make([]string, 0)
+			for _, instance := range instances {
+				allInstanceIds = append(allInstanceIds, instance.instanceId)
+				if !instance.latestModelApplied {
+					outdatedIds = append(outdatedIds, instance.instanceId)
+				}
+			}
+			sort.Strings(outdatedIds)
+			failedIds, unhealthyIds := filterVirtualMachineScaleSetInstances(instances, allInstanceIds)
+
+			latest, err := rollingUpgradesClient.GetLatest(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				// there's no Rolling Upgrade until one's been started
+				if !utils.ResponseWasNotFound(latest.Response) {
+					return nil, "", fmt.Errorf("Error retrieving the latest Rolling Upgrade: %+v", err)
+				}
+			}
+
+			if props := latest.RollingUpgradeStatusInfoProperties; props != nil && props.RunningStatus != nil {
+				status := props.RunningStatus
+				startedDuringUpdate := status.StartTime != nil && !status.StartTime.Time.Before(updateStarted)
+				if startedDuringUpdate {
+					switch status.Code {
+					case compute.RollingUpgradeStatusCodeCancelled, compute.RollingUpgradeStatusCodeFaulted:
+						message := ""
+						if props.Error != nil && props.Error.Message != nil {
+							message = *props.Error.Message
+						}
+						return nil, "", fmt.Errorf("the Rolling Upgrade was %s (failed instances: %q / unhealthy instances: %q): %s", strings.ToLower(string(status.Code)), strings.Join(failedIds, ", "), strings.Join(unhealthyIds, ", "), message)
+
+					case compute.RollingUpgradeStatusCodeCompleted:
+						// once the Rolling Upgrade has completed there's nothing further to wait for - so any
+						// instances which aren't running the latest model at this point won't be upgraded
+						if len(outdatedIds) > 0 || len(failedIds) > 0 {
+							return nil, "", fmt.Errorf("the Rolling Upgrade completed but not all instances were upgraded (outdated instances: %q / failed instances: %q)", strings.Join(outdatedIds, ", "), strings.Join(failedIds, ", "))
+						}
+
+						return latest, "Completed", nil
+
+					case compute.RollingUpgradeStatusCodeRollingForward:
+						log.Printf("[DEBUG] Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) is in progress..", id.Name, id.ResourceGroup)
+						return latest, "Upgrading", nil
+					}
+				}
+			}
+
+			if len(outdatedIds) > 0 {
+				log.Printf("[DEBUG] Waiting for instances %q within Virtual Machine Scale Set %q (Resource Group %q) to be upgraded..", strings.Join(outdatedIds, ", "), id.Name, id.ResourceGroup)
+				return latest, "Upgrading", nil
+			}
+
+			return latest, "Completed", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for the Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+// deleteVirtualMachineScaleSet deletes the Virtual Machine Scale Set, including all of the instances within it
+func deleteVirtualMachineScaleSet(ctx context.Context, meta interface{}, id *parse.VirtualMachineScaleSetID) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetClient
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// virtualMachineScaleSetDefaultComputerNamePrefix returns the Computer Name Prefix if specified, otherwise the name of the Scale Set
+func virtualMachineScaleSetDefaultComputerNamePrefix(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("computer_name_prefix"); ok {
+		return v.(string)
+	}
+
+	return d.Get("name").(string)
+}
+
+func expandIDsToSubResources(input []interface{}) *[]compute.SubResource {
+	ids := make([]compute.SubResource, 0)
+
+	for _, v := range input {
+		ids = append(ids, compute.SubResource{
+			ID: utils.String(v.(string)),
+		})
+	}
+
+	return &ids
+}
+
+func flattenSubResourcesToIDs(input *[]compute.SubResource) []interface{} {
+	ids := make([]interface{}, 0)
+	if input == nil {
+		return ids
+	}
+
+	for _, v := range *input {
+		if v.ID == nil {
+			continue
+		}
+
+		ids = append(ids, *v.ID)
+	}
+
+	return ids
+}
@@ -0,0 +1,135 @@
+package compute
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBatchVirtualMachineScaleSetInstanceIds(t *testing.T) {
+	cases := []struct {
+		Name           string
+		InstanceIds    []string
+		TotalInstances int
+		Expected       [][]string
+	}{
+		{
+			Name:           "no instances",
+			InstanceIds:    []string{},
+			TotalInstances: 0,
+			Expected:       [][]string{},
+		},
+		{
+			Name:           "single instance",
+			InstanceIds:    []string{"0"},
+			TotalInstances: 1,
+			Expected:       [][]string{{"0"}},
+		},
+		{
+			Name:           "batch size is rounded up",
+			InstanceIds:    []string{"0", "1", "2"},
+			TotalInstances: 3,
+			Expected:       [][]string{{"0"}, {"1"}, {"2"}},
+		},
+		{
+			Name:           "batch size is a percentage of all instances",
+			InstanceIds:    []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
+			TotalInstances: 10,
+			Expected:       [][]string{{"0", "1"}, {"2", "3"}, {"4", "5"}, {"6", "7"}, {"8", "9"}},
+		},
+		{
+			Name:           "only some instances are outdated",
+			InstanceIds:    []string{"3", "5", "7"},
+			TotalInstances: 10,
+			Expected:       [][]string{{"3", "5"}, {"7"}},
+		},
+		{
+			Name:           "last batch is smaller",
+			InstanceIds:    []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+			TotalInstances: 11,
+			Expected:       [][]string{{"0", "1", "2"}, {"3", "4", "5"}, {"6", "7", "8"}, {"9", "10"}},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := batchVirtualMachineScaleSetInstanceIds(v.InstanceIds, v.TotalInstances)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestFilterVirtualMachineScaleSetInstances(t *testing.T) {
+	cases := []struct {
+		Name              string
+		Instances         []virtualMachineScaleSetInstance
+		InstanceIds       []string
+		ExpectedFailed    []string
+		ExpectedUnhealthy []string
+	}{
+		{
+			Name:              "no instances",
+			Instances:         []virtualMachineScaleSetInstance{},
+			InstanceIds:       []string{"0"},
+			ExpectedFailed:    []string{},
+			ExpectedUnhealthy: []string{},
+		},
+		{
+			Name: "all healthy",
+			Instances: []virtualMachineScaleSetInstance{
+				{instanceId: "0", healthy: true},
+				{instanceId: "1", healthy: true},
+			},
+			InstanceIds:       []string{"0", "1"},
+			ExpectedFailed:    []string{},
+			ExpectedUnhealthy: []string{},
+		},
+		{
+			Name: "failed instances aren't reported as unhealthy",
+			Instances: []virtualMachineScaleSetInstance{
+				{instanceId: "0", healthy: true},
+				{instanceId: "1", failed: true},
+				{instanceId: "2", healthy: false},
+			},
+			InstanceIds:       []string{"0", "1", "2"},
+			ExpectedFailed:    []string{"1"},
+			ExpectedUnhealthy: []string{"2"},
+		},
+		{
+			Name: "instances which weren't specified are ignored",
+			Instances: []virtualMachineScaleSetInstance{
+				{instanceId: "0", failed: true},
+				{instanceId: "1", healthy: false},
+				{instanceId: "2", healthy: false},
+			},
+			InstanceIds:       []string{"2"},
+			ExpectedFailed:    []string{},
+			ExpectedUnhealthy: []string{"2"},
+		},
+		{
+			Name: "results are sorted",
+			Instances: []virtualMachineScaleSetInstance{
+				{instanceId: "4", failed: true},
+				{instanceId: "3", healthy: false},
+				{instanceId: "2", failed: true},
+				{instanceId: "1", healthy: false},
+			},
+			InstanceIds:       []string{"1", "2", "3", "4"},
+			ExpectedFailed:    []string{"2", "4"},
+			ExpectedUnhealthy: []string{"1", "3"},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		failed, unhealthy := filterVirtualMachineScaleSetInstances(v.Instances, v.InstanceIds)
+		if !reflect.DeepEqual(failed, v.ExpectedFailed) {
+			t.Fatalf("Expected failed instances %+v but got %+v", v.ExpectedFailed, failed)
+		}
+		if !reflect.DeepEqual(unhealthy, v.ExpectedUnhealthy) {
+			t.Fatalf("Expected unhealthy instances %+v but got %+v", v.ExpectedUnhealthy, unhealthy)
+		}
+	}
+}
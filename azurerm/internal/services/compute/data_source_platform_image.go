@@ -1,10 +1,12 @@
-package azurerm
+package compute
 
 import (
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -38,8 +40,9 @@ func dataSourceArmPlatformImage() *schema.Resource {
 }
 
 func dataSourceArmPlatformImageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).compute.VMImageClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Compute.VMImageClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	publisher := d.Get("publisher").(string)
@@ -1,4 +1,4 @@
-package azurerm
+package compute
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AvailabilitySetID is a parsed Availability Set ID
+type AvailabilitySetID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewAvailabilitySetID returns a new AvailabilitySetID for the specified values
+func NewAvailabilitySetID(subscriptionId, resourceGroup, name string) AvailabilitySetID {
+	return AvailabilitySetID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Availability Set ID
+func (id AvailabilitySetID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/availabilitySets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseAvailabilitySetID parses a Availability Set ID into a AvailabilitySetID struct
+func ParseAvailabilitySetID(input string) (*AvailabilitySetID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Availability Set ID %q: %+v", input, err)
+	}
+
+	resourceId := AvailabilitySetID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Compute"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("availabilitySets"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAvailabilitySetID validates that the specified value is a Availability Set ID
+func ValidateAvailabilitySetID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseAvailabilitySetID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Availability Set ID: %+v", k, err))
+	}
+
+	return
+}
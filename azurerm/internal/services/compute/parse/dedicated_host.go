@@ -0,0 +1,82 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DedicatedHostID is a parsed Dedicated Host ID
+type DedicatedHostID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	HostGroupName  string
+	Name           string
+}
+
+// NewDedicatedHostID returns a new DedicatedHostID for the specified values
+func NewDedicatedHostID(subscriptionId, resourceGroup, hostGroupName, name string) DedicatedHostID {
+	return DedicatedHostID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		HostGroupName:  hostGroupName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Dedicated Host ID
+func (id DedicatedHostID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/hostGroups/%s/hosts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.HostGroupName, id.Name)
+}
+
+// ParseDedicatedHostID parses a Dedicated Host ID into a DedicatedHostID struct
+func ParseDedicatedHostID(input string) (*DedicatedHostID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dedicated Host ID %q: %+v", input, err)
+	}
+
+	resourceId := DedicatedHostID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Compute"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.HostGroupName, err = id.PopSegment("hostGroups"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("hosts"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDedicatedHostID validates that the specified value is a Dedicated Host ID
+func ValidateDedicatedHostID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDedicatedHostID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Dedicated Host ID: %+v", k, err))
+	}
+
+	return
+}
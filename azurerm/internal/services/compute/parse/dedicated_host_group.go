@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DedicatedHostGroupID is a parsed Dedicated Host Group ID
+type DedicatedHostGroupID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewDedicatedHostGroupID returns a new DedicatedHostGroupID for the specified values
+func NewDedicatedHostGroupID(subscriptionId, resourceGroup, name string) DedicatedHostGroupID {
+	return DedicatedHostGroupID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Dedicated Host Group ID
+func (id DedicatedHostGroupID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/hostGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseDedicatedHostGroupID parses a Dedicated Host Group ID into a DedicatedHostGroupID struct
+func ParseDedicatedHostGroupID(input string) (*DedicatedHostGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dedicated Host Group ID %q: %+v", input, err)
+	}
+
+	resourceId := DedicatedHostGroupID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Compute"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("hostGroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDedicatedHostGroupID validates that the specified value is a Dedicated Host Group ID
+func ValidateDedicatedHostGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDedicatedHostGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Dedicated Host Group ID: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ImageID is a parsed Image ID
+type ImageID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewImageID returns a new ImageID for the specified values
+func NewImageID(subscriptionId, resourceGroup, name string) ImageID {
+	return ImageID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Image ID
+func (id ImageID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/images/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseImageID parses a Image ID into a ImageID struct
+func ParseImageID(input string) (*ImageID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Image ID %q: %+v", input, err)
+	}
+
+	resourceId := ImageID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Compute"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("images"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateImageID validates that the specified value is a Image ID
+func ValidateImageID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseImageID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Image ID: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ManagedDiskID is a parsed Managed Disk ID
+type ManagedDiskID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewManagedDiskID returns a new ManagedDiskID for the specified values
+func NewManagedDiskID(subscriptionId, resourceGroup, name string) ManagedDiskID {
+	return ManagedDiskID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Managed Disk ID
+func (id ManagedDiskID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/disks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseManagedDiskID parses a Managed Disk ID into a ManagedDiskID struct
+func ParseManagedDiskID(input string) (*ManagedDiskID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Managed Disk ID %q: %+v", input, err)
+	}
+
+	resourceId := ManagedDiskID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Compute"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("disks"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateManagedDiskID validates that the specified value is a Managed Disk ID
+func ValidateManagedDiskID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseManagedDiskID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Managed Disk ID: %+v", k, err))
+	}
+
+	return
+}
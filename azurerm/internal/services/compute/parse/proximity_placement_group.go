@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ProximityPlacementGroupID is a parsed Proximity Placement Group ID
+type ProximityPlacementGroupID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewProximityPlacementGroupID returns a new ProximityPlacementGroupID for the specified values
+func NewProximityPlacementGroupID(subscriptionId, resourceGroup, name string) ProximityPlacementGroupID {
+	return ProximityPlacementGroupID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Proximity Placement Group ID
+func (id ProximityPlacementGroupID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/proximityPlacementGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseProximityPlacementGroupID parses a Proximity Placement Group ID into a ProximityPlacementGroupID struct
+func ParseProximityPlacementGroupID(input string) (*ProximityPlacementGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Proximity Placement Group ID %q: %+v", input, err)
+	}
+
+	resourceId := ProximityPlacementGroupID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Compute"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("proximityPlacementGroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateProximityPlacementGroupID validates that the specified value is a Proximity Placement Group ID
+func ValidateProximityPlacementGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseProximityPlacementGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Proximity Placement Group ID: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,82 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SharedImageID is a parsed Shared Image ID
+type SharedImageID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	GalleryName    string
+	Name           string
+}
+
+// NewSharedImageID returns a new SharedImageID for the specified values
+func NewSharedImageID(subscriptionId, resourceGroup, galleryName, name string) SharedImageID {
+	return SharedImageID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		GalleryName:    galleryName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Shared Image ID
+func (id SharedImageID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s/images/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.GalleryName, id.Name)
+}
+
+// ParseSharedImageID parses a Shared Image ID into a SharedImageID struct
+func ParseSharedImageID(input string) (*SharedImageID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Shared Image ID %q: %+v", input, err)
+	}
+
+	resourceId := SharedImageID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Compute"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.GalleryName, err = id.PopSegment("galleries"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("images"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSharedImageID validates that the specified value is a Shared Image ID
+func ValidateSharedImageID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseSharedImageID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Shared Image ID: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SharedImageGalleryID is a parsed Shared Image Gallery ID
+type SharedImageGalleryID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewSharedImageGalleryID returns a new SharedImageGalleryID for the specified values
+func NewSharedImageGalleryID(subscriptionId, resourceGroup, name string) SharedImageGalleryID {
+	return SharedImageGalleryID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Shared Image Gallery ID
+func (id SharedImageGalleryID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseSharedImageGalleryID parses a Shared Image Gallery ID into a SharedImageGalleryID struct
+func ParseSharedImageGalleryID(input string) (*SharedImageGalleryID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Shared Image Gallery ID %q: %+v", input, err)
+	}
+
+	resourceId := SharedImageGalleryID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Compute"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("galleries"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSharedImageGalleryID validates that the specified value is a Shared Image Gallery ID
+func ValidateSharedImageGalleryID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseSharedImageGalleryID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Shared Image Gallery ID: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,88 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SharedImageVersionID is a parsed Shared Image Version ID
+type SharedImageVersionID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	GalleryName    string
+	ImageName      string
+	Name           string
+}
+
+// NewSharedImageVersionID returns a new SharedImageVersionID for the specified values
+func NewSharedImageVersionID(subscriptionId, resourceGroup, galleryName, imageName, name string) SharedImageVersionID {
+	return SharedImageVersionID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		GalleryName:    galleryName,
+		ImageName:      imageName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Shared Image Version ID
+func (id SharedImageVersionID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s/images/%s/versions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.GalleryName, id.ImageName, id.Name)
+}
+
+// ParseSharedImageVersionID parses a Shared Image Version ID into a SharedImageVersionID struct
+func ParseSharedImageVersionID(input string) (*SharedImageVersionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Shared Image Version ID %q: %+v", input, err)
+	}
+
+	resourceId := SharedImageVersionID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Compute"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.GalleryName, err = id.PopSegment("galleries"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.ImageName, err = id.PopSegment("images"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("versions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSharedImageVersionID validates that the specified value is a Shared Image Version ID
+func ValidateSharedImageVersionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseSharedImageVersionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Shared Image Version ID: %+v", k, err))
+	}
+
+	return
+}
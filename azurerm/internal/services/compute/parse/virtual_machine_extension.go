@@ -0,0 +1,82 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualMachineExtensionID is a parsed Virtual Machine Extension ID
+type VirtualMachineExtensionID struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	VirtualMachineName string
+	Name               string
+}
+
+// NewVirtualMachineExtensionID returns a new VirtualMachineExtensionID for the specified values
+func NewVirtualMachineExtensionID(subscriptionId, resourceGroup, virtualMachineName, name string) VirtualMachineExtensionID {
+	return VirtualMachineExtensionID{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		VirtualMachineName: virtualMachineName,
+		Name:               name,
+	}
+}
+
+// ID returns the formatted Virtual Machine Extension ID
+func (id VirtualMachineExtensionID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s/extensions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName, id.Name)
+}
+
+// ParseVirtualMachineExtensionID parses a Virtual Machine Extension ID into a VirtualMachineExtensionID struct
+func ParseVirtualMachineExtensionID(input string) (*VirtualMachineExtensionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Extension ID %q: %+v", input, err)
+	}
+
+	resourceId := VirtualMachineExtensionID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Compute"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.VirtualMachineName, err = id.PopSegment("virtualMachines"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("extensions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualMachineExtensionID validates that the specified value is a Virtual Machine Extension ID
+func ValidateVirtualMachineExtensionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVirtualMachineExtensionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Virtual Machine Extension ID: %+v", k, err))
+	}
+
+	return
+}
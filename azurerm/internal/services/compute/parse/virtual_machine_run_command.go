@@ -0,0 +1,82 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualMachineRunCommandID is a parsed Virtual Machine Run Command ID
+type VirtualMachineRunCommandID struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	VirtualMachineName string
+	Name               string
+}
+
+// NewVirtualMachineRunCommandID returns a new VirtualMachineRunCommandID for the specified values
+func NewVirtualMachineRunCommandID(subscriptionId, resourceGroup, virtualMachineName, name string) VirtualMachineRunCommandID {
+	return VirtualMachineRunCommandID{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		VirtualMachineName: virtualMachineName,
+		Name:               name,
+	}
+}
+
+// ID returns the formatted Virtual Machine Run Command ID
+func (id VirtualMachineRunCommandID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s/runCommands/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName, id.Name)
+}
+
+// ParseVirtualMachineRunCommandID parses a Virtual Machine Run Command ID into a VirtualMachineRunCommandID struct
+func ParseVirtualMachineRunCommandID(input string) (*VirtualMachineRunCommandID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Run Command ID %q: %+v", input, err)
+	}
+
+	resourceId := VirtualMachineRunCommandID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Compute"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.VirtualMachineName, err = id.PopSegment("virtualMachines"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("runCommands"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualMachineRunCommandID validates that the specified value is a Virtual Machine Run Command ID
+func ValidateVirtualMachineRunCommandID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVirtualMachineRunCommandID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Virtual Machine Run Command ID: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,82 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualMachineScaleSetExtensionID is a parsed Virtual Machine Scale Set Extension ID
+type VirtualMachineScaleSetExtensionID struct {
+	SubscriptionId             string
+	ResourceGroup              string
+	VirtualMachineScaleSetName string
+	Name                       string
+}
+
+// NewVirtualMachineScaleSetExtensionID returns a new VirtualMachineScaleSetExtensionID for the specified values
+func NewVirtualMachineScaleSetExtensionID(subscriptionId, resourceGroup, virtualMachineScaleSetName, name string) VirtualMachineScaleSetExtensionID {
+	return VirtualMachineScaleSetExtensionID{
+		SubscriptionId:             subscriptionId,
+		ResourceGroup:              resourceGroup,
+		VirtualMachineScaleSetName: virtualMachineScaleSetName,
+		Name:                       name,
+	}
+}
+
+// ID returns the formatted Virtual Machine Scale Set Extension ID
+func (id VirtualMachineScaleSetExtensionID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachineScaleSets/%s/extensions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, id.Name)
+}
+
+// ParseVirtualMachineScaleSetExtensionID parses a Virtual Machine Scale Set Extension ID into a VirtualMachineScaleSetExtensionID struct
+func ParseVirtualMachineScaleSetExtensionID(input string) (*VirtualMachineScaleSetExtensionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Scale Set Extension ID %q: %+v", input, err)
+	}
+
+	resourceId := VirtualMachineScaleSetExtensionID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Compute"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.VirtualMachineScaleSetName, err = id.PopSegment("virtualMachineScaleSets"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("extensions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualMachineScaleSetExtensionID validates that the specified value is a Virtual Machine Scale Set Extension ID
+func ValidateVirtualMachineScaleSetExtensionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVirtualMachineScaleSetExtensionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Virtual Machine Scale Set Extension ID: %+v", k, err))
+	}
+
+	return
+}
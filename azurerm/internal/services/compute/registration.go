@@ -13,10 +13,43 @@ func (r Registration) Name() string {
 
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
-	return map[string]*schema.Resource{}
+	return map[string]*schema.Resource{
+		"azurerm_availability_set":          dataSourceArmAvailabilitySet(),
+		"azurerm_image":                     dataSourceArmImage(),
+		"azurerm_managed_disk":              dataSourceArmManagedDisk(),
+		"azurerm_platform_image":            dataSourceArmPlatformImage(),
+		"azurerm_proximity_placement_group": dataSourceArmProximityPlacementGroup(),
+		"azurerm_shared_image":              dataSourceArmSharedImage(),
+		"azurerm_shared_image_gallery":      dataSourceArmSharedImageGallery(),
+		"azurerm_shared_image_version":      dataSourceArmSharedImageVersion(),
+		"azurerm_snapshot":                  dataSourceArmSnapshot(),
+		"azurerm_virtual_machine":           dataSourceArmVirtualMachine(),
+	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*schema.Resource {
-	return map[string]*schema.Resource{}
+	return map[string]*schema.Resource{
+		"azurerm_availability_set":                     resourceArmAvailabilitySet(),
+		"azurerm_dedicated_host":                       resourceArmDedicatedHost(),
+		"azurerm_dedicated_host_group":                 resourceArmDedicatedHostGroup(),
+		"azurerm_image":                                resourceArmImage(),
+		"azurerm_linux_virtual_machine":                resourceArmLinuxVirtualMachine(),
+		"azurerm_linux_virtual_machine_scale_set":      resourceArmLinuxVirtualMachineScaleSet(),
+		"azurerm_managed_disk":                         resourceArmManagedDisk(),
+		"azurerm_marketplace_agreement":                resourceArmMarketplaceAgreement(),
+		"azurerm_proximity_placement_group":            resourceArmProximityPlacementGroup(),
+		"azurerm_shared_image":                         resourceArmSharedImage(),
+		"azurerm_shared_image_gallery":                 resourceArmSharedImageGallery(),
+		"azurerm_shared_image_version":                 resourceArmSharedImageVersion(),
+		"azurerm_snapshot":                             resourceArmSnapshot(),
+		"azurerm_virtual_machine":                      resourceArmVirtualMachine(),
+		"azurerm_virtual_machine_data_disk_attachment": resourceArmVirtualMachineDataDiskAttachment(),
+		"azurerm_virtual_machine_extension":            resourceArmVirtualMachineExtensions(),
+		"azurerm_virtual_machine_run_command":          resourceArmVirtualMachineRunCommand(),
+		"azurerm_virtual_machine_scale_set":            resourceArmVirtualMachineScaleSet(),
+		"azurerm_virtual_machine_scale_set_extension":  resourceArmVirtualMachineScaleSetExtension(),
+		"azurerm_windows_virtual_machine":              resourceArmWindowsVirtualMachine(),
+		"azurerm_windows_virtual_machine_scale_set":    resourceArmWindowsVirtualMachineScaleSet(),
+	}
 }
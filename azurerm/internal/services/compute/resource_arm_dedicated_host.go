@@ -0,0 +1,255 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDedicatedHost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDedicatedHostCreate,
+		Read:   resourceArmDedicatedHostRead,
+		Update: resourceArmDedicatedHostUpdate,
+		Delete: resourceArmDedicatedHostDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseDedicatedHostID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"dedicated_host_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateDedicatedHostGroupID,
+			},
+
+			"sku_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"DSv3-Type1",
+					"ESv3-Type1",
+					"FSv2-Type2",
+				}, false),
+			},
+
+			"platform_fault_domain": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"auto_replace_on_failure": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.DedicatedHostLicenseTypesNone),
+					string(compute.DedicatedHostLicenseTypesWindowsServerHybrid),
+					string(compute.DedicatedHostLicenseTypesWindowsServerPerpetual),
+				}, false),
+				Default: string(compute.DedicatedHostLicenseTypesNone),
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmDedicatedHostCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DedicatedHostsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	dedicatedHostGroupId, err := parse.ParseDedicatedHostGroupID(d.Get("dedicated_host_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if meta.(*clients.Client).Features.Resources.RequireImport {
+		existing, err := client.Get(ctx, dedicatedHostGroupId.ResourceGroup, dedicatedHostGroupId.Name, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Dedicated Host %q (Host Group %q / Resource Group %q): %+v", name, dedicatedHostGroupId.Name, dedicatedHostGroupId.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dedicated_host", *existing.ID)
+		}
+	}
+
+	parameters := compute.DedicatedHost{
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		DedicatedHostProperties: &compute.DedicatedHostProperties{
+			AutoReplaceOnFailure: utils.Bool(d.Get("auto_replace_on_failure").(bool)),
+			LicenseType:          compute.DedicatedHostLicenseTypes(d.Get("license_type").(string)),
+			PlatformFaultDomain:  utils.Int32(int32(d.Get("platform_fault_domain").(int))),
+		},
+		Sku: &compute.Sku{
+			Name: utils.String(d.Get("sku_name").(string)),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, dedicatedHostGroupId.ResourceGroup, dedicatedHostGroupId.Name, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating Dedicated Host %q (Host Group %q / Resource Group %q): %+v", name, dedicatedHostGroupId.Name, dedicatedHostGroupId.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Dedicated Host %q (Host Group %q / Resource Group %q): %+v", name, dedicatedHostGroupId.Name, dedicatedHostGroupId.ResourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, dedicatedHostGroupId.ResourceGroup, dedicatedHostGroupId.Name, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Dedicated Host %q (Host Group %q / Resource Group %q): %+v", name, dedicatedHostGroupId.Name, dedicatedHostGroupId.ResourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for Dedicated Host %q (Host Group %q / Resource Group %q)", name, dedicatedHostGroupId.Name, dedicatedHostGroupId.ResourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDedicatedHostRead(d, meta)
+}
+
+func resourceArmDedicatedHostRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DedicatedHostsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseDedicatedHostID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.HostGroupName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Dedicated Host %q (Host Group %q / Resource Group %q) was not found - removing from state!", id.Name, id.HostGroupName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Dedicated Host %q (Host Group %q / Resource Group %q): %+v", id.Name, id.HostGroupName, id.ResourceGroup, err)
+	}
+
+	dedicatedHostGroupId := parse.NewDedicatedHostGroupID(id.SubscriptionId, id.ResourceGroup, id.HostGroupName)
+
+	d.Set("name", id.Name)
+	d.Set("dedicated_host_group_id", dedicatedHostGroupId.ID())
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	skuName := ""
+	if resp.Sku != nil && resp.Sku.Name != nil {
+		skuName = *resp.Sku.Name
+	}
+	d.Set("sku_name", skuName)
+
+	if props := resp.DedicatedHostProperties; props != nil {
+		d.Set("auto_replace_on_failure", props.AutoReplaceOnFailure)
+		d.Set("license_type", string(props.LicenseType))
+
+		platformFaultDomain := 0
+		if props.PlatformFaultDomain != nil {
+			platformFaultDomain = int(*props.PlatformFaultDomain)
+		}
+		d.Set("platform_fault_domain", platformFaultDomain)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmDedicatedHostUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DedicatedHostsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseDedicatedHostID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := compute.DedicatedHostUpdate{
+		DedicatedHostProperties: &compute.DedicatedHostProperties{
+			AutoReplaceOnFailure: utils.Bool(d.Get("auto_replace_on_failure").(bool)),
+			LicenseType:          compute.DedicatedHostLicenseTypes(d.Get("license_type").(string)),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.HostGroupName, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error updating Dedicated Host %q (Host Group %q / Resource Group %q): %+v", id.Name, id.HostGroupName, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Dedicated Host %q (Host Group %q / Resource Group %q): %+v", id.Name, id.HostGroupName, id.ResourceGroup, err)
+	}
+
+	return resourceArmDedicatedHostRead(d, meta)
+}
+
+func resourceArmDedicatedHostDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DedicatedHostsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseDedicatedHostID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.HostGroupName, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Dedicated Host %q (Host Group %q / Resource Group %q): %+v", id.Name, id.HostGroupName, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Dedicated Host %q (Host Group %q / Resource Group %q): %+v", id.Name, id.HostGroupName, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
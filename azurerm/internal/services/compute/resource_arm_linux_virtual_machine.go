@@ -0,0 +1,483 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLinuxVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLinuxVirtualMachineCreate,
+		Read:   resourceArmLinuxVirtualMachineRead,
+		Update: resourceArmLinuxVirtualMachineUpdate,
+		Delete: resourceArmLinuxVirtualMachineDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseVirtualMachineID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"admin_username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"os_disk": virtualMachineOSDiskSchema(),
+
+			"size": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"admin_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"admin_ssh_key": virtualMachineAdminSSHKeySchema(),
+
+			"allow_extension_operations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"availability_set_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateAvailabilitySetID,
+				// the Compute API doesn't allow both an Availability Set and an Availability Zone
+				ConflictsWith: []string{"zones"},
+			},
+
+			"boot_diagnostics": virtualMachineBootDiagnosticsSchema(),
+
+			"computer_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"custom_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.Base64String(),
+			},
+
+			"dedicated_host_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateDedicatedHostID,
+				// the Compute API returns the Resource Group segment of this ID in a different casing
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"disable_password_authentication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"eviction_policy": virtualMachineEvictionPolicySchema(),
+
+			"identity": virtualMachineIdentitySchema(),
+
+			"max_bid_price": virtualMachineMaxBidPriceSchema(),
+
+			"priority": virtualMachinePrioritySchema(),
+
+			"provision_vm_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"proximity_placement_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateProximityPlacementGroupID,
+			},
+
+			"source_image_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"source_image_reference": virtualMachineSourceImageReferenceSchema(),
+
+			"zones": azure.SchemaSingleZone(),
+
+			"tags": tags.Schema(),
+
+			"virtual_machine_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmLinuxVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*clients.Client).Features.Resources.RequireImport {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_linux_virtual_machine", *existing.ID)
+		}
+	}
+
+	adminUsername := d.Get("admin_username").(string)
+	adminPassword := d.Get("admin_password").(string)
+	disablePasswordAuthentication := d.Get("disable_password_authentication").(bool)
+	sshKeys := expandVirtualMachineAdminSSHKeys(d.Get("admin_ssh_key").(*schema.Set).List())
+	if disablePasswordAuthentication {
+		if adminPassword != "" {
+			return fmt.Errorf("An `admin_password` cannot be specified when `disable_password_authentication` is set to `true`")
+		}
+
+		if len(*sshKeys) == 0 {
+			return fmt.Errorf("At least one `admin_ssh_key` must be specified when `disable_password_authentication` is set to `true`")
+		}
+	} else if adminPassword == "" {
+		return fmt.Errorf("An `admin_password` must be specified when `disable_password_authentication` is set to `false`")
+	}
+
+	identity, err := expandVirtualMachineIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error expanding `identity`: %+v", err)
+	}
+
+	sourceImageReference, err := expandVirtualMachineSourceImageReference(d.Get("source_image_reference").([]interface{}), d.Get("source_image_id").(string))
+	if err != nil {
+		return err
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	networkInterfaces := expandVirtualMachineNetworkInterfaceIDs(d.Get("network_interface_ids").([]interface{}))
+	t := d.Get("tags").(map[string]interface{})
+
+	params := compute.VirtualMachine{
+		Name:     utils.String(name),
+		Location: utils.String(location),
+		Identity: identity,
+		VirtualMachineProperties: &compute.VirtualMachineProperties{
+			HardwareProfile: &compute.HardwareProfile{
+				VMSize: compute.VirtualMachineSizeTypes(d.Get("size").(string)),
+			},
+			OsProfile: &compute.OSProfile{
+				AdminUsername:            utils.String(adminUsername),
+				AllowExtensionOperations: utils.Bool(d.Get("allow_extension_operations").(bool)),
+				ComputerName:             utils.String(virtualMachineDefaultComputerName(d)),
+				LinuxConfiguration: &compute.LinuxConfiguration{
+					DisablePasswordAuthentication: utils.Bool(disablePasswordAuthentication),
+					ProvisionVMAgent:              utils.Bool(d.Get("provision_vm_agent").(bool)),
+					SSH: &compute.SSHConfiguration{
+						PublicKeys: sshKeys,
+					},
+				},
+			},
+			NetworkProfile: &compute.NetworkProfile{
+				NetworkInterfaces: &networkInterfaces,
+			},
+			StorageProfile: &compute.StorageProfile{
+				ImageReference: sourceImageReference,
+				OsDisk:         expandVirtualMachineOSDisk(d.Get("os_disk").([]interface{}), compute.Linux),
+			},
+			DiagnosticsProfile: expandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{})),
+		},
+		Zones: azure.ExpandZones(d.Get("zones").([]interface{})),
+		Tags:  tags.Expand(t),
+	}
+
+	priority, evictionPolicy, billingProfile, err := expandVirtualMachinePriority(d)
+	if err != nil {
+		return err
+	}
+	params.VirtualMachineProperties.Priority = priority
+	params.VirtualMachineProperties.EvictionPolicy = evictionPolicy
+	params.VirtualMachineProperties.BillingProfile = billingProfile
+
+	if adminPassword != "" {
+		params.VirtualMachineProperties.OsProfile.AdminPassword = utils.String(adminPassword)
+	}
+
+	if v, ok := d.GetOk("custom_data"); ok {
+		params.VirtualMachineProperties.OsProfile.CustomData = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("availability_set_id"); ok {
+		params.VirtualMachineProperties.AvailabilitySet = &compute.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("dedicated_host_id"); ok {
+		params.VirtualMachineProperties.Host = &compute.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("proximity_placement_group_id"); ok {
+		params.VirtualMachineProperties.ProximityPlacementGroup = &compute.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, params)
+	if err != nil {
+		return fmt.Errorf("Error creating Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Linux Virtual Machine %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmLinuxVirtualMachineRead(d, meta)
+}
+
+func resourceArmLinuxVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMClient
+	disksClient := meta.(*clients.Client).Compute.DisksClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Linux Virtual Machine %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+	d.Set("zones", resp.Zones)
+
+	if err := d.Set("identity", flattenVirtualMachineIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	props := resp.VirtualMachineProperties
+	if props == nil {
+		return fmt.Errorf("Error retrieving Linux Virtual Machine %q (Resource Group %q): `properties` was nil", id.Name, id.ResourceGroup)
+	}
+
+	availabilitySetId := ""
+	if props.AvailabilitySet != nil && props.AvailabilitySet.ID != nil {
+		availabilitySetId = *props.AvailabilitySet.ID
+	}
+	d.Set("availability_set_id", availabilitySetId)
+
+	dedicatedHostId := ""
+	if props.Host != nil && props.Host.ID != nil {
+		dedicatedHostId = *props.Host.ID
+	}
+	d.Set("dedicated_host_id", dedicatedHostId)
+
+	proximityPlacementGroupId := ""
+	if props.ProximityPlacementGroup != nil && props.ProximityPlacementGroup.ID != nil {
+		proximityPlacementGroupId = *props.ProximityPlacementGroup.ID
+	}
+	d.Set("proximity_placement_group_id", proximityPlacementGroupId)
+
+	if err := d.Set("boot_diagnostics", flattenVirtualMachineBootDiagnostics(props.DiagnosticsProfile)); err != nil {
+		return fmt.Errorf("Error setting `boot_diagnostics`: %+v", err)
+	}
+
+	d.Set("eviction_policy", string(props.EvictionPolicy))
+	d.Set("max_bid_price", flattenVirtualMachineMaxBidPrice(props.BillingProfile))
+	d.Set("priority", string(props.Priority))
+	d.Set("virtual_machine_id", props.VMID)
+
+	if profile := props.HardwareProfile; profile != nil {
+		d.Set("size", string(profile.VMSize))
+	}
+
+	if profile := props.NetworkProfile; profile != nil {
+		if err := d.Set("network_interface_ids", flattenVirtualMachineNetworkInterfaceIDs(profile.NetworkInterfaces)); err != nil {
+			return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+		}
+	}
+
+	if profile := props.OsProfile; profile != nil {
+		d.Set("admin_username", profile.AdminUsername)
+		d.Set("allow_extension_operations", profile.AllowExtensionOperations)
+		d.Set("computer_name", profile.ComputerName)
+
+		if config := profile.LinuxConfiguration; config != nil {
+			d.Set("disable_password_authentication", config.DisablePasswordAuthentication)
+			d.Set("provision_vm_agent", config.ProvisionVMAgent)
+
+			sshKeys, err := flattenVirtualMachineAdminSSHKeys(config.SSH)
+			if err != nil {
+				return fmt.Errorf("Error flattening `admin_ssh_key`: %+v", err)
+			}
+			if err := d.Set("admin_ssh_key", sshKeys); err != nil {
+				return fmt.Errorf("Error setting `admin_ssh_key`: %+v", err)
+			}
+		}
+
+		// the `admin_password` and `custom_data` fields aren't returned from the API, so we keep the values from the config
+	}
+
+	if profile := props.StorageProfile; profile != nil {
+		osDisk, err := flattenVirtualMachineOSDisk(ctx, disksClient, profile.OsDisk)
+		if err != nil {
+			return fmt.Errorf("Error flattening `os_disk`: %+v", err)
+		}
+		if err := d.Set("os_disk", osDisk); err != nil {
+			return fmt.Errorf("Error setting `os_disk`: %+v", err)
+		}
+
+		sourceImageId := ""
+		if profile.ImageReference != nil && profile.ImageReference.ID != nil {
+			sourceImageId = *profile.ImageReference.ID
+		}
+		d.Set("source_image_id", sourceImageId)
+
+		if err := d.Set("source_image_reference", flattenVirtualMachineSourceImageReference(profile.ImageReference)); err != nil {
+			return fmt.Errorf("Error setting `source_image_reference`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmLinuxVirtualMachineUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, virtualMachineResourceName)
+	defer locks.UnlockByName(id.Name, virtualMachineResourceName)
+
+	details, err := expandVirtualMachineUpdateDetails(ctx, d, client, id)
+	if err != nil {
+		return fmt.Errorf("Error determining the changes for Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := applyVirtualMachineUpdate(ctx, meta, id, details); err != nil {
+		return fmt.Errorf("Error updating Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return resourceArmLinuxVirtualMachineRead(d, meta)
+}
+
+func resourceArmLinuxVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, virtualMachineResourceName)
+	defer locks.UnlockByName(id.Name, virtualMachineResourceName)
+
+	if err := deleteVirtualMachine(ctx, meta, id); err != nil {
+		return fmt.Errorf("Error deleting Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,251 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Run Commands aren't a resource within Azure - the command is invoked against the Virtual Machine
+// during creation and its output stored in the state, as such all arguments are ForceNew so that
+// changing them runs the command again.
+func resourceArmVirtualMachineRunCommand() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineRunCommandCreate,
+		Read:   resourceArmVirtualMachineRunCommandRead,
+		Delete: resourceArmVirtualMachineRunCommandDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"virtual_machine_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateVirtualMachineID,
+			},
+
+			"command_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"script": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"exit_code": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"stderr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"stdout": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineRunCommandCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	virtualMachineId, err := parse.ParseVirtualMachineID(d.Get("virtual_machine_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(virtualMachineId.Name, virtualMachineResourceName)
+	defer locks.UnlockByName(virtualMachineId.Name, virtualMachineResourceName)
+
+	input := compute.RunCommandInput{
+		CommandID:  utils.String(d.Get("command_id").(string)),
+		Parameters: expandVirtualMachineRunCommandParameters(d.Get("parameters").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("script"); ok {
+		input.Script = utils.ExpandStringSlice(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Running Command %q on Virtual Machine %q (Resource Group %q)..", name, virtualMachineId.Name, virtualMachineId.ResourceGroup)
+	future, err := client.RunCommand(ctx, virtualMachineId.ResourceGroup, virtualMachineId.Name, input)
+	if err != nil {
+		return fmt.Errorf("Error running Command %q on Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineId.Name, virtualMachineId.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Command %q to complete on Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineId.Name, virtualMachineId.ResourceGroup, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving result of Command %q on Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineId.Name, virtualMachineId.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Ran Command %q on Virtual Machine %q (Resource Group %q).", name, virtualMachineId.Name, virtualMachineId.ResourceGroup)
+
+	id := parse.NewVirtualMachineRunCommandID(virtualMachineId.SubscriptionId, virtualMachineId.ResourceGroup, virtualMachineId.Name, name)
+	d.SetId(id.ID())
+
+	output := parseVirtualMachineRunCommandResult(result.Value)
+	d.Set("exit_code", output.exitCode)
+	d.Set("stderr", output.stderr)
+	d.Set("stdout", output.stdout)
+
+	return resourceArmVirtualMachineRunCommandRead(d, meta)
+}
+
+func resourceArmVirtualMachineRunCommandRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseVirtualMachineRunCommandID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the output of the command is only available at the time it's run, so all we can do here
+	// is check that the Virtual Machine it was run against still exists
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Virtual Machine %q was not found in Resource Group %q - removing Run Command %q from state!", id.VirtualMachineName, id.ResourceGroup, id.Name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", id.VirtualMachineName, id.ResourceGroup, err)
+	}
+
+	virtualMachineId := parse.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName)
+	d.Set("name", id.Name)
+	d.Set("virtual_machine_id", virtualMachineId.ID())
+
+	return nil
+}
+
+func resourceArmVirtualMachineRunCommandDelete(d *schema.ResourceData, _ interface{}) error {
+	// there's nothing to delete within Azure, so we only need to remove this from the state
+	d.SetId("")
+	return nil
+}
+
+func expandVirtualMachineRunCommandParameters(input map[string]interface{}) *[]compute.RunCommandInputParameter {
+	// sort the keys so that the parameters are always sent in the same order
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parameters := make([]compute.RunCommandInputParameter, 0)
+	for _, k := range keys {
+		parameters = append(parameters, compute.RunCommandInputParameter{
+			Name:  utils.String(k),
+			Value: utils.String(input[k].(string)),
+		})
+	}
+
+	return &parameters
+}
+
+type virtualMachineRunCommandOutput struct {
+	exitCode int
+	stderr   string
+	stdout   string
+}
+
+var virtualMachineRunCommandExitStatusRegex = regexp.MustCompile(`exit status=(\d+)`)
+
+// parseVirtualMachineRunCommandResult parses the statuses returned from a Run Command. Windows
+// Virtual Machines return separate statuses for stdout and stderr - whereas Linux Virtual Machines
+// return a single status containing both, which also includes the exit status when the command fails.
+func parseVirtualMachineRunCommandResult(input *[]compute.InstanceViewStatus) virtualMachineRunCommandOutput {
+	output := virtualMachineRunCommandOutput{}
+	if input == nil {
+		return output
+	}
+
+	for _, status := range *input {
+		code := ""
+		if status.Code != nil {
+			code = *status.Code
+		}
+
+		message := ""
+		if status.Message != nil {
+			message = *status.Message
+		}
+
+		if strings.Contains(strings.ToLower(code), "/failed") && output.exitCode == 0 {
+			output.exitCode = 1
+		}
+
+		switch {
+		case strings.HasPrefix(code, "ComponentStatus/StdOut/"):
+			output.stdout = message
+
+		case strings.HasPrefix(code, "ComponentStatus/StdErr/"):
+			output.stderr = message
+
+		default:
+			if match := virtualMachineRunCommandExitStatusRegex.FindStringSubmatch(message); len(match) == 2 {
+				if exitCode, err := strconv.Atoi(match[1]); err == nil {
+					output.exitCode = exitCode
+				}
+			}
+
+			stdoutIndex := strings.Index(message, "[stdout]")
+			stderrIndex := strings.Index(message, "[stderr]")
+			if stdoutIndex == -1 || stderrIndex < stdoutIndex {
+				continue
+			}
+
+			output.stdout = strings.Trim(message[stdoutIndex+len("[stdout]"):stderrIndex], "\n")
+			output.stderr = strings.Trim(message[stderrIndex+len("[stderr]"):], "\n")
+		}
+	}
+
+	return output
+}
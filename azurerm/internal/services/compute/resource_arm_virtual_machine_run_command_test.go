@@ -0,0 +1,104 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestParseVirtualMachineRunCommandResult(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    *[]compute.InstanceViewStatus
+		Expected virtualMachineRunCommandOutput
+	}{
+		{
+			Name:     "no statuses",
+			Input:    nil,
+			Expected: virtualMachineRunCommandOutput{},
+		},
+		{
+			Name: "linux succeeded",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:    utils.String("ProvisioningState/succeeded"),
+					Message: utils.String("Enable succeeded: \n[stdout]\nhello world\n\n[stderr]\n"),
+				},
+			},
+			Expected: virtualMachineRunCommandOutput{
+				exitCode: 0,
+				stdout:   "hello world",
+			},
+		},
+		{
+			Name: "linux failed",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:    utils.String("ProvisioningState/failed/0"),
+					Message: utils.String("Enable failed: failed to execute command: command terminated with exit status=2\n[stdout]\n\n[stderr]\nls: cannot access '/missing': No such file or directory\n"),
+				},
+			},
+			Expected: virtualMachineRunCommandOutput{
+				exitCode: 2,
+				stderr:   "ls: cannot access '/missing': No such file or directory",
+			},
+		},
+		{
+			Name: "linux failed without an exit status",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:    utils.String("ProvisioningState/failed"),
+					Message: utils.String("Enable failed: timed out"),
+				},
+			},
+			Expected: virtualMachineRunCommandOutput{
+				exitCode: 1,
+			},
+		},
+		{
+			Name: "windows succeeded",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:    utils.String("ComponentStatus/StdOut/succeeded"),
+					Message: utils.String("hello world"),
+				},
+				{
+					Code:    utils.String("ComponentStatus/StdErr/succeeded"),
+					Message: utils.String("a warning"),
+				},
+			},
+			Expected: virtualMachineRunCommandOutput{
+				exitCode: 0,
+				stderr:   "a warning",
+				stdout:   "hello world",
+			},
+		},
+		{
+			Name: "windows failed",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:    utils.String("ComponentStatus/StdOut/failed"),
+					Message: utils.String(""),
+				},
+				{
+					Code:    utils.String("ComponentStatus/StdErr/failed"),
+					Message: utils.String("something went wrong"),
+				},
+			},
+			Expected: virtualMachineRunCommandOutput{
+				exitCode: 1,
+				stderr:   "something went wrong",
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := parseVirtualMachineRunCommandResult(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
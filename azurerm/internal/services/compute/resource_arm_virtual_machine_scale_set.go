@@ -1,14 +1,19 @@
-package azurerm
+package compute
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -30,8 +35,16 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 		MigrateState:  resourceVirtualMachineScaleSetMigrateState,
 		SchemaVersion: 1,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseVirtualMachineScaleSetID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -774,15 +787,19 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 }
 
 func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).compute.VMScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Compute.VMScaleSetClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine Scale Set creation.")
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	locks.ByName(name, virtualMachineScaleSetResourceName)
+	defer locks.UnlockByName(name, virtualMachineScaleSetResourceName)
+
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -837,6 +854,30 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 		return err
 	}
 
+	var existingTags map[string]*string
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+		existingTags = existing.Tags
+
+		// Extensions managed using the `azurerm_virtual_machine_scale_set_extension` resource aren't tracked in the `extension`
+		// block - so these need to be sent back to the API, otherwise they'd be removed from the Scale Set
+		standaloneExtensions := virtualMachineScaleSetStandaloneExtensionNames(existing.Tags)
+		inlineExtensions := virtualMachineScaleSetInlineExtensionNames(d.Get("extension").(*schema.Set).List())
+
+		if props := existing.VirtualMachineScaleSetProperties; props != nil && props.VirtualMachineProfile != nil && props.VirtualMachineProfile.ExtensionProfile != nil && props.VirtualMachineProfile.ExtensionProfile.Extensions != nil {
+			for _, extension := range *props.VirtualMachineProfile.ExtensionProfile.Extensions {
+				if extension.Name == nil || !standaloneExtensions[*extension.Name] || inlineExtensions[*extension.Name] {
+					continue
+				}
+
+				*extensions.Extensions = append(*extensions.Extensions, extension)
+			}
+		}
+	}
+
 	upgradePolicy := d.Get("upgrade_policy_mode").(string)
 	automaticOsUpgrade := d.Get("automatic_os_upgrade").(bool)
 	overprovision := d.Get("overprovision").(bool)
@@ -887,7 +928,7 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 	properties := compute.VirtualMachineScaleSet{
 		Name:                             &name,
 		Location:                         &location,
-		Tags:                             tags.Expand(t),
+		Tags:                             withVirtualMachineScaleSetExtensionsTag(tags.Expand(t), existingTags),
 		Sku:                              sku,
 		VirtualMachineScaleSetProperties: &scaleSetProps,
 		Zones:                            zones,
@@ -919,6 +960,15 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 		return err
 	}
 
+	// instances using the Manual Upgrade Policy keep running the previous model until they're updated
+	if !d.IsNewResource() && meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesWhenRequired {
+		if strings.EqualFold(upgradePolicy, string(compute.Manual)) {
+			if err := resourceArmVirtualMachineScaleSetUpdateInstances(ctx, meta, resGroup, name); err != nil {
+				return err
+			}
+		}
+	}
+
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		return err
@@ -932,16 +982,60 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 	return resourceArmVirtualMachineScaleSetRead(d, meta)
 }
 
+func resourceArmVirtualMachineScaleSetUpdateInstances(ctx context.Context, meta interface{}, resourceGroup string, name string) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetClient
+	vmsClient := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+
+	instanceIds := make([]string, 0)
+	instances, err := vmsClient.ListComplete(ctx, resourceGroup, name, "", "", "")
+	if err != nil {
+		return fmt.Errorf("Error listing the instances within Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	for instances.NotDone() {
+		instance := instances.Value()
+		if props := instance.VirtualMachineScaleSetVMProperties; props != nil && instance.InstanceID != nil {
+			if props.LatestModelApplied == nil || !*props.LatestModelApplied {
+				instanceIds = append(instanceIds, *instance.InstanceID)
+			}
+		}
+
+		if err := instances.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing the instances within Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	if len(instanceIds) == 0 {
+		log.Printf("[DEBUG] All instances within Virtual Machine Scale Set %q (Resource Group %q) are running the latest model", name, resourceGroup)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Updating %d instances within Virtual Machine Scale Set %q (Resource Group %q) to the latest model..", len(instanceIds), name, resourceGroup)
+	input := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+		InstanceIds: &instanceIds,
+	}
+	future, err := client.UpdateInstances(ctx, resourceGroup, name, input)
+	if err != nil {
+		return fmt.Errorf("Error updating the instances within Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the instances within Virtual Machine Scale Set %q (Resource Group %q) to be updated: %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
 func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).compute.VMScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Compute.VMScaleSetClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := parse.ParseVirtualMachineScaleSetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualMachineScaleSets"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -1069,10 +1163,19 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 			}
 
 			if extensionProfile := properties.VirtualMachineProfile.ExtensionProfile; extensionProfile != nil {
-				extension, err := flattenAzureRmVirtualMachineScaleSetExtensionProfile(extensionProfile)
+				extensions, err := flattenAzureRmVirtualMachineScaleSetExtensionProfile(extensionProfile)
 				if err != nil {
 					return fmt.Errorf("[DEBUG] Error setting Virtual Machine Scale Set Extension Profile error: %#v", err)
 				}
+
+				// Extensions managed using the `azurerm_virtual_machine_scale_set_extension` resource aren't tracked in the `extension` block
+				standaloneExtensions := virtualMachineScaleSetStandaloneExtensionNames(resp.Tags)
+				extension := make([]map[string]interface{}, 0)
+				for _, v := range extensions {
+					if !standaloneExtensions[v["name"].(string)] {
+						extension = append(extension, v)
+					}
+				}
 				if err := d.Set("extension", extension); err != nil {
 					return fmt.Errorf("[DEBUG] Error setting `extension`: %#v", err)
 				}
@@ -1087,19 +1190,20 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(d, withoutVirtualMachineScaleSetExtensionsTag(resp.Tags))
 }
 
 func resourceArmVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).compute.VMScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Compute.VMScaleSetClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := parse.ParseVirtualMachineScaleSetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualMachineScaleSets"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -1678,6 +1782,16 @@ func resourceArmVirtualMachineScaleSetOsProfileWindowsConfigHash(v interface{})
 	return hashcode.String(buf.String())
 }
 
+// virtualMachineScaleSetInlineExtensionNames returns the names of the Extensions defined in the `extension` block
+func virtualMachineScaleSetInlineExtensionNames(input []interface{}) map[string]bool {
+	names := make(map[string]bool)
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		names[raw["name"].(string)] = true
+	}
+	return names
+}
+
 func resourceArmVirtualMachineScaleSetExtensionHash(v interface{}) int {
 	var buf bytes.Buffer
 
@@ -0,0 +1,410 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVirtualMachineScaleSetExtension() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineScaleSetExtensionCreateUpdate,
+		Read:   resourceArmVirtualMachineScaleSetExtensionRead,
+		Update: resourceArmVirtualMachineScaleSetExtensionCreateUpdate,
+		Delete: resourceArmVirtualMachineScaleSetExtensionDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseVirtualMachineScaleSetExtensionID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"virtual_machine_scale_set_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateVirtualMachineScaleSetID,
+			},
+
+			"publisher": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"type_handler_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"auto_upgrade_minor_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"force_update_tag": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// due to the sensitive nature, these are not returned by the API
+			"protected_settings": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"provision_after_extensions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"settings": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineScaleSetExtensionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetExtensionsClient
+	vmssClient := meta.(*clients.Client).Compute.VMScaleSetClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	virtualMachineScaleSetId, err := parse.ParseVirtualMachineScaleSetID(d.Get("virtual_machine_scale_set_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// the Scale Set (and its other Extensions) can't be modified whilst an Extension is being provisioned
+	locks.ByName(virtualMachineScaleSetId.Name, virtualMachineScaleSetResourceName)
+	defer locks.UnlockByName(virtualMachineScaleSetId.Name, virtualMachineScaleSetResourceName)
+
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, virtualMachineScaleSetId.ResourceGroup, virtualMachineScaleSetId.Name, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, virtualMachineScaleSetId.Name, virtualMachineScaleSetId.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_virtual_machine_scale_set_extension", *existing.ID)
+		}
+	}
+
+	virtualMachineScaleSet, err := vmssClient.Get(ctx, virtualMachineScaleSetId.ResourceGroup, virtualMachineScaleSetId.Name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Machine Scale Set %q (Resource Group %q): %+v", virtualMachineScaleSetId.Name, virtualMachineScaleSetId.ResourceGroup, err)
+	}
+
+	props := compute.VirtualMachineScaleSetExtensionProperties{
+		Publisher:                utils.String(d.Get("publisher").(string)),
+		Type:                     utils.String(d.Get("type").(string)),
+		TypeHandlerVersion:       utils.String(d.Get("type_handler_version").(string)),
+		AutoUpgradeMinorVersion:  utils.Bool(d.Get("auto_upgrade_minor_version").(bool)),
+		ProvisionAfterExtensions: utils.ExpandStringSlice(d.Get("provision_after_extensions").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("force_update_tag"); ok {
+		props.ForceUpdateTag = utils.String(v.(string))
+	}
+
+	if settingsString := d.Get("settings").(string); settingsString != "" {
+		settings, err := structure.ExpandJsonFromString(settingsString)
+		if err != nil {
+			return fmt.Errorf("Error parsing `settings`: %+v", err)
+		}
+		props.Settings = &settings
+	}
+
+	if protectedSettingsString := d.Get("protected_settings").(string); protectedSettingsString != "" {
+		protectedSettings, err := structure.ExpandJsonFromString(protectedSettingsString)
+		if err != nil {
+			return fmt.Errorf("Error parsing `protected_settings`: %+v", err)
+		}
+		props.ProtectedSettings = &protectedSettings
+	}
+
+	extension := compute.VirtualMachineScaleSetExtension{
+		Name: utils.String(name),
+		VirtualMachineScaleSetExtensionProperties: &props,
+	}
+
+	// this is tracked before the Extension is provisioned, so that it's not picked up by the `extension` block on the Scale Set
+	if err := updateVirtualMachineScaleSetExtensionsTag(ctx, vmssClient, virtualMachineScaleSetId, virtualMachineScaleSet, name, true); err != nil {
+		return err
+	}
+
+	updateStarted := time.Now()
+	future, err := client.CreateOrUpdate(ctx, virtualMachineScaleSetId.ResourceGroup, virtualMachineScaleSetId.Name, name, extension)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, virtualMachineScaleSetId.Name, virtualMachineScaleSetId.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, virtualMachineScaleSetId.Name, virtualMachineScaleSetId.ResourceGroup, err)
+	}
+
+	if err := upgradeVirtualMachineScaleSetInstancesForExtension(ctx, meta, virtualMachineScaleSetId, virtualMachineScaleSet, updateStarted); err != nil {
+		return fmt.Errorf("Error upgrading the instances within Virtual Machine Scale Set %q (Resource Group %q) after changing Extension %q: %+v", virtualMachineScaleSetId.Name, virtualMachineScaleSetId.ResourceGroup, name, err)
+	}
+
+	read, err := client.Get(ctx, virtualMachineScaleSetId.ResourceGroup, virtualMachineScaleSetId.Name, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, virtualMachineScaleSetId.Name, virtualMachineScaleSetId.ResourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Extension %q (Virtual Machine Scale Set %q / Resource Group %q)", name, virtualMachineScaleSetId.Name, virtualMachineScaleSetId.ResourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualMachineScaleSetExtensionRead(d, meta)
+}
+
+func resourceArmVirtualMachineScaleSetExtensionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetExtensionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseVirtualMachineScaleSetExtensionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Extension %q (Virtual Machine Scale Set %q / Resource Group %q) was not found - removing from state!", id.Name, id.VirtualMachineScaleSetName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.Name, id.VirtualMachineScaleSetName, id.ResourceGroup, err)
+	}
+
+	virtualMachineScaleSetId := parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+
+	d.Set("name", id.Name)
+	d.Set("virtual_machine_scale_set_id", virtualMachineScaleSetId.ID())
+
+	if props := resp.VirtualMachineScaleSetExtensionProperties; props != nil {
+		d.Set("auto_upgrade_minor_version", props.AutoUpgradeMinorVersion)
+		d.Set("force_update_tag", props.ForceUpdateTag)
+		d.Set("publisher", props.Publisher)
+		d.Set("type", props.Type)
+		d.Set("type_handler_version", props.TypeHandlerVersion)
+
+		if err := d.Set("provision_after_extensions", utils.FlattenStringSlice(props.ProvisionAfterExtensions)); err != nil {
+			return fmt.Errorf("Error setting `provision_after_extensions`: %+v", err)
+		}
+
+		settings := ""
+		if props.Settings != nil {
+			settingsVal, ok := props.Settings.(map[string]interface{})
+			if ok {
+				settingsJson, err := structure.FlattenJsonToString(settingsVal)
+				if err != nil {
+					return fmt.Errorf("Error parsing `settings` from the API: %+v", err)
+				}
+				settings = settingsJson
+			}
+		}
+		d.Set("settings", settings)
+	}
+
+	return nil
+}
+
+func resourceArmVirtualMachineScaleSetExtensionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetExtensionsClient
+	vmssClient := meta.(*clients.Client).Compute.VMScaleSetClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseVirtualMachineScaleSetExtensionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	virtualMachineScaleSetId := parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+
+	locks.ByName(id.VirtualMachineScaleSetName, virtualMachineScaleSetResourceName)
+	defer locks.UnlockByName(id.VirtualMachineScaleSetName, virtualMachineScaleSetResourceName)
+
+	virtualMachineScaleSet, err := vmssClient.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Machine Scale Set %q (Resource Group %q): %+v", id.VirtualMachineScaleSetName, id.ResourceGroup, err)
+	}
+
+	updateStarted := time.Now()
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return updateVirtualMachineScaleSetExtensionsTag(ctx, vmssClient, &virtualMachineScaleSetId, virtualMachineScaleSet, id.Name, false)
+		}
+
+		return fmt.Errorf("Error deleting Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.Name, id.VirtualMachineScaleSetName, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.Name, id.VirtualMachineScaleSetName, id.ResourceGroup, err)
+	}
+
+	if err := upgradeVirtualMachineScaleSetInstancesForExtension(ctx, meta, &virtualMachineScaleSetId, virtualMachineScaleSet, updateStarted); err != nil {
+		return fmt.Errorf("Error upgrading the instances within Virtual Machine Scale Set %q (Resource Group %q) after deleting Extension %q: %+v", id.VirtualMachineScaleSetName, id.ResourceGroup, id.Name, err)
+	}
+
+	return updateVirtualMachineScaleSetExtensionsTag(ctx, vmssClient, &virtualMachineScaleSetId, virtualMachineScaleSet, id.Name, false)
+}
+
+// upgradeVirtualMachineScaleSetInstancesForExtension ensures the instances within the Virtual Machine Scale Set are upgraded
+// to the latest model once an Extension has been changed, since Extensions form part of the Scale Set's model
+func upgradeVirtualMachineScaleSetInstancesForExtension(ctx context.Context, meta interface{}, id *parse.VirtualMachineScaleSetID, virtualMachineScaleSet compute.VirtualMachineScaleSet, updateStarted time.Time) error {
+	upgradeMode := compute.Manual
+	if props := virtualMachineScaleSet.VirtualMachineScaleSetProperties; props != nil && props.UpgradePolicy != nil && props.UpgradePolicy.Mode != "" {
+		upgradeMode = props.UpgradePolicy.Mode
+	}
+
+	return upgradeVirtualMachineScaleSetInstances(ctx, meta, id, upgradeMode, updateStarted)
+}
+
+// virtualMachineScaleSetExtensionsTagName is the name of the (hidden) Tag on the Virtual Machine Scale Set which tracks the names of the
+// Extensions managed using the `azurerm_virtual_machine_scale_set_extension` resource, so that the Virtual Machine Scale Set resources
+// can tell these apart from any other Extensions on the Scale Set
+const virtualMachineScaleSetExtensionsTagName = "hidden-terraform-managed-extensions"
+
+// virtualMachineScaleSetStandaloneExtensionNames returns the names of the Extensions managed using the
+// `azurerm_virtual_machine_scale_set_extension` resource, as tracked in the Tags on the Virtual Machine Scale Set
+func virtualMachineScaleSetStandaloneExtensionNames(input map[string]*string) map[string]bool {
+	names := make(map[string]bool)
+
+	if v, ok := input[virtualMachineScaleSetExtensionsTagName]; ok && v != nil {
+		for _, name := range strings.Split(*v, ",") {
+			if name != "" {
+				names[name] = true
+			}
+		}
+	}
+
+	return names
+}
+
+// withoutVirtualMachineScaleSetExtensionsTag returns the Tags on the Virtual Machine Scale Set, excluding the (hidden) Tag which
+// tracks the Extensions managed using the `azurerm_virtual_machine_scale_set_extension` resource
+func withoutVirtualMachineScaleSetExtensionsTag(input map[string]*string) map[string]*string {
+	output := make(map[string]*string, len(input))
+
+	for k, v := range input {
+		if k != virtualMachineScaleSetExtensionsTagName {
+			output[k] = v
+		}
+	}
+
+	return output
+}
+
+// withVirtualMachineScaleSetExtensionsTag returns the specified Tags including the (hidden) Tag which tracks the Extensions managed
+// using the `azurerm_virtual_machine_scale_set_extension` resource from the `existing` Tags, since otherwise this'd be removed when
+// the Tags on the Virtual Machine Scale Set are replaced
+func withVirtualMachineScaleSetExtensionsTag(input map[string]*string, existing map[string]*string) map[string]*string {
+	v, ok := existing[virtualMachineScaleSetExtensionsTagName]
+	if !ok {
+		return input
+	}
+
+	output := make(map[string]*string, len(input)+1)
+	for k, v := range input {
+		output[k] = v
+	}
+	output[virtualMachineScaleSetExtensionsTagName] = v
+
+	return output
+}
+
+// updateVirtualMachineScaleSetExtensionsTag adds (or removes) the specified Extension from the (hidden) Tag on the Virtual Machine
+// Scale Set which tracks the Extensions managed using the `azurerm_virtual_machine_scale_set_extension` resource
+func updateVirtualMachineScaleSetExtensionsTag(ctx context.Context, client *compute.VirtualMachineScaleSetsClient, id *parse.VirtualMachineScaleSetID, virtualMachineScaleSet compute.VirtualMachineScaleSet, name string, managed bool) error {
+	names := virtualMachineScaleSetStandaloneExtensionNames(virtualMachineScaleSet.Tags)
+	if names[name] == managed {
+		return nil
+	}
+
+	if managed {
+		names[name] = true
+	} else {
+		delete(names, name)
+	}
+
+	updatedTags := withoutVirtualMachineScaleSetExtensionsTag(virtualMachineScaleSet.Tags)
+	if len(names) > 0 {
+		sortedNames := make([]string, 0, len(names))
+		for k := range names {
+			sortedNames = append(sortedNames, k)
+		}
+		sort.Strings(sortedNames)
+
+		updatedTags[virtualMachineScaleSetExtensionsTagName] = utils.String(strings.Join(sortedNames, ","))
+	}
+
+	update := compute.VirtualMachineScaleSetUpdate{
+		Tags: updatedTags,
+	}
+	future, err := client.Update(ctx, id.ResourceGroup, id.Name, update)
+	if err != nil {
+		return fmt.Errorf("Error updating the Tags for Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the Tags for Virtual Machine Scale Set %q (Resource Group %q) to be updated: %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package compute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestVirtualMachineScaleSetStandaloneExtensionNames(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Tags     map[string]*string
+		Expected map[string]bool
+	}{
+		{
+			Name:     "no tags",
+			Tags:     nil,
+			Expected: map[string]bool{},
+		},
+		{
+			Name: "tag not present",
+			Tags: map[string]*string{
+				"environment": utils.String("Production"),
+			},
+			Expected: map[string]bool{},
+		},
+		{
+			Name: "single extension",
+			Tags: map[string]*string{
+				virtualMachineScaleSetExtensionsTagName: utils.String("CustomScript"),
+			},
+			Expected: map[string]bool{
+				"CustomScript": true,
+			},
+		},
+		{
+			Name: "multiple extensions",
+			Tags: map[string]*string{
+				"environment":                           utils.String("Production"),
+				virtualMachineScaleSetExtensionsTagName: utils.String("CustomScript,HealthExtension"),
+			},
+			Expected: map[string]bool{
+				"CustomScript":    true,
+				"HealthExtension": true,
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := virtualMachineScaleSetStandaloneExtensionNames(v.Tags)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestVirtualMachineScaleSetExtensionsTag(t *testing.T) {
+	existing := map[string]*string{
+		"environment":                           utils.String("Production"),
+		virtualMachineScaleSetExtensionsTagName: utils.String("CustomScript"),
+	}
+
+	withoutTag := withoutVirtualMachineScaleSetExtensionsTag(existing)
+	if _, ok := withoutTag[virtualMachineScaleSetExtensionsTagName]; ok {
+		t.Fatalf("Expected the hidden tag to be removed but got %+v", withoutTag)
+	}
+	if len(withoutTag) != 1 {
+		t.Fatalf("Expected 1 tag but got %d", len(withoutTag))
+	}
+
+	withTag := withVirtualMachineScaleSetExtensionsTag(map[string]*string{"team": utils.String("Compute")}, existing)
+	if v, ok := withTag[virtualMachineScaleSetExtensionsTagName]; !ok || *v != "CustomScript" {
+		t.Fatalf("Expected the hidden tag to be retained but got %+v", withTag)
+	}
+	if len(withTag) != 2 {
+		t.Fatalf("Expected 2 tags but got %d", len(withTag))
+	}
+
+	unchanged := withVirtualMachineScaleSetExtensionsTag(map[string]*string{"team": utils.String("Compute")}, map[string]*string{})
+	if len(unchanged) != 1 {
+		t.Fatalf("Expected 1 tag but got %d", len(unchanged))
+	}
+}
@@ -1,10 +1,11 @@
-package azurerm
+package compute
 
 import (
 	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 )
 
 func resourceVirtualMachineScaleSetMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
@@ -25,8 +26,8 @@ func resourceVirtualMachineScaleSetStateV0toV1(is *terraform.InstanceState, meta
 
 	log.Printf("[DEBUG] ARM Virtual Machine Scale Set Attributes before Migration: %#v", is.Attributes)
 
-	client := meta.(*ArmClient).compute.VMScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Compute.VMScaleSetClient
+	ctx := meta.(*clients.Client).StopContext
 
 	resGroup := is.Attributes["resource_group_name"]
 	name := is.Attributes["name"]
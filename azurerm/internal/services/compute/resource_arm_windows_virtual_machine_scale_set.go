@@ -0,0 +1,586 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmWindowsVirtualMachineScaleSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmWindowsVirtualMachineScaleSetCreate,
+		Read:   resourceArmWindowsVirtualMachineScaleSetRead,
+		Update: resourceArmWindowsVirtualMachineScaleSetUpdate,
+		Delete: resourceArmWindowsVirtualMachineScaleSetDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseVirtualMachineScaleSetID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"admin_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"admin_username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"instances": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"network_interface": virtualMachineScaleSetNetworkInterfaceSchema(),
+
+			"os_disk": virtualMachineScaleSetOSDiskSchema(),
+
+			"sku": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"boot_diagnostics": virtualMachineBootDiagnosticsSchema(),
+
+			"computer_name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateWindowsVirtualMachineScaleSetComputerNamePrefix,
+			},
+
+			"custom_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.Base64String(),
+			},
+
+			"data_disk": virtualMachineScaleSetDataDiskSchema(),
+
+			"enable_automatic_updates": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"eviction_policy": virtualMachineEvictionPolicySchema(),
+
+			"health_probe_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"identity": virtualMachineIdentitySchema(),
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Windows_Client",
+					"Windows_Server",
+				}, false),
+			},
+
+			"max_bid_price": virtualMachineMaxBidPriceSchema(),
+
+			"overprovision": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"priority": virtualMachinePrioritySchema(),
+
+			"provision_vm_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"proximity_placement_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateProximityPlacementGroupID,
+			},
+
+			"rolling_upgrade_policy": virtualMachineScaleSetRollingUpgradePolicySchema(),
+
+			"single_placement_group": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"source_image_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"source_image_reference": virtualMachineScaleSetSourceImageReferenceSchema(),
+
+			"tags": tags.Schema(),
+
+			"timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualMachineTimeZone(),
+			},
+
+			"upgrade_mode": virtualMachineScaleSetUpgradeModeSchema(),
+
+			"winrm_listener": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.HTTP),
+								string(compute.HTTPS),
+							}, false),
+						},
+
+						"certificate_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"zone_balance": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"zones": azure.SchemaZones(),
+
+			"unique_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmWindowsVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*clients.Client).Features.Resources.RequireImport {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Windows Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_windows_virtual_machine_scale_set", *existing.ID)
+		}
+	}
+
+	computerNamePrefix := virtualMachineScaleSetDefaultComputerNamePrefix(d)
+	if _, errs := validateWindowsVirtualMachineScaleSetComputerNamePrefix(computerNamePrefix, "computer_name_prefix"); len(errs) > 0 {
+		return fmt.Errorf("Unable to use the name of the Windows Virtual Machine Scale Set %q as the `computer_name_prefix` - please specify a `computer_name_prefix`: %+v", name, errs[0])
+	}
+
+	winRmListeners, err := expandWindowsVirtualMachineWinRMListeners(d.Get("winrm_listener").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	identity, err := expandVirtualMachineScaleSetIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error expanding `identity`: %+v", err)
+	}
+
+	sourceImageReference, err := expandVirtualMachineSourceImageReference(d.Get("source_image_reference").([]interface{}), d.Get("source_image_id").(string))
+	if err != nil {
+		return err
+	}
+
+	upgradePolicy, err := expandVirtualMachineScaleSetUpgradePolicy(d)
+	if err != nil {
+		return err
+	}
+
+	priority, evictionPolicy, billingProfile, err := expandVirtualMachinePriority(d)
+	if err != nil {
+		return err
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	osProfile := compute.VirtualMachineScaleSetOSProfile{
+		AdminUsername:      utils.String(d.Get("admin_username").(string)),
+		AdminPassword:      utils.String(d.Get("admin_password").(string)),
+		ComputerNamePrefix: utils.String(computerNamePrefix),
+		WindowsConfiguration: &compute.WindowsConfiguration{
+			EnableAutomaticUpdates: utils.Bool(d.Get("enable_automatic_updates").(bool)),
+			ProvisionVMAgent:       utils.Bool(d.Get("provision_vm_agent").(bool)),
+			WinRM: &compute.WinRMConfiguration{
+				Listeners: winRmListeners,
+			},
+		},
+	}
+
+	if v, ok := d.GetOk("timezone"); ok {
+		osProfile.WindowsConfiguration.TimeZone = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("custom_data"); ok {
+		osProfile.CustomData = utils.String(v.(string))
+	}
+
+	networkProfile := &compute.VirtualMachineScaleSetNetworkProfile{
+		NetworkInterfaceConfigurations: expandVirtualMachineScaleSetNetworkInterfaces(d.Get("network_interface").([]interface{})),
+	}
+	if v, ok := d.GetOk("health_probe_id"); ok {
+		networkProfile.HealthProbe = &compute.APIEntityReference{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	params := compute.VirtualMachineScaleSet{
+		Location: utils.String(location),
+		Sku: &compute.Sku{
+			Name:     utils.String(d.Get("sku").(string)),
+			Capacity: utils.Int64(int64(d.Get("instances").(int))),
+
+			// doesn't appear this can be set to anything else, even Promo machines are Standard
+			Tier: utils.String("Standard"),
+		},
+		Identity: identity,
+		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
+			Overprovision:        utils.Bool(d.Get("overprovision").(bool)),
+			SinglePlacementGroup: utils.Bool(d.Get("single_placement_group").(bool)),
+			UpgradePolicy:        upgradePolicy,
+			VirtualMachineProfile: &compute.VirtualMachineScaleSetVMProfile{
+				BillingProfile:     billingProfile,
+				DiagnosticsProfile: expandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{})),
+				EvictionPolicy:     evictionPolicy,
+				NetworkProfile:     networkProfile,
+				OsProfile:          &osProfile,
+				Priority:           priority,
+				StorageProfile: &compute.VirtualMachineScaleSetStorageProfile{
+					ImageReference: sourceImageReference,
+					OsDisk:         expandVirtualMachineScaleSetOSDisk(d.Get("os_disk").([]interface{}), compute.Windows),
+					DataDisks:      expandVirtualMachineScaleSetDataDisks(d.Get("data_disk").([]interface{})),
+				},
+			},
+			ZoneBalance: utils.Bool(d.Get("zone_balance").(bool)),
+		},
+		Zones: azure.ExpandZones(d.Get("zones").([]interface{})),
+		Tags:  tags.Expand(t),
+	}
+
+	if v, ok := d.GetOk("license_type"); ok {
+		params.VirtualMachineScaleSetProperties.VirtualMachineProfile.LicenseType = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("proximity_placement_group_id"); ok {
+		params.VirtualMachineScaleSetProperties.ProximityPlacementGroup = &compute.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, params)
+	if err != nil {
+		return fmt.Errorf("Error creating Windows Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Windows Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Windows Virtual Machine Scale Set %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmWindowsVirtualMachineScaleSetRead(d, meta)
+}
+
+func resourceArmWindowsVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseVirtualMachineScaleSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Windows Virtual Machine Scale Set %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Windows Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+	d.Set("zones", resp.Zones)
+
+	if sku := resp.Sku; sku != nil {
+		instances := 0
+		if sku.Capacity != nil {
+			instances = int(*sku.Capacity)
+		}
+		d.Set("instances", instances)
+		d.Set("sku", sku.Name)
+	}
+
+	if err := d.Set("identity", flattenVirtualMachineScaleSetIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	props := resp.VirtualMachineScaleSetProperties
+	if props == nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine Scale Set %q (Resource Group %q): `properties` was nil", id.Name, id.ResourceGroup)
+	}
+
+	d.Set("overprovision", props.Overprovision)
+	d.Set("single_placement_group", props.SinglePlacementGroup)
+	d.Set("unique_id", props.UniqueID)
+	d.Set("zone_balance", props.ZoneBalance)
+
+	proximityPlacementGroupId := ""
+	if props.ProximityPlacementGroup != nil && props.ProximityPlacementGroup.ID != nil {
+		proximityPlacementGroupId = *props.ProximityPlacementGroup.ID
+	}
+	d.Set("proximity_placement_group_id", proximityPlacementGroupId)
+
+	if policy := props.UpgradePolicy; policy != nil {
+		d.Set("upgrade_mode", string(policy.Mode))
+		if err := d.Set("rolling_upgrade_policy", flattenVirtualMachineScaleSetRollingUpgradePolicy(policy.RollingUpgradePolicy)); err != nil {
+			return fmt.Errorf("Error setting `rolling_upgrade_policy`: %+v", err)
+		}
+	}
+
+	profile := props.VirtualMachineProfile
+	if profile == nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine Scale Set %q (Resource Group %q): `properties.virtualMachineProfile` was nil", id.Name, id.ResourceGroup)
+	}
+
+	if err := d.Set("boot_diagnostics", flattenVirtualMachineBootDiagnostics(profile.DiagnosticsProfile)); err != nil {
+		return fmt.Errorf("Error setting `boot_diagnostics`: %+v", err)
+	}
+
+	d.Set("eviction_policy", string(profile.EvictionPolicy))
+	d.Set("license_type", profile.LicenseType)
+	d.Set("max_bid_price", flattenVirtualMachineMaxBidPrice(profile.BillingProfile))
+	d.Set("priority", string(profile.Priority))
+
+	if networkProfile := profile.NetworkProfile; networkProfile != nil {
+		if err := d.Set("network_interface", flattenVirtualMachineScaleSetNetworkInterfaces(networkProfile.NetworkInterfaceConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `network_interface`: %+v", err)
+		}
+
+		healthProbeId := ""
+		if networkProfile.HealthProbe != nil && networkProfile.HealthProbe.ID != nil {
+			healthProbeId = *networkProfile.HealthProbe.ID
+		}
+		d.Set("health_probe_id", healthProbeId)
+	}
+
+	if osProfile := profile.OsProfile; osProfile != nil {
+		d.Set("admin_username", osProfile.AdminUsername)
+		d.Set("computer_name_prefix", osProfile.ComputerNamePrefix)
+
+		if config := osProfile.WindowsConfiguration; config != nil {
+			d.Set("enable_automatic_updates", config.EnableAutomaticUpdates)
+			d.Set("provision_vm_agent", config.ProvisionVMAgent)
+			d.Set("timezone", config.TimeZone)
+
+			if err := d.Set("winrm_listener", flattenWindowsVirtualMachineWinRMListeners(config.WinRM)); err != nil {
+				return fmt.Errorf("Error setting `winrm_listener`: %+v", err)
+			}
+		}
+
+		// the `admin_password` and `custom_data` fields aren't returned from the API, so we keep the values from the config
+	}
+
+	if storageProfile := profile.StorageProfile; storageProfile != nil {
+		if err := d.Set("os_disk", flattenVirtualMachineScaleSetOSDisk(storageProfile.OsDisk)); err != nil {
+			return fmt.Errorf("Error setting `os_disk`: %+v", err)
+		}
+
+		if err := d.Set("data_disk", flattenVirtualMachineScaleSetDataDisks(storageProfile.DataDisks)); err != nil {
+			return fmt.Errorf("Error setting `data_disk`: %+v", err)
+		}
+
+		sourceImageId := ""
+		if storageProfile.ImageReference != nil && storageProfile.ImageReference.ID != nil {
+			sourceImageId = *storageProfile.ImageReference.ID
+		}
+		d.Set("source_image_id", sourceImageId)
+
+		if err := d.Set("source_image_reference", flattenVirtualMachineSourceImageReference(storageProfile.ImageReference)); err != nil {
+			return fmt.Errorf("Error setting `source_image_reference`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, withoutVirtualMachineScaleSetExtensionsTag(resp.Tags))
+}
+
+func resourceArmWindowsVirtualMachineScaleSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseVirtualMachineScaleSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, virtualMachineScaleSetResourceName)
+	defer locks.UnlockByName(id.Name, virtualMachineScaleSetResourceName)
+
+	details, err := expandVirtualMachineScaleSetUpdateDetails(d)
+	if err != nil {
+		return fmt.Errorf("Error determining the changes for Windows Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if d.HasChange("license_type") {
+		licenseType := d.Get("license_type").(string)
+		if licenseType == "" {
+			// the API requires that the License Type is explicitly set to None to remove it
+			licenseType = "None"
+		}
+
+		updateProps := details.update.VirtualMachineScaleSetUpdateProperties
+		if updateProps.VirtualMachineProfile == nil {
+			updateProps.VirtualMachineProfile = &compute.VirtualMachineScaleSetUpdateVMProfile{}
+		}
+		updateProps.VirtualMachineProfile.LicenseType = utils.String(licenseType)
+		details.updateInstances = true
+	}
+
+	upgradeMode := compute.UpgradeMode(d.Get("upgrade_mode").(string))
+	if err := applyVirtualMachineScaleSetUpdate(ctx, meta, id, details, upgradeMode); err != nil {
+		return fmt.Errorf("Error updating Windows Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return resourceArmWindowsVirtualMachineScaleSetRead(d, meta)
+}
+
+func resourceArmWindowsVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseVirtualMachineScaleSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, virtualMachineScaleSetResourceName)
+	defer locks.UnlockByName(id.Name, virtualMachineScaleSetResourceName)
+
+	if err := deleteVirtualMachineScaleSet(ctx, meta, id); err != nil {
+		return fmt.Errorf("Error deleting Windows Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func validateWindowsVirtualMachineScaleSetComputerNamePrefix(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	// Azure appends a 6 character suffix to the prefix for each instance, and Windows Computer Names are limited to 15 characters
+	if len(v) == 0 || len(v) > 9 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 9 characters in length but got %d", k, len(v)))
+	}
+
+	if regexp.MustCompile(`^[0-9]+$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q cannot contain only numbers", k))
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9-]+$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q can only contain alphanumeric characters and hyphens", k))
+	}
+
+	return warnings, errors
+}
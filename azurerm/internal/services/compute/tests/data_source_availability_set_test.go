@@ -1,10 +1,11 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 
 	"github.com/hashicorp/terraform/helper/resource"
 )
@@ -12,11 +13,11 @@ import (
 func TestAccDataSourceAvailabilitySet_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_availability_set.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceAvailabilitySet_basic(ri, location),
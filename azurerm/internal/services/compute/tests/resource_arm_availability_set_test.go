@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -16,11 +18,11 @@ import (
 func TestAccAzureRMAvailabilitySet_basic(t *testing.T) {
 	resourceName := "azurerm_availability_set.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMAvailabilitySet_basic(ri, testLocation())
+	config := testAccAzureRMAvailabilitySet_basic(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMAvailabilitySetDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -48,11 +50,11 @@ func TestAccAzureRMAvailabilitySet_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_availability_set.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMAvailabilitySetDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -65,7 +67,7 @@ func TestAccAzureRMAvailabilitySet_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMAvailabilitySet_requiresImport(ri, location),
-				ExpectError: testRequiresImportError("azurerm_availability_set"),
+				ExpectError: acceptance.RequiresImportError("azurerm_availability_set"),
 			},
 		},
 	})
@@ -74,11 +76,11 @@ func TestAccAzureRMAvailabilitySet_requiresImport(t *testing.T) {
 func TestAccAzureRMAvailabilitySet_disappears(t *testing.T) {
 	resourceName := "azurerm_availability_set.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMAvailabilitySet_basic(ri, testLocation())
+	config := testAccAzureRMAvailabilitySet_basic(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMAvailabilitySetDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -98,13 +100,13 @@ func TestAccAzureRMAvailabilitySet_disappears(t *testing.T) {
 func TestAccAzureRMAvailabilitySet_withTags(t *testing.T) {
 	resourceName := "azurerm_availability_set.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	preConfig := testAccAzureRMAvailabilitySet_withTags(ri, location)
 	postConfig := testAccAzureRMAvailabilitySet_withUpdatedTags(ri, location)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMAvailabilitySetDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -136,11 +138,11 @@ func TestAccAzureRMAvailabilitySet_withTags(t *testing.T) {
 func TestAccAzureRMAvailabilitySet_withPPG(t *testing.T) {
 	resourceName := "azurerm_availability_set.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMAvailabilitySet_withPPG(ri, testLocation())
+	config := testAccAzureRMAvailabilitySet_withPPG(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMAvailabilitySetDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -162,11 +164,11 @@ func TestAccAzureRMAvailabilitySet_withPPG(t *testing.T) {
 func TestAccAzureRMAvailabilitySet_withDomainCounts(t *testing.T) {
 	resourceName := "azurerm_availability_set.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMAvailabilitySet_withDomainCounts(ri, testLocation())
+	config := testAccAzureRMAvailabilitySet_withDomainCounts(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMAvailabilitySetDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -189,11 +191,11 @@ func TestAccAzureRMAvailabilitySet_withDomainCounts(t *testing.T) {
 func TestAccAzureRMAvailabilitySet_managed(t *testing.T) {
 	resourceName := "azurerm_availability_set.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMAvailabilitySet_managed(ri, testLocation())
+	config := testAccAzureRMAvailabilitySet_managed(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMAvailabilitySetDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -228,8 +230,8 @@ func testCheckAzureRMAvailabilitySetExists(resourceName string) resource.TestChe
 			return fmt.Errorf("Bad: no resource group found in state for availability set: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).compute.AvailabilitySetsClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Compute.AvailabilitySetsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		vmss, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
@@ -258,8 +260,8 @@ func testCheckAzureRMAvailabilitySetDisappears(resourceName string) resource.Tes
 			return fmt.Errorf("Bad: no resource group found in state for availability set: %s", availSetName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).compute.AvailabilitySetsClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Compute.AvailabilitySetsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 		resp, err := client.Delete(ctx, resourceGroup, availSetName)
 		if err != nil {
 			if !response.WasNotFound(resp.Response) {
@@ -280,8 +282,8 @@ func testCheckAzureRMAvailabilitySetDestroy(s *terraform.State) error {
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).compute.AvailabilitySetsClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Compute.AvailabilitySetsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 		resp, err := client.Get(ctx, resourceGroup, name)
 
 		if err != nil {
@@ -0,0 +1,159 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualMachineRunCommand_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_run_command.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMLinuxVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineRunCommand_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineRunCommandExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exit_code", "0"),
+					resource.TestCheckResourceAttr(resourceName, "stdout", "hello world"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineRunCommand_update(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_run_command.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMLinuxVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineRunCommand_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineRunCommandExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "stdout", "hello world"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineRunCommand_parameters(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineRunCommandExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exit_code", "0"),
+					resource.TestCheckResourceAttr(resourceName, "stdout", "hello terraform"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineRunCommand_exitCode(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_run_command.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMLinuxVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineRunCommand_exitCode(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineRunCommandExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exit_code", "3"),
+					resource.TestCheckResourceAttr(resourceName, "stderr", "oops"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualMachineRunCommandExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Virtual Machine Run Command not found: %s", resourceName)
+		}
+
+		id, err := parse.ParseVirtualMachineRunCommandID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Compute.VMClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineName, ""); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Virtual Machine %q (Resource Group %q) does not exist", id.VirtualMachineName, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on compute.VirtualMachinesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMVirtualMachineRunCommand_basic(rInt int, location string) string {
+	template := testAccAzureRMLinuxVirtualMachine_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestrc-%d"
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+  command_id         = "RunShellScript"
+  script             = ["echo 'hello world'"]
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualMachineRunCommand_parameters(rInt int, location string) string {
+	template := testAccAzureRMLinuxVirtualMachine_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestrc-%d"
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+  command_id         = "RunShellScript"
+  script             = ["echo \"hello $1\""]
+
+  parameters = {
+    arg1 = "terraform"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualMachineRunCommand_exitCode(rInt int, location string) string {
+	template := testAccAzureRMLinuxVirtualMachine_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestrc-%d"
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+  command_id         = "RunShellScript"
+  script             = ["echo 'oops' >&2", "exit 3"]
+}
+`, template, rInt)
+}
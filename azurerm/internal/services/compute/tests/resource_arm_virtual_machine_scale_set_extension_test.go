@@ -0,0 +1,389 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set_extension.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_upgrade_minor_version", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_virtual_machine_scale_set_extension.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualMachineScaleSetExtension_requiresImport(ri, location),
+				ExpectError: acceptance.RequiresImportError("azurerm_virtual_machine_scale_set_extension"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_update(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set_extension.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "force_update_tag", "second"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"protected_settings",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_provisionAfterExtensions(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set_extension.second"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_provisionAfterExtensions(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provision_after_extensions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "provision_after_extensions.0", "CustomScript"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_withInlineExtensions(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set_extension.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_withInlineExtensions(ri, location, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestCheckResourceAttr("azurerm_virtual_machine_scale_set.test", "extension.#", "1"),
+				),
+			},
+			{
+				// changing the Scale Set shouldn't remove the Extension managed outside of the `extension` block
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_withInlineExtensions(ri, location, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestCheckResourceAttr("azurerm_virtual_machine_scale_set.test", "extension.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// the Extension managed using the separate resource shouldn't be imported into the `extension` block
+				ResourceName:            "azurerm_virtual_machine_scale_set.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"os_profile.0.admin_password"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Virtual Machine Scale Set Extension not found: %s", resourceName)
+		}
+
+		id, err := parse.ParseVirtualMachineScaleSetExtensionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Compute.VMScaleSetExtensionsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.Name, ""); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Extension %q (Virtual Machine Scale Set %q / Resource Group %q) does not exist", id.Name, id.VirtualMachineScaleSetName, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on compute.VirtualMachineScaleSetExtensionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualMachineScaleSetExtensionDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Compute.VMScaleSetExtensionsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_machine_scale_set_extension" {
+			continue
+		}
+
+		id, err := parse.ParseVirtualMachineScaleSetExtensionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.Name, ""); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on compute.VirtualMachineScaleSetExtensionsClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("Extension %q (Virtual Machine Scale Set %q / Resource Group %q) still exists", id.Name, id.VirtualMachineScaleSetName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_basic(rInt int, location string) string {
+	template := testAccAzureRMLinuxVirtualMachineScaleSet_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                         = "CustomScript"
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+  publisher                    = "Microsoft.Azure.Extensions"
+  type                         = "CustomScript"
+  type_handler_version         = "2.0"
+
+  settings = jsonencode({
+    "commandToExecute" = "echo $HOSTNAME"
+  })
+}
+`, template)
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMVirtualMachineScaleSetExtension_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "import" {
+  name                         = azurerm_virtual_machine_scale_set_extension.test.name
+  virtual_machine_scale_set_id = azurerm_virtual_machine_scale_set_extension.test.virtual_machine_scale_set_id
+  publisher                    = azurerm_virtual_machine_scale_set_extension.test.publisher
+  type                         = azurerm_virtual_machine_scale_set_extension.test.type
+  type_handler_version         = azurerm_virtual_machine_scale_set_extension.test.type_handler_version
+  settings                     = azurerm_virtual_machine_scale_set_extension.test.settings
+}
+`, template)
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_updated(rInt int, location string) string {
+	template := testAccAzureRMLinuxVirtualMachineScaleSet_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                         = "CustomScript"
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+  publisher                    = "Microsoft.Azure.Extensions"
+  type                         = "CustomScript"
+  type_handler_version         = "2.0"
+  auto_upgrade_minor_version   = false
+  force_update_tag             = "second"
+
+  settings = jsonencode({
+    "commandToExecute" = "echo $HOSTNAME"
+  })
+
+  protected_settings = jsonencode({
+    "commandToExecute" = "echo $HOSTNAME && uptime"
+  })
+}
+`, template)
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_provisionAfterExtensions(rInt int, location string) string {
+	template := testAccAzureRMVirtualMachineScaleSetExtension_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "second" {
+  name                         = "Docker"
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+  publisher                    = "Microsoft.Azure.Extensions"
+  type                         = "DockerExtension"
+  type_handler_version         = "1.0"
+  provision_after_extensions   = [azurerm_virtual_machine_scale_set_extension.test.name]
+}
+`, template)
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_withInlineExtensions(rInt int, location, environment string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_virtual_machine_scale_set" "test" {
+  name                = "acctvmss-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  upgrade_policy_mode = "Manual"
+
+  sku {
+    name     = "Standard_F2"
+    tier     = "Standard"
+    capacity = 1
+  }
+
+  os_profile {
+    computer_name_prefix = "testvm-%d"
+    admin_username       = "myadmin"
+    admin_password       = "Passwword1234"
+  }
+
+  network_profile {
+    name    = "TestNetworkProfile-%d"
+    primary = true
+
+    ip_configuration {
+      name      = "TestIPConfiguration"
+      primary   = true
+      subnet_id = azurerm_subnet.test.id
+    }
+  }
+
+  storage_profile_os_disk {
+    name              = ""
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  storage_profile_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  extension {
+    name                 = "CustomScript"
+    publisher            = "Microsoft.Azure.Extensions"
+    type                 = "CustomScript"
+    type_handler_version = "2.0"
+    settings             = "{\"commandToExecute\":\"echo $HOSTNAME\"}"
+  }
+
+  tags = {
+    environment = "%s"
+  }
+}
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                         = "Docker"
+  virtual_machine_scale_set_id = azurerm_virtual_machine_scale_set.test.id
+  publisher                    = "Microsoft.Azure.Extensions"
+  type                         = "DockerExtension"
+  type_handler_version         = "1.0"
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt, environment)
+}
@@ -0,0 +1,32 @@
+package containers
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Container Services"
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azurerm_container_registry":          dataSourceArmContainerRegistry(),
+		"azurerm_kubernetes_cluster":          dataSourceArmKubernetesCluster(),
+		"azurerm_kubernetes_service_versions": dataSourceArmKubernetesServiceVersions(),
+	}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azurerm_container_group":            resourceArmContainerGroup(),
+		"azurerm_container_registry":         resourceArmContainerRegistry(),
+		"azurerm_container_registry_webhook": resourceArmContainerRegistryWebhook(),
+		"azurerm_container_service":          resourceArmContainerService(),
+		"azurerm_kubernetes_cluster":         resourceArmKubernetesCluster(),
+	}
+}
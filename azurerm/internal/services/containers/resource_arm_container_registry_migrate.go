@@ -1,4 +1,4 @@
-package azurerm
+package containers
 
 import (
 	"fmt"
@@ -7,9 +7,10 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 )
 
-func resourceAzureRMContainerRegistryMigrateState(
+func ResourceAzureRMContainerRegistryMigrateState(
 	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
 	switch v {
 	case 0:
@@ -91,8 +92,8 @@ func updateV1ToV2StorageAccountName(is *terraform.InstanceState, meta interface{
 }
 
 func findAzureStorageAccountIdFromName(name string, meta interface{}) (string, error) {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).Storage.AccountsClient
+	ctx := meta.(*clients.Client).StopContext
+	client := meta.(*clients.Client).Storage.AccountsClient
 	accounts, err := client.List(ctx)
 	if err != nil {
 		return "", err
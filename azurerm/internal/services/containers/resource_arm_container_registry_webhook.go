@@ -1,4 +1,4 @@
-package azurerm
+package containers
 
 import (
 	"fmt"
@@ -11,8 +11,9 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -98,15 +99,16 @@ func resourceArmContainerRegistryWebhook() *schema.Resource {
 }
 
 func resourceArmContainerRegistryWebhookCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containers.WebhooksClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Containers.WebhooksClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Container Registry Webhook creation.")
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	registryName := d.Get("registry_name").(string)
 	name := d.Get("name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, registryName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -152,8 +154,9 @@ func resourceArmContainerRegistryWebhookCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceArmContainerRegistryWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containers.WebhooksClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Containers.WebhooksClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Container Registry Webhook update.")
 
@@ -186,8 +189,9 @@ func resourceArmContainerRegistryWebhookUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceArmContainerRegistryWebhookRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containers.WebhooksClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Containers.WebhooksClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -251,8 +255,9 @@ func resourceArmContainerRegistryWebhookRead(d *schema.ResourceData, meta interf
 }
 
 func resourceArmContainerRegistryWebhookDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containers.WebhooksClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Containers.WebhooksClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 )
 
 func TestAccDataSourceAzureRMContainerRegistry_basic(t *testing.T) {
@@ -15,8 +16,8 @@ func TestAccDataSourceAzureRMContainerRegistry_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_container_registry.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -41,5 +42,5 @@ data "azurerm_container_registry" "test" {
   name                = "${azurerm_container_registry.test.name}"
   resource_group_name = "${azurerm_container_registry.test.resource_group_name}"
 }
-`, testAccAzureRMContainerRegistry_basicManaged(rInt, testLocation(), "Basic"))
+`, testAccAzureRMContainerRegistry_basicManaged(rInt, acceptance.Location(), "Basic"))
 }
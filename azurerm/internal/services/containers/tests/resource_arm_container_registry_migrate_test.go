@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"context"
@@ -12,27 +12,33 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers"
 )
 
 func TestAccAzureRMContainerRegistryMigrateState(t *testing.T) {
-	config := testGetAzureConfig(t)
+	config := acceptance.GetAuthConfig(t)
 	if config == nil {
 		t.SkipNow()
 		return
 	}
 
-	client, err := getArmClient(config, false, "", true)
+	client, err := clients.Build(clients.ClientBuilder{
+		AuthConfig:                  config,
+		DisableCorrelationRequestID: true,
+	})
 	if err != nil {
 		t.Fatal(fmt.Errorf("Error building ARM Client: %+v", err))
 		return
 	}
 
-	client.StopContext = testAccProvider.StopContext()
+	client.StopContext = acceptance.AzureProvider.StopContext()
 
 	rs := acctest.RandString(4)
 	resourceGroupName := fmt.Sprintf("acctestRG%s", rs)
 	storageAccountName := fmt.Sprintf("acctestsa%s", rs)
-	location := azure.NormalizeLocation(testLocation())
+	location := azure.NormalizeLocation(acceptance.Location())
 	ctx := client.StopContext
 
 	err = createResourceGroup(ctx, client, resourceGroupName, location)
@@ -88,7 +94,7 @@ func TestAccAzureRMContainerRegistryMigrateState(t *testing.T) {
 			ID:         tc.ID,
 			Attributes: tc.Attributes,
 		}
-		is, err := resourceAzureRMContainerRegistryMigrateState(tc.StateVersion, is, tc.Meta)
+		is, err := containers.ResourceAzureRMContainerRegistryMigrateState(tc.StateVersion, is, tc.Meta)
 
 		if err != nil {
 			t.Fatalf("bad: %q, err: %#v", tn, err)
@@ -100,18 +106,18 @@ func TestAccAzureRMContainerRegistryMigrateState(t *testing.T) {
 	}
 }
 
-func createResourceGroup(ctx context.Context, client *ArmClient, resourceGroupName string, location string) error {
+func createResourceGroup(ctx context.Context, client *clients.Client, resourceGroupName string, location string) error {
 	group := resources.Group{
 		Location: &location,
 	}
 
-	if _, err := client.resource.GroupsClient.CreateOrUpdate(ctx, resourceGroupName, group); err != nil {
+	if _, err := client.Resource.GroupsClient.CreateOrUpdate(ctx, resourceGroupName, group); err != nil {
 		return fmt.Errorf("Error creating Resource Group %q: %+v", resourceGroupName, err)
 	}
 	return nil
 }
 
-func createStorageAccount(client *ArmClient, resourceGroupName, storageAccountName, location string) (*storage.Account, error) {
+func createStorageAccount(client *clients.Client, resourceGroupName, storageAccountName, location string) (*storage.Account, error) {
 	storageClient := client.Storage.AccountsClient
 	createParams := storage.AccountCreateParameters{
 		Location: &location,
@@ -139,12 +145,12 @@ func createStorageAccount(client *ArmClient, resourceGroupName, storageAccountNa
 	return &account, nil
 }
 
-func destroyStorageAccountAndResourceGroup(client *ArmClient, resourceGroupName, storageAccountName string) {
+func destroyStorageAccountAndResourceGroup(client *clients.Client, resourceGroupName, storageAccountName string) {
 	ctx := client.StopContext
 	if _, err := client.Storage.AccountsClient.Delete(ctx, resourceGroupName, storageAccountName); err != nil {
 		log.Printf("[DEBUG] Error deleting Storage Account %q (Resource Group %q): %v", storageAccountName, resourceGroupName, err)
 	}
-	if _, err := client.resource.GroupsClient.Delete(ctx, resourceGroupName); err != nil {
+	if _, err := client.Resource.GroupsClient.Delete(ctx, resourceGroupName); err != nil {
 		log.Printf("[DEBUG] Error deleting Resource Group %q): %v", resourceGroupName, err)
 	}
 }
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -10,74 +10,20 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-func TestAccAzureRMContainerRegistryName_validation(t *testing.T) {
-	cases := []struct {
-		Value    string
-		ErrCount int
-	}{
-		{
-			Value:    "four",
-			ErrCount: 1,
-		},
-		{
-			Value:    "5five",
-			ErrCount: 0,
-		},
-		{
-			Value:    "hello-world",
-			ErrCount: 1,
-		},
-		{
-			Value:    "hello_world",
-			ErrCount: 1,
-		},
-		{
-			Value:    "helloWorld",
-			ErrCount: 0,
-		},
-		{
-			Value:    "helloworld12",
-			ErrCount: 0,
-		},
-		{
-			Value:    "hello@world",
-			ErrCount: 1,
-		},
-		{
-			Value:    "qfvbdsbvipqdbwsbddbdcwqffewsqwcdw21ddwqwd3324120",
-			ErrCount: 0,
-		},
-		{
-			Value:    "qfvbdsbvipqdbwsbddbdcwqffewsqwcdw21ddwqwd33241202",
-			ErrCount: 0,
-		},
-		{
-			Value:    "qfvbdsbvipqdbwsbddbdcwqfjjfewsqwcdw21ddwqwd3324120",
-			ErrCount: 1,
-		},
-	}
-
-	for _, tc := range cases {
-		_, errors := validateAzureRMContainerRegistryName(tc.Value, "azurerm_container_registry")
-
-		if len(errors) != tc.ErrCount {
-			t.Fatalf("Expected the Azure RM Container Registry Name to trigger a validation error: %v", errors)
-		}
-	}
-}
-
 func TestAccAzureRMContainerRegistry_basic_basic(t *testing.T) {
 	rn := "azurerm_container_registry.test"
 	ri := tf.AccRandTimeInt()
-	l := testLocation()
+	l := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -103,11 +49,11 @@ func TestAccAzureRMContainerRegistry_requiresImport(t *testing.T) {
 
 	rn := "azurerm_container_registry.test"
 	ri := tf.AccRandTimeInt()
-	l := testLocation()
+	l := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -118,7 +64,7 @@ func TestAccAzureRMContainerRegistry_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMContainerRegistry_requiresImport(ri, l, "Basic"),
-				ExpectError: testRequiresImportError("azurerm_container_registry"),
+				ExpectError: acceptance.RequiresImportError("azurerm_container_registry"),
 			},
 		},
 	})
@@ -129,12 +75,12 @@ func TestAccAzureRMContainerRegistry_basic_standard(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMContainerRegistry_basicManaged(ri, testLocation(), "Standard"),
+				Config: testAccAzureRMContainerRegistry_basicManaged(ri, acceptance.Location(), "Standard"),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMContainerRegistryExists(rn),
 				),
@@ -153,12 +99,12 @@ func TestAccAzureRMContainerRegistry_basic_premium(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMContainerRegistry_basicManaged(ri, testLocation(), "Premium"),
+				Config: testAccAzureRMContainerRegistry_basicManaged(ri, acceptance.Location(), "Premium"),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMContainerRegistryExists(rn),
 				),
@@ -177,26 +123,26 @@ func TestAccAzureRMContainerRegistry_basic_basic2Premium2basic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMContainerRegistry_basic_basic(ri, testLocation()),
+				Config: testAccAzureRMContainerRegistry_basic_basic(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMContainerRegistryExists(rn),
 					resource.TestCheckResourceAttr(rn, "sku", "Basic"),
 				),
 			},
 			{
-				Config: testAccAzureRMContainerRegistry_basicManaged(ri, testLocation(), "Premium"),
+				Config: testAccAzureRMContainerRegistry_basicManaged(ri, acceptance.Location(), "Premium"),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMContainerRegistryExists(rn),
 					resource.TestCheckResourceAttr(rn, "sku", "Premium"),
 				),
 			},
 			{
-				Config: testAccAzureRMContainerRegistry_basic_basic(ri, testLocation()),
+				Config: testAccAzureRMContainerRegistry_basic_basic(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMContainerRegistryExists(rn),
 					resource.TestCheckResourceAttr(rn, "sku", "Basic"),
@@ -211,12 +157,12 @@ func TestAccAzureRMContainerRegistry_complete(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMContainerRegistry_complete(ri, testLocation()),
+				Config: testAccAzureRMContainerRegistry_complete(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMContainerRegistryExists(rn),
 				),
@@ -233,11 +179,11 @@ func TestAccAzureRMContainerRegistry_complete(t *testing.T) {
 func TestAccAzureRMContainerRegistry_update(t *testing.T) {
 	rn := "azurerm_container_registry.test"
 	ri := tf.AccRandTimeInt()
-	l := testLocation()
+	l := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -264,14 +210,14 @@ func TestAccAzureRMContainerRegistry_geoReplication(t *testing.T) {
 	skuBasic := "Basic"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
 		Steps: []resource.TestStep{
 			// first config creates an ACR with locations
 			{
 				// TODO: fix this to use dynamic locations
-				Config: testAccAzureRMContainerRegistry_geoReplication(ri, testLocation(), skuPremium, `eastus", "westus`),
+				Config: testAccAzureRMContainerRegistry_geoReplication(ri, acceptance.Location(), skuPremium, `eastus", "westus`),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(dsn, "sku", skuPremium),
 					resource.TestCheckResourceAttr(dsn, "georeplication_locations.#", "2"),
@@ -281,7 +227,7 @@ func TestAccAzureRMContainerRegistry_geoReplication(t *testing.T) {
 			},
 			// second config udpates the ACR with updated locations
 			{
-				Config: testAccAzureRMContainerRegistry_geoReplication(ri, testLocation(), skuPremium, `centralus", "eastus`),
+				Config: testAccAzureRMContainerRegistry_geoReplication(ri, acceptance.Location(), skuPremium, `centralus", "eastus`),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(dsn, "sku", skuPremium),
 					resource.TestCheckResourceAttr(dsn, "georeplication_locations.#", "2"),
@@ -291,7 +237,7 @@ func TestAccAzureRMContainerRegistry_geoReplication(t *testing.T) {
 			},
 			// third config udpates the ACR with no location
 			{
-				Config: testAccAzureRMContainerRegistry_geoReplicationUpdateWithNoLocation(ri, testLocation(), skuPremium),
+				Config: testAccAzureRMContainerRegistry_geoReplicationUpdateWithNoLocation(ri, acceptance.Location(), skuPremium),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(dsn, "sku", skuPremium),
 					testCheckAzureRMContainerRegistryExists(dsn),
@@ -300,7 +246,7 @@ func TestAccAzureRMContainerRegistry_geoReplication(t *testing.T) {
 			},
 			// fourth config updates an ACR with replicas
 			{
-				Config: testAccAzureRMContainerRegistry_geoReplication(ri, testLocation(), skuPremium, `eastus", "westus`),
+				Config: testAccAzureRMContainerRegistry_geoReplication(ri, acceptance.Location(), skuPremium, `eastus", "westus`),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(dsn, "sku", skuPremium),
 					resource.TestCheckResourceAttr(dsn, "georeplication_locations.#", "2"),
@@ -310,7 +256,7 @@ func TestAccAzureRMContainerRegistry_geoReplication(t *testing.T) {
 			},
 			// fifth config updates the SKU to basic and no replicas (should remove the existing replicas if any)
 			{
-				Config: testAccAzureRMContainerRegistry_geoReplicationUpdateWithNoLocation_basic(ri, testLocation()),
+				Config: testAccAzureRMContainerRegistry_geoReplicationUpdateWithNoLocation_basic(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(dsn, "sku", skuBasic),
 					testCheckAzureRMContainerRegistryExists(dsn),
@@ -324,11 +270,11 @@ func TestAccAzureRMContainerRegistry_geoReplication(t *testing.T) {
 func TestAccAzureRMContainerRegistry_networkAccessProfileIp(t *testing.T) {
 	rn := "azurerm_container_registry.test"
 	ri := tf.AccRandTimeInt()
-	l := testLocation()
+	l := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -351,11 +297,11 @@ func TestAccAzureRMContainerRegistry_networkAccessProfileIp(t *testing.T) {
 func TestAccAzureRMContainerRegistry_networkAccessProfileIp_update(t *testing.T) {
 	rn := "azurerm_container_registry.test"
 	ri := tf.AccRandTimeInt()
-	l := testLocation()
+	l := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -390,11 +336,11 @@ func TestAccAzureRMContainerRegistry_networkAccessProfileIp_update(t *testing.T)
 func TestAccAzureRMContainerRegistry_networkAccessProfileVnet(t *testing.T) {
 	rn := "azurerm_container_registry.test"
 	ri := tf.AccRandTimeInt()
-	l := testLocation()
+	l := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -415,8 +361,8 @@ func TestAccAzureRMContainerRegistry_networkAccessProfileVnet(t *testing.T) {
 }
 
 func testCheckAzureRMContainerRegistryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).containers.RegistriesClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	conn := acceptance.AzureProvider.Meta().(*clients.Client).Containers.RegistriesClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_container_registry" {
@@ -453,8 +399,8 @@ func testCheckAzureRMContainerRegistryExists(resourceName string) resource.TestC
 			return fmt.Errorf("Bad: no resource group found in state for Container Registry: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).containers.RegistriesClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		conn := acceptance.AzureProvider.Meta().(*clients.Client).Containers.RegistriesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, name)
 		if err != nil {
@@ -483,8 +429,8 @@ func testCheckAzureRMContainerRegistryGeoreplications(resourceName string, sku s
 			return fmt.Errorf("Bad: no resource group found in state for Container Registry: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).containers.ReplicationsClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		conn := acceptance.AzureProvider.Meta().(*clients.Client).Containers.ReplicationsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := conn.List(ctx, resourceGroup, name)
 		if err != nil {
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -9,81 +9,18 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
-func TestAccAzureRMContainerService_orchestrationPlatformValidation(t *testing.T) {
-	cases := []struct {
-		Value    string
-		ErrCount int
-	}{
-		{Value: "DCOS", ErrCount: 0},
-		{Value: "Kubernetes", ErrCount: 0},
-		{Value: "Swarm", ErrCount: 0},
-		{Value: "Mesos", ErrCount: 1},
-	}
-
-	for _, tc := range cases {
-		_, errors := validateArmContainerServiceOrchestrationPlatform(tc.Value, "azurerm_container_service")
-
-		if len(errors) != tc.ErrCount {
-			t.Fatalf("Expected the Azure RM Container Service Orchestration Platform to trigger a validation error for '%s'", tc.Value)
-		}
-	}
-}
-
-func TestAccAzureRMContainerService_masterProfileCountValidation(t *testing.T) {
-	cases := []struct {
-		Value    int
-		ErrCount int
-	}{
-		{Value: 0, ErrCount: 1},
-		{Value: 1, ErrCount: 0},
-		{Value: 2, ErrCount: 1},
-		{Value: 3, ErrCount: 0},
-		{Value: 4, ErrCount: 1},
-		{Value: 5, ErrCount: 0},
-		{Value: 6, ErrCount: 1},
-	}
-
-	for _, tc := range cases {
-		_, errors := validateArmContainerServiceMasterProfileCount(tc.Value, "azurerm_container_service")
-
-		if len(errors) != tc.ErrCount {
-			t.Fatalf("Expected the Azure RM Container Service Master Profile Count to trigger a validation error for '%d'", tc.Value)
-		}
-	}
-}
-
-func TestAccAzureRMContainerService_agentProfilePoolCountValidation(t *testing.T) {
-	cases := []struct {
-		Value    int
-		ErrCount int
-	}{
-		{Value: 0, ErrCount: 1},
-		{Value: 1, ErrCount: 0},
-		{Value: 2, ErrCount: 0},
-		{Value: 99, ErrCount: 0},
-		{Value: 100, ErrCount: 0},
-		{Value: 101, ErrCount: 1},
-	}
-
-	for _, tc := range cases {
-		_, errors := validateArmContainerServiceAgentPoolProfileCount(tc.Value, "azurerm_container_service")
-
-		if len(errors) != tc.ErrCount {
-			t.Fatalf("Expected the Azure RM Container Service Agent Pool Profile Count to trigger a validation error for '%d'", tc.Value)
-		}
-	}
-}
-
 func TestAccAzureRMContainerService_dcosBasic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMContainerService_dcosBasic(ri, testLocation())
+	config := testAccAzureRMContainerService_dcosBasic(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMContainerServiceDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -103,11 +40,11 @@ func TestAccAzureRMContainerService_requiresImport(t *testing.T) {
 	}
 
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMContainerServiceDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -118,7 +55,7 @@ func TestAccAzureRMContainerService_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMContainerService_requiresImport(ri, location),
-				ExpectError: testRequiresImportError("azurerm_container_service"),
+				ExpectError: acceptance.RequiresImportError("azurerm_container_service"),
 			},
 		},
 	})
@@ -128,11 +65,11 @@ func TestAccAzureRMContainerService_kubernetesBasic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	clientId := os.Getenv("ARM_CLIENT_ID")
 	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
-	config := testAccAzureRMContainerService_kubernetesBasic(ri, clientId, clientSecret, testLocation())
+	config := testAccAzureRMContainerService_kubernetesBasic(ri, clientId, clientSecret, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMContainerServiceDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -149,11 +86,11 @@ func TestAccAzureRMContainerService_kubernetesComplete(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	clientId := os.Getenv("ARM_CLIENT_ID")
 	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
-	config := testAccAzureRMContainerService_kubernetesComplete(ri, clientId, clientSecret, testLocation())
+	config := testAccAzureRMContainerService_kubernetesComplete(ri, clientId, clientSecret, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMContainerServiceDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -168,11 +105,11 @@ func TestAccAzureRMContainerService_kubernetesComplete(t *testing.T) {
 
 func TestAccAzureRMContainerService_swarmBasic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMContainerService_swarmBasic(ri, testLocation())
+	config := testAccAzureRMContainerService_swarmBasic(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMContainerServiceDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -411,8 +348,8 @@ func testCheckAzureRMContainerServiceExists(resourceName string) resource.TestCh
 			return fmt.Errorf("Bad: no resource group found in state for Container Service Instance: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).containers.ServicesClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		conn := acceptance.AzureProvider.Meta().(*clients.Client).Containers.ServicesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, name)
 		if err != nil {
@@ -428,7 +365,7 @@ func testCheckAzureRMContainerServiceExists(resourceName string) resource.TestCh
 }
 
 func testCheckAzureRMContainerServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).containers.ServicesClient
+	conn := acceptance.AzureProvider.Meta().(*clients.Client).Containers.ServicesClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_container_service" {
@@ -437,7 +374,7 @@ func testCheckAzureRMContainerServiceDestroy(s *terraform.State) error {
 
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, name)
 
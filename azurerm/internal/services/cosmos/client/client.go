@@ -1,4 +1,4 @@
-package cosmos
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -6,16 +6,17 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 )
 
 func TestAccAzureRMCosmosDBAccount_failover_boundedStaleness(t *testing.T) {
 	resourceName := "azurerm_cosmosdb_account.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMCosmosDBAccount_failover_boundedStaleness(ri, testLocation())
+	config := testAccAzureRMCosmosDBAccount_failover_boundedStaleness(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMCosmosDBAccountDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -31,11 +32,11 @@ func TestAccAzureRMCosmosDBAccount_failover_boundedStaleness(t *testing.T) {
 
 func TestAccAzureRMCosmosDBAccount_failover_boundedStalenessComplete(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMCosmosDBAccount_failover_boundedStalenessComplete(ri, testLocation())
+	config := testAccAzureRMCosmosDBAccount_failover_boundedStalenessComplete(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMCosmosDBAccountDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -50,11 +51,11 @@ func TestAccAzureRMCosmosDBAccount_failover_boundedStalenessComplete(t *testing.
 
 func TestAccAzureRMCosmosDBAccount_failover_eventualConsistency(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMCosmosDBAccount_failover_eventualConsistency(ri, testLocation())
+	config := testAccAzureRMCosmosDBAccount_failover_eventualConsistency(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMCosmosDBAccountDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -70,11 +71,11 @@ func TestAccAzureRMCosmosDBAccount_failover_eventualConsistency(t *testing.T) {
 func TestAccAzureRMCosmosDBAccount_failover_mongoDB(t *testing.T) {
 	resourceName := "azurerm_cosmosdb_account.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMCosmosDBAccount_failover_mongoDB(ri, testLocation())
+	config := testAccAzureRMCosmosDBAccount_failover_mongoDB(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMCosmosDBAccountDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -90,11 +91,11 @@ func TestAccAzureRMCosmosDBAccount_failover_mongoDB(t *testing.T) {
 
 func TestAccAzureRMCosmosDBAccount_failover_session(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMCosmosDBAccount_failover_session(ri, testLocation())
+	config := testAccAzureRMCosmosDBAccount_failover_session(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMCosmosDBAccountDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -109,11 +110,11 @@ func TestAccAzureRMCosmosDBAccount_failover_session(t *testing.T) {
 
 func TestAccAzureRMCosmosDBAccount_failover_strong(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMCosmosDBAccount_failover_strong(ri, testLocation())
+	config := testAccAzureRMCosmosDBAccount_failover_strong(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMCosmosDBAccountDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -128,11 +129,11 @@ func TestAccAzureRMCosmosDBAccount_failover_strong(t *testing.T) {
 
 func TestAccAzureRMCosmosDBAccount_failover_geoReplicated(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMCosmosDBAccount_failover_geoReplicated(ri, testLocation(), testAltLocation())
+	config := testAccAzureRMCosmosDBAccount_failover_geoReplicated(ri, acceptance.Location(), acceptance.AltLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMCosmosDBAccountDestroy,
 		Steps: []resource.TestStep{
 			{
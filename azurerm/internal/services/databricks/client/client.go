@@ -1,4 +1,4 @@
-package databricks
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/databricks/mgmt/2018-04-01/databricks"
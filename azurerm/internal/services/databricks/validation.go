@@ -0,0 +1,35 @@
+package databricks
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func ValidateWorkspaceName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q type to be string", k))
+		return warnings, errors
+	}
+
+	// Cannot be empty
+	if len(v) == 0 {
+		errors = append(errors, fmt.Errorf("%q cannot be an empty string: %q", k, v))
+		return warnings, errors
+	}
+
+	// First, second, and last characters must be a letter or number with a total length between 3 to 64 characters
+	// NOTE: Restricted name to 30 characters because that is the restriction in Azure Portal even though the API supports 64 characters
+	if !regexp.MustCompile("^[a-zA-Z0-9]{2}[-a-zA-Z0-9]{0,27}[a-zA-Z0-9]{1}$").MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be 3 - 30 characters in length", k))
+		errors = append(errors, fmt.Errorf("%q first, second, and last characters must be a letter or number", k))
+		errors = append(errors, fmt.Errorf("%q can only contain letters, numbers, and hyphens", k))
+	}
+
+	// No consecutive hyphens
+	if regexp.MustCompile("(--)").MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must not contain any consecutive hyphens", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,60 @@
+package databricks
+
+import "testing"
+
+func TestAzureRMDatabrickWorkspaceName(t *testing.T) {
+	cases := []struct {
+		Value       string
+		ShouldError bool
+	}{
+		{
+			Value:       "hello",
+			ShouldError: false,
+		},
+		{
+			Value:       "hello123there",
+			ShouldError: false,
+		},
+		{
+			Value:       "hello-1-2-3-there",
+			ShouldError: false,
+		},
+		{
+			Value:       "hello-1-2-3-",
+			ShouldError: true,
+		},
+		{
+			Value:       "-hello-1-2-3",
+			ShouldError: true,
+		},
+		{
+			Value:       "hello!there",
+			ShouldError: true,
+		},
+		{
+			Value:       "hello--there",
+			ShouldError: true,
+		},
+		{
+			Value:       "!hellothere",
+			ShouldError: true,
+		},
+		{
+			Value:       "hellothere!",
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidateWorkspaceName(tc.Value, "test")
+
+		hasErrors := len(errors) > 0
+		if hasErrors && !tc.ShouldError {
+			t.Fatalf("Expected no errors but got %d for %q", len(errors), tc.Value)
+		}
+
+		if !hasErrors && tc.ShouldError {
+			t.Fatalf("Expected no errors but got %d for %q", len(errors), tc.Value)
+		}
+	}
+}
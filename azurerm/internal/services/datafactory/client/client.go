@@ -1,4 +1,4 @@
-package datafactory
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
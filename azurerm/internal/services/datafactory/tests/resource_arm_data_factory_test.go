@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -8,17 +8,19 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMDataFactory_basic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMDataFactory_basic(ri, testLocation())
+	config := testAccAzureRMDataFactory_basic(ri, acceptance.Location())
 	resourceName := "azurerm_data_factory.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMDataFactoryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -38,12 +40,12 @@ func TestAccAzureRMDataFactory_basic(t *testing.T) {
 
 func TestAccAzureRMDataFactory_tags(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMDataFactory_tags(ri, testLocation())
+	config := testAccAzureRMDataFactory_tags(ri, acceptance.Location())
 	resourceName := "azurerm_data_factory.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMDataFactoryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -65,13 +67,13 @@ func TestAccAzureRMDataFactory_tags(t *testing.T) {
 
 func TestAccAzureRMDataFactory_tagsUpdated(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMDataFactory_tags(ri, testLocation())
-	updatedConfig := testAccAzureRMDataFactory_tagsUpdated(ri, testLocation())
+	config := testAccAzureRMDataFactory_tags(ri, acceptance.Location())
+	updatedConfig := testAccAzureRMDataFactory_tagsUpdated(ri, acceptance.Location())
 	resourceName := "azurerm_data_factory.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMDataFactoryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -102,12 +104,12 @@ func TestAccAzureRMDataFactory_tagsUpdated(t *testing.T) {
 
 func TestAccAzureRMDataFactory_identity(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMDataFactory_identity(ri, testLocation())
+	config := testAccAzureRMDataFactory_identity(ri, acceptance.Location())
 	resourceName := "azurerm_data_factory.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMDataFactoryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -131,12 +133,12 @@ func TestAccAzureRMDataFactory_identity(t *testing.T) {
 
 func TestAccAzureRMDataFactory_disappears(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMDataFactory_basic(ri, testLocation())
+	config := testAccAzureRMDataFactory_basic(ri, acceptance.Location())
 	resourceName := "azurerm_data_factory.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMDataFactoryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -153,13 +155,13 @@ func TestAccAzureRMDataFactory_disappears(t *testing.T) {
 
 func TestAccAzureRMDataFactory_github(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMDataFactory_github(ri, testLocation())
-	config2 := testAccAzureRMDataFactory_githubUpdated(ri, testLocation())
+	config := testAccAzureRMDataFactory_github(ri, acceptance.Location())
+	config2 := testAccAzureRMDataFactory_githubUpdated(ri, acceptance.Location())
 	resourceName := "azurerm_data_factory.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMDataFactoryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -207,8 +209,8 @@ func testCheckAzureRMDataFactoryExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for Data Factory: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).dataFactory.FactoriesClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).DataFactory.FactoriesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name, "")
 		if err != nil {
@@ -237,8 +239,8 @@ func testCheckAzureRMDataFactoryDisappears(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for Data Factory: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).dataFactory.FactoriesClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).DataFactory.FactoriesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := client.Delete(ctx, resourceGroup, name)
 		if err != nil {
@@ -252,8 +254,8 @@ func testCheckAzureRMDataFactoryDisappears(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMDataFactoryDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).dataFactory.FactoriesClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).DataFactory.FactoriesClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_data_factory" {
@@ -1,4 +1,4 @@
-package datalake
+package client
 
 import (
 	analytics "github.com/Azure/azure-sdk-for-go/services/datalake/analytics/mgmt/2016-11-01/account"
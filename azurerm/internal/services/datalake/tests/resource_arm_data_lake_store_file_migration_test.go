@@ -1,30 +1,36 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datalake"
 )
 
 // NOTE: this is intentionally an acceptance test (and we're not explicitly setting the env)
 // as we want to run this depending on the cloud we're in.
 func TestAccAzureRMDataLakeStoreFileMigrateState(t *testing.T) {
-	config := testGetAzureConfig(t)
+	config := acceptance.GetAuthConfig(t)
 	if config == nil {
 		t.SkipNow()
 		return
 	}
 
-	client, err := getArmClient(config, false, "", true)
+	client, err := clients.Build(clients.ClientBuilder{
+		AuthConfig:                  config,
+		DisableCorrelationRequestID: true,
+	})
 	if err != nil {
 		t.Fatal(fmt.Errorf("Error building ARM Client: %+v", err))
 		return
 	}
 
-	client.StopContext = testAccProvider.StopContext()
+	client.StopContext = acceptance.AzureProvider.StopContext()
 
-	filesClient := client.datalake.StoreFilesClient
+	filesClient := client.Datalake.StoreFilesClient
 
 	cases := map[string]struct {
 		StateVersion       int
@@ -50,7 +56,7 @@ func TestAccAzureRMDataLakeStoreFileMigrateState(t *testing.T) {
 			ID:         tc.ID,
 			Attributes: tc.InputAttributes,
 		}
-		is, err := resourceDataLakeStoreFileMigrateState(tc.StateVersion, is, client)
+		is, err := datalake.ResourceDataLakeStoreFileMigrateState(tc.StateVersion, is, client)
 
 		if err != nil {
 			t.Fatalf("bad: %s, err: %#v", tn, err)
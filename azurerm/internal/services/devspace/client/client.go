@@ -1,4 +1,4 @@
-package devspace
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/devspaces/mgmt/2018-06-01-preview/devspaces"
@@ -1,4 +1,4 @@
-package devtestlabs
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
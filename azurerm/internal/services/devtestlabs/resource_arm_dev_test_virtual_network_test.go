@@ -0,0 +1,31 @@
+package devtestlabs
+
+import "testing"
+
+func TestValidateDevTestVirtualNetworkName(t *testing.T) {
+	validNames := []string{
+		"valid-name",
+		"valid02-name",
+		"validName1",
+		"-validname1",
+		"valid_name",
+		"double-hyphen--valid",
+	}
+	for _, v := range validNames {
+		_, errors := validateDevTestVirtualNetworkName()(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Dev Test Virtual Network Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"invalid!",
+		"!@£",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateDevTestVirtualNetworkName()(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Dev Test Virtual Network Name", v)
+		}
+	}
+}
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -8,45 +8,19 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
-func TestValidateDevTestVirtualNetworkName(t *testing.T) {
-	validNames := []string{
-		"valid-name",
-		"valid02-name",
-		"validName1",
-		"-validname1",
-		"valid_name",
-		"double-hyphen--valid",
-	}
-	for _, v := range validNames {
-		_, errors := validateDevTestVirtualNetworkName()(v, "example")
-		if len(errors) != 0 {
-			t.Fatalf("%q should be a valid Dev Test Virtual Network Name: %q", v, errors)
-		}
-	}
-
-	invalidNames := []string{
-		"invalid!",
-		"!@£",
-	}
-	for _, v := range invalidNames {
-		_, errors := validateDevTestVirtualNetworkName()(v, "name")
-		if len(errors) == 0 {
-			t.Fatalf("%q should be an invalid Dev Test Virtual Network Name", v)
-		}
-	}
-}
-
 func TestAccAzureRMDevTestVirtualNetwork_basic(t *testing.T) {
 	resourceName := "azurerm_dev_test_virtual_network.test"
 	rInt := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMDevTestVirtualNetworkDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -73,11 +47,11 @@ func TestAccAzureRMDevTestVirtualNetwork_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_dev_test_virtual_network.test"
 	rInt := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMDevTestVirtualNetworkDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -89,7 +63,7 @@ func TestAccAzureRMDevTestVirtualNetwork_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMDevTestVirtualNetwork_requiresImport(rInt, location),
-				ExpectError: testRequiresImportError("azurerm_dev_test_virtual_network"),
+				ExpectError: acceptance.RequiresImportError("azurerm_dev_test_virtual_network"),
 			},
 		},
 	})
@@ -98,11 +72,11 @@ func TestAccAzureRMDevTestVirtualNetwork_requiresImport(t *testing.T) {
 func TestAccAzureRMDevTestVirtualNetwork_subnet(t *testing.T) {
 	resourceName := "azurerm_dev_test_virtual_network.test"
 	rInt := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMDevTestVirtualNetworkDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -136,8 +110,8 @@ func testCheckAzureRMDevTestVirtualNetworkExists(resourceName string) resource.T
 		labName := rs.Primary.Attributes["lab_name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		conn := testAccProvider.Meta().(*ArmClient).devTestLabs.VirtualNetworksClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		conn := acceptance.AzureProvider.Meta().(*clients.Client).DevTestLabs.VirtualNetworksClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, labName, virtualNetworkName, "")
 		if err != nil {
@@ -153,8 +127,8 @@ func testCheckAzureRMDevTestVirtualNetworkExists(resourceName string) resource.T
 }
 
 func testCheckAzureRMDevTestVirtualNetworkDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).devTestLabs.VirtualNetworksClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	conn := acceptance.AzureProvider.Meta().(*clients.Client).DevTestLabs.VirtualNetworksClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_dev_test_virtual_network" {
@@ -1,4 +1,4 @@
-package dns
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
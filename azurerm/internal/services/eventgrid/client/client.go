@@ -1,4 +1,4 @@
-package eventgrid
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/eventgrid/mgmt/2018-09-15-preview/eventgrid"
@@ -1,4 +1,4 @@
-package eventhub
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
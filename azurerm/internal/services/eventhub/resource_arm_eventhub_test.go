@@ -0,0 +1,159 @@
+package eventhub
+
+import "testing"
+
+func TestAccAzureRMEventHubPartitionCount_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    0,
+			ErrCount: 1,
+		},
+		{
+			Value:    1,
+			ErrCount: 0,
+		},
+		{
+			Value:    2,
+			ErrCount: 0,
+		},
+		{
+			Value:    3,
+			ErrCount: 0,
+		},
+		{
+			Value:    21,
+			ErrCount: 0,
+		},
+		{
+			Value:    32,
+			ErrCount: 0,
+		},
+		{
+			Value:    33,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateEventHubPartitionCount(tc.Value, "azurerm_eventhub")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM EventHub Partition Count to trigger a validation error")
+		}
+	}
+}
+
+func TestAccAzureRMEventHubMessageRetentionCount_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    0,
+			ErrCount: 1,
+		}, {
+			Value:    1,
+			ErrCount: 0,
+		}, {
+			Value:    2,
+			ErrCount: 0,
+		}, {
+			Value:    3,
+			ErrCount: 0,
+		}, {
+			Value:    4,
+			ErrCount: 0,
+		}, {
+			Value:    5,
+			ErrCount: 0,
+		}, {
+			Value:    6,
+			ErrCount: 0,
+		}, {
+			Value:    7,
+			ErrCount: 0,
+		}, {
+			Value:    8,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateEventHubMessageRetentionCount(tc.Value, "azurerm_eventhub")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM EventHub Message Retention Count to trigger a validation error")
+		}
+	}
+}
+
+func TestAccAzureRMEventHubArchiveNameFormat_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 9,
+		},
+		{
+			Value:    "Prod_{EventHub}/{Namespace}\\{PartitionId}_{Year}_{Month}/{Day}/{Hour}/{Minute}/{Second}",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Prod_{Eventub}/{Namespace}\\{PartitionId}_{Year}_{Month}/{Day}/{Hour}/{Minute}/{Second}",
+			ErrCount: 1,
+		},
+		{
+			Value:    "{Namespace}\\{PartitionId}_{Year}_{Month}/{Day}/{Hour}/{Minute}/{Second}",
+			ErrCount: 1,
+		},
+		{
+			Value:    "{Namespace}\\{PartitionId}_{Year}_{Month}/{Day}/{Hour}/{Minute}/{Second}",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Prod_{EventHub}/{PartitionId}_{Year}_{Month}/{Day}/{Hour}/{Minute}/{Second}",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Prod_{EventHub}/{Namespace}\\{Year}_{Month}/{Day}/{Hour}/{Minute}/{Second}",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Prod_{EventHub}/{Namespace}\\{PartitionId}_{Month}/{Day}/{Hour}/{Minute}/{Second}",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Prod_{EventHub}/{Namespace}\\{PartitionId}_{Year}/{Day}/{Hour}/{Minute}/{Second}",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Prod_{EventHub}/{Namespace}\\{PartitionId}_{Year}_{Month}/{Hour}/{Minute}/{Second}",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Prod_{EventHub}/{Namespace}\\{PartitionId}_{Year}_{Month}/{Day}/{Minute}/{Second}",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Prod_{EventHub}/{Namespace}\\{PartitionId}_{Year}_{Month}/{Day}/{Hour}/{Second}",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Prod_{EventHub}/{Namespace}\\{PartitionId}_{Year}_{Month}/{Day}/{Hour}/{Minute}",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateEventHubArchiveNameFormat(tc.Value, "azurerm_eventhub")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %q to trigger a validation error", tc.Value)
+		}
+	}
+}
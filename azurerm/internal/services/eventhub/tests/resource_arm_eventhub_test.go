@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -11,176 +11,22 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
-func TestAccAzureRMEventHubPartitionCount_validation(t *testing.T) {
-	cases := []struct {
-		Value    int
-		ErrCount int
-	}{
-		{
-			Value:    0,
-			ErrCount: 1,
-		},
-		{
-			Value:    1,
-			ErrCount: 0,
-		},
-		{
-			Value:    2,
-			ErrCount: 0,
-		},
-		{
-			Value:    3,
-			ErrCount: 0,
-		},
-		{
-			Value:    21,
-			ErrCount: 0,
-		},
-		{
-			Value:    32,
-			ErrCount: 0,
-		},
-		{
-			Value:    33,
-			ErrCount: 1,
-		},
-	}
-
-	for _, tc := range cases {
-		_, errors := validateEventHubPartitionCount(tc.Value, "azurerm_eventhub")
-
-		if len(errors) != tc.ErrCount {
-			t.Fatalf("Expected the Azure RM EventHub Partition Count to trigger a validation error")
-		}
-	}
-}
-
-func TestAccAzureRMEventHubMessageRetentionCount_validation(t *testing.T) {
-	cases := []struct {
-		Value    int
-		ErrCount int
-	}{
-		{
-			Value:    0,
-			ErrCount: 1,
-		}, {
-			Value:    1,
-			ErrCount: 0,
-		}, {
-			Value:    2,
-			ErrCount: 0,
-		}, {
-			Value:    3,
-			ErrCount: 0,
-		}, {
-			Value:    4,
-			ErrCount: 0,
-		}, {
-			Value:    5,
-			ErrCount: 0,
-		}, {
-			Value:    6,
-			ErrCount: 0,
-		}, {
-			Value:    7,
-			ErrCount: 0,
-		}, {
-			Value:    8,
-			ErrCount: 1,
-		},
-	}
-
-	for _, tc := range cases {
-		_, errors := validateEventHubMessageRetentionCount(tc.Value, "azurerm_eventhub")
-
-		if len(errors) != tc.ErrCount {
-			t.Fatalf("Expected the Azure RM EventHub Message Retention Count to trigger a validation error")
-		}
-	}
-}
-
-func TestAccAzureRMEventHubArchiveNameFormat_validation(t *testing.T) {
-	cases := []struct {
-		Value    string
-		ErrCount int
-	}{
-		{
-			Value:    "",
-			ErrCount: 9,
-		},
-		{
-			Value:    "Prod_{EventHub}/{Namespace}\\{PartitionId}_{Year}_{Month}/{Day}/{Hour}/{Minute}/{Second}",
-			ErrCount: 0,
-		},
-		{
-			Value:    "Prod_{Eventub}/{Namespace}\\{PartitionId}_{Year}_{Month}/{Day}/{Hour}/{Minute}/{Second}",
-			ErrCount: 1,
-		},
-		{
-			Value:    "{Namespace}\\{PartitionId}_{Year}_{Month}/{Day}/{Hour}/{Minute}/{Second}",
-			ErrCount: 1,
-		},
-		{
-			Value:    "{Namespace}\\{PartitionId}_{Year}_{Month}/{Day}/{Hour}/{Minute}/{Second}",
-			ErrCount: 1,
-		},
-		{
-			Value:    "Prod_{EventHub}/{PartitionId}_{Year}_{Month}/{Day}/{Hour}/{Minute}/{Second}",
-			ErrCount: 1,
-		},
-		{
-			Value:    "Prod_{EventHub}/{Namespace}\\{Year}_{Month}/{Day}/{Hour}/{Minute}/{Second}",
-			ErrCount: 1,
-		},
-		{
-			Value:    "Prod_{EventHub}/{Namespace}\\{PartitionId}_{Month}/{Day}/{Hour}/{Minute}/{Second}",
-			ErrCount: 1,
-		},
-		{
-			Value:    "Prod_{EventHub}/{Namespace}\\{PartitionId}_{Year}/{Day}/{Hour}/{Minute}/{Second}",
-			ErrCount: 1,
-		},
-		{
-			Value:    "Prod_{EventHub}/{Namespace}\\{PartitionId}_{Year}_{Month}/{Hour}/{Minute}/{Second}",
-			ErrCount: 1,
-		},
-		{
-			Value:    "Prod_{EventHub}/{Namespace}\\{PartitionId}_{Year}_{Month}/{Day}/{Minute}/{Second}",
-			ErrCount: 1,
-		},
-		{
-			Value:    "Prod_{EventHub}/{Namespace}\\{PartitionId}_{Year}_{Month}/{Day}/{Hour}/{Second}",
-			ErrCount: 1,
-		},
-		{
-			Value:    "Prod_{EventHub}/{Namespace}\\{PartitionId}_{Year}_{Month}/{Day}/{Hour}/{Minute}",
-			ErrCount: 1,
-		},
-	}
-
-	for _, tc := range cases {
-		_, errors := validateEventHubArchiveNameFormat(tc.Value, "azurerm_eventhub")
-
-		if len(errors) != tc.ErrCount {
-			t.Fatalf("Expected %q to trigger a validation error", tc.Value)
-		}
-	}
-}
-
 func TestAccAzureRMEventHub_basic(t *testing.T) {
 	resourceName := "azurerm_eventhub.test"
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMEventHubDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMEventHub_basic(ri, testLocation(), 2),
+				Config: testAccAzureRMEventHub_basic(ri, acceptance.Location(), 2),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMEventHubExists(resourceName),
 				),
@@ -199,12 +45,12 @@ func TestAccAzureRMEventHub_basicOnePartition(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMEventHubDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMEventHub_basic(ri, testLocation(), 1),
+				Config: testAccAzureRMEventHub_basic(ri, acceptance.Location(), 1),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMEventHubExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "partition_count", "1"),
@@ -228,11 +74,11 @@ func TestAccAzureRMEventHub_requiresImport(t *testing.T) {
 	resourceName := "azurerm_eventhub.test"
 	ri := tf.AccRandTimeInt()
 
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMEventHubDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -243,7 +89,7 @@ func TestAccAzureRMEventHub_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMEventHub_requiresImport(ri, location),
-				ExpectError: testRequiresImportError("azurerm_eventhub"),
+				ExpectError: acceptance.RequiresImportError("azurerm_eventhub"),
 			},
 		},
 	})
@@ -252,12 +98,12 @@ func TestAccAzureRMEventHub_requiresImport(t *testing.T) {
 func TestAccAzureRMEventHub_partitionCountUpdate(t *testing.T) {
 	resourceName := "azurerm_eventhub.test"
 	ri := tf.AccRandTimeInt()
-	preConfig := testAccAzureRMEventHub_basic(ri, testLocation(), 2)
-	postConfig := testAccAzureRMEventHub_partitionCountUpdate(ri, testLocation())
+	preConfig := testAccAzureRMEventHub_basic(ri, acceptance.Location(), 2)
+	postConfig := testAccAzureRMEventHub_partitionCountUpdate(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMEventHubDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -283,12 +129,12 @@ func TestAccAzureRMEventHub_standard(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMEventHubDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMEventHub_standard(ri, testLocation()),
+				Config: testAccAzureRMEventHub_standard(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMEventHubExists(resourceName),
 				),
@@ -308,12 +154,12 @@ func TestAccAzureRMEventHub_captureDescription(t *testing.T) {
 	rs := acctest.RandString(5)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMEventHubDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMEventHub_captureDescription(ri, rs, testLocation(), true),
+				Config: testAccAzureRMEventHub_captureDescription(ri, rs, acceptance.Location(), true),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMEventHubExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "capture_description.0.enabled", "true"),
@@ -333,14 +179,14 @@ func TestAccAzureRMEventHub_captureDescriptionDisabled(t *testing.T) {
 	resourceName := "azurerm_eventhub.test"
 	ri := tf.AccRandTimeInt()
 	rs := acctest.RandString(5)
-	location := testLocation()
+	location := acceptance.Location()
 
 	config := testAccAzureRMEventHub_captureDescription(ri, rs, location, true)
 	updatedConfig := testAccAzureRMEventHub_captureDescription(ri, rs, location, false)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMEventHubDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -364,12 +210,12 @@ func TestAccAzureRMEventHub_captureDescriptionDisabled(t *testing.T) {
 func TestAccAzureRMEventHub_messageRetentionUpdate(t *testing.T) {
 	resourceName := "azurerm_eventhub.test"
 	ri := tf.AccRandTimeInt()
-	preConfig := testAccAzureRMEventHub_standard(ri, testLocation())
-	postConfig := testAccAzureRMEventHub_messageRetentionUpdate(ri, testLocation())
+	preConfig := testAccAzureRMEventHub_standard(ri, acceptance.Location())
+	postConfig := testAccAzureRMEventHub_messageRetentionUpdate(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMEventHubDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -391,8 +237,8 @@ func TestAccAzureRMEventHub_messageRetentionUpdate(t *testing.T) {
 }
 
 func testCheckAzureRMEventHubDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).eventhub.EventHubsClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	conn := acceptance.AzureProvider.Meta().(*clients.Client).Eventhub.EventHubsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_eventhub" {
@@ -432,8 +278,8 @@ func testCheckAzureRMEventHubExists(resourceName string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for Event Hub: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).eventhub.EventHubsClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		conn := acceptance.AzureProvider.Meta().(*clients.Client).Eventhub.EventHubsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, namespaceName, name)
 		if err != nil {
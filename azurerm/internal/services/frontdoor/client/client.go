@@ -1,4 +1,4 @@
-package frontdoor
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/frontdoor/mgmt/2019-04-01/frontdoor"
@@ -1,4 +1,4 @@
-package azurerm
+package frontdoor
 
 import (
 	"fmt"
@@ -11,8 +11,9 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	afd "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/frontdoor"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -76,7 +77,7 @@ func resourceArmFrontDoorFirewallPolicy() *schema.Resource {
 			"custom_block_response_body": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: afd.ValidateCustomBlockResponseBody,
+				ValidateFunc: ValidateCustomBlockResponseBody,
 			},
 
 			"custom_rule": {
@@ -302,15 +303,16 @@ func resourceArmFrontDoorFirewallPolicy() *schema.Resource {
 }
 
 func resourceArmFrontDoorFirewallPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).frontdoor.FrontDoorsPolicyClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Frontdoor.FrontDoorsPolicyClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing args for Front Door Firewall Policy")
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	if requireResourcesToBeImported {
+	if meta.(*clients.Client).Features.Resources.RequireImport {
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -333,7 +335,7 @@ func resourceArmFrontDoorFirewallPolicyCreateUpdate(d *schema.ResourceData, meta
 	customBlockResponseBody := d.Get("custom_block_response_body").(string)
 	customRules := d.Get("custom_rule").([]interface{})
 	managedRules := d.Get("managed_rule").([]interface{})
-	tags := d.Get("tags").(map[string]interface{})
+	t := d.Get("tags").(map[string]interface{})
 
 	frontdoorWebApplicationFirewallPolicy := frontdoor.WebApplicationFirewallPolicy{
 		Name:     utils.String(name),
@@ -346,7 +348,7 @@ func resourceArmFrontDoorFirewallPolicyCreateUpdate(d *schema.ResourceData, meta
 			CustomRules:  expandArmFrontDoorFirewallCustomRules(customRules),
 			ManagedRules: expandArmFrontDoorFirewallManagedRules(managedRules),
 		},
-		Tags: expandTags(tags),
+		Tags: tags.Expand(t),
 	}
 
 	if redirectUrl != "" {
@@ -380,10 +382,11 @@ func resourceArmFrontDoorFirewallPolicyCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceArmFrontDoorFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).frontdoor.FrontDoorsPolicyClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Frontdoor.FrontDoorsPolicyClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -424,7 +427,7 @@ func resourceArmFrontDoorFirewallPolicyRead(d *schema.ResourceData, meta interfa
 			return fmt.Errorf("Error flattening `managed_rule`: %+v", err)
 		}
 
-		if err := d.Set("frontend_endpoint_ids", afd.FlattenFrontendEndpointLinkSlice(properties.FrontendEndpointLinks)); err != nil {
+		if err := d.Set("frontend_endpoint_ids", FlattenFrontendEndpointLinkSlice(properties.FrontendEndpointLinks)); err != nil {
 			return fmt.Errorf("Error flattening `frontend_endpoint_ids`: %+v", err)
 		}
 	}
@@ -433,10 +436,11 @@ func resourceArmFrontDoorFirewallPolicyRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceArmFrontDoorFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).frontdoor.FrontDoorsPolicyClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Frontdoor.FrontDoorsPolicyClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -681,7 +685,7 @@ func flattenArmFrontDoorFirewallMatchConditions(condition *[]frontdoor.MatchCond
 		output["match_variable"] = string(c.MatchVariable)
 		output["operator"] = string(c.Operator)
 		output["match_values"] = utils.FlattenStringSlice(c.MatchValue)
-		output["transforms"] = afd.FlattenTransformSlice(c.Transforms)
+		output["transforms"] = FlattenTransformSlice(c.Transforms)
 
 		if v := c.Selector; v != nil {
 			output["selector"] = *v
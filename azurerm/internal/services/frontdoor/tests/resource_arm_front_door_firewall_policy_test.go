@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -7,17 +7,19 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMFrontDoorFirewallPolicy_basic(t *testing.T) {
 	resourceName := "azurerm_frontdoor_firewall_policy.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMFrontDoorFirewallPolicy_basic(ri, testLocation())
+	config := testAccAzureRMFrontDoorFirewallPolicy_basic(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMFrontDoorFirewallPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -40,12 +42,12 @@ func TestAccAzureRMFrontDoorFirewallPolicy_basic(t *testing.T) {
 func TestAccAzureRMFrontDoorFirewallPolicy_update(t *testing.T) {
 	resourceName := "azurerm_frontdoor_firewall_policy.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMFrontDoorFirewallPolicy_update(ri, "", testLocation())
-	configUpdate := testAccAzureRMFrontDoorFirewallPolicy_update(ri, testAccAzureRMFrontDoorFirewallPolicy_updateTemplate(), testLocation())
+	config := testAccAzureRMFrontDoorFirewallPolicy_update(ri, "", acceptance.Location())
+	configUpdate := testAccAzureRMFrontDoorFirewallPolicy_update(ri, testAccAzureRMFrontDoorFirewallPolicy_updateTemplate(), acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMFrontDoorFirewallPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -86,11 +88,11 @@ func TestAccAzureRMFrontDoorFirewallPolicy_update(t *testing.T) {
 func TestAccAzureRMFrontDoorFirewallPolicy_complete(t *testing.T) {
 	resourceName := "azurerm_frontdoor_firewall_policy.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMFrontDoorFirewallPolicy_update(ri, testAccAzureRMFrontDoorFirewallPolicy_updateTemplate(), testLocation())
+	config := testAccAzureRMFrontDoorFirewallPolicy_update(ri, testAccAzureRMFrontDoorFirewallPolicy_updateTemplate(), acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMFrontDoorFirewallPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -126,8 +128,8 @@ func testCheckAzureRMFrontDoorFirewallPolicyExists(resourceName string) resource
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).frontdoor.FrontDoorsPolicyClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Frontdoor.FrontDoorsPolicyClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		if resp, err := client.Get(ctx, resourceGroup, name); err != nil {
 			if utils.ResponseWasNotFound(resp.Response) {
@@ -141,8 +143,8 @@ func testCheckAzureRMFrontDoorFirewallPolicyExists(resourceName string) resource
 }
 
 func testCheckAzureRMFrontDoorFirewallPolicyDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).frontdoor.FrontDoorsPolicyClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Frontdoor.FrontDoorsPolicyClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_frontdoor_firewall_policy" {
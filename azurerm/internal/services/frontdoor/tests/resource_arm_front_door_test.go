@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -16,11 +18,11 @@ func TestAccAzureRMFrontDoor_basic(t *testing.T) {
 	resourceName := "azurerm_frontdoor.test"
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(5))
-	config := testAccAzureRMFrontDoor_basic(ri, rs, testLocation())
+	config := testAccAzureRMFrontDoor_basic(ri, rs, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMFrontDoorDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -71,12 +73,12 @@ func TestAccAzureRMFrontDoor_update(t *testing.T) {
 	resourceName := "azurerm_frontdoor.test"
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(5))
-	config := testAccAzureRMFrontDoor_basic(ri, rs, testLocation())
-	update := testAccAzureRMFrontDoor_complete(ri, rs, testLocation())
+	config := testAccAzureRMFrontDoor_basic(ri, rs, acceptance.Location())
+	update := testAccAzureRMFrontDoor_complete(ri, rs, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMFrontDoorDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -149,11 +151,11 @@ func TestAccAzureRMFrontDoor_complete(t *testing.T) {
 	resourceName := "azurerm_frontdoor.test"
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(5))
-	config := testAccAzureRMFrontDoor_complete(ri, rs, testLocation())
+	config := testAccAzureRMFrontDoor_complete(ri, rs, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMFrontDoorDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -212,11 +214,11 @@ func TestAccAzureRMFrontDoor_waf(t *testing.T) {
 	resourceName := "azurerm_frontdoor.test"
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(5))
-	config := testAccAzureRMFrontDoor_waf(ri, rs, testLocation())
+	config := testAccAzureRMFrontDoor_waf(ri, rs, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMFrontDoorDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -246,8 +248,8 @@ func testCheckAzureRMFrontDoorExists(resourceName string) resource.TestCheckFunc
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).frontdoor.FrontDoorsClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Frontdoor.FrontDoorsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		if resp, err := client.Get(ctx, resourceGroup, name); err != nil {
 			if utils.ResponseWasNotFound(resp.Response) {
@@ -261,8 +263,8 @@ func testCheckAzureRMFrontDoorExists(resourceName string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMFrontDoorDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).frontdoor.FrontDoorsClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Frontdoor.FrontDoorsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_front_door" {
@@ -1,4 +1,4 @@
-package graph
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
@@ -1,4 +1,4 @@
-package hdinsight
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/hdinsight/mgmt/2018-06-01-preview/hdinsight"
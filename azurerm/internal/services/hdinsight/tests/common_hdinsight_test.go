@@ -1,10 +1,12 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -15,8 +17,8 @@ func testCheckAzureRMHDInsightClusterDestroy(terraformResourceName string) func(
 				continue
 			}
 
-			client := testAccProvider.Meta().(*ArmClient).hdinsight.ClustersClient
-			ctx := testAccProvider.Meta().(*ArmClient).StopContext
+			client := acceptance.AzureProvider.Meta().(*clients.Client).HDInsight.ClustersClient
+			ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 			name := rs.Primary.Attributes["name"]
 			resourceGroup := rs.Primary.Attributes["resource_group_name"]
 			resp, err := client.Get(ctx, resourceGroup, name)
@@ -43,8 +45,8 @@ func testCheckAzureRMHDInsightClusterExists(resourceName string) resource.TestCh
 		clusterName := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).hdinsight.ClustersClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).HDInsight.ClustersClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 		resp, err := client.Get(ctx, resourceGroup, clusterName)
 		if err != nil {
 			if utils.ResponseWasNotFound(resp.Response) {
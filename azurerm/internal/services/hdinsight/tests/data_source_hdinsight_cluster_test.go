@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -8,17 +8,18 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 )
 
 func TestAccDataSourceAzureRMHDInsightCluster_hadoop(t *testing.T) {
 	dataSourceName := "data.azurerm_hdinsight_cluster.test"
 	rInt := tf.AccRandTimeInt()
 	rString := strings.ToLower(acctest.RandString(11))
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceHDInsightCluster_hadoop(rInt, rString, location),
@@ -38,11 +39,11 @@ func TestAccDataSourceAzureRMHDInsightCluster_hbase(t *testing.T) {
 	dataSourceName := "data.azurerm_hdinsight_cluster.test"
 	rInt := tf.AccRandTimeInt()
 	rString := strings.ToLower(acctest.RandString(11))
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceHDInsightCluster_hbase(rInt, rString, location),
@@ -62,11 +63,11 @@ func TestAccDataSourceAzureRMHDInsightCluster_interactiveQuery(t *testing.T) {
 	dataSourceName := "data.azurerm_hdinsight_cluster.test"
 	rInt := tf.AccRandTimeInt()
 	rString := strings.ToLower(acctest.RandString(11))
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceHDInsightCluster_interactiveQuery(rInt, rString, location),
@@ -86,11 +87,11 @@ func TestAccDataSourceAzureRMHDInsightCluster_kafka(t *testing.T) {
 	dataSourceName := "data.azurerm_hdinsight_cluster.test"
 	rInt := tf.AccRandTimeInt()
 	rString := strings.ToLower(acctest.RandString(11))
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceHDInsightCluster_kafka(rInt, rString, location),
@@ -110,11 +111,11 @@ func TestAccDataSourceAzureRMHDInsightCluster_mlServices(t *testing.T) {
 	dataSourceName := "data.azurerm_hdinsight_cluster.test"
 	rInt := tf.AccRandTimeInt()
 	rString := strings.ToLower(acctest.RandString(11))
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceHDInsightCluster_mlServices(rInt, rString, location),
@@ -134,11 +135,11 @@ func TestAccDataSourceAzureRMHDInsightCluster_rserver(t *testing.T) {
 	dataSourceName := "data.azurerm_hdinsight_cluster.test"
 	rInt := tf.AccRandTimeInt()
 	rString := strings.ToLower(acctest.RandString(11))
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceHDInsightCluster_rserver(rInt, rString, location),
@@ -158,11 +159,11 @@ func TestAccDataSourceAzureRMHDInsightCluster_spark(t *testing.T) {
 	dataSourceName := "data.azurerm_hdinsight_cluster.test"
 	rInt := tf.AccRandTimeInt()
 	rString := strings.ToLower(acctest.RandString(11))
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceHDInsightCluster_spark(rInt, rString, location),
@@ -182,11 +183,11 @@ func TestAccDataSourceAzureRMHDInsightCluster_storm(t *testing.T) {
 	dataSourceName := "data.azurerm_hdinsight_cluster.test"
 	rInt := tf.AccRandTimeInt()
 	rString := strings.ToLower(acctest.RandString(11))
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceHDInsightCluster_storm(rInt, rString, location),
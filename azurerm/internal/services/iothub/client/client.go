@@ -1,4 +1,4 @@
-package iothub
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/iothub/mgmt/2018-12-01-preview/devices"
@@ -0,0 +1,28 @@
+package iothub
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "IoT Hub"
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azurerm_iot_dps":                     resourceArmIotDPS(),
+		"azurerm_iot_dps_certificate":         resourceArmIotDPSCertificate(),
+		"azurerm_iothub":                      resourceArmIotHub(),
+		"azurerm_iothub_consumer_group":       resourceArmIotHubConsumerGroup(),
+		"azurerm_iothub_shared_access_policy": resourceArmIotHubSharedAccessPolicy(),
+	}
+}
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
@@ -16,12 +18,12 @@ func TestAccAzureRMIotDPSCertificate_basic(t *testing.T) {
 	rInt := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMIotDPSCertificateDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMIotDPSCertificate_basic(rInt, testLocation()),
+				Config: testAccAzureRMIotDPSCertificate_basic(rInt, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMIotDPSCertificateExists(resourceName),
 				),
@@ -46,11 +48,11 @@ func TestAccAzureRMIotDPSCertificate_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_iot_dps_certificate.test"
 	rInt := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMIotDPSCertificateDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -61,7 +63,7 @@ func TestAccAzureRMIotDPSCertificate_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMIotDPSCertificate_requiresImport(rInt, location),
-				ExpectError: testRequiresImportError("azurerm_iotdps"),
+				ExpectError: acceptance.RequiresImportError("azurerm_iotdps"),
 			},
 		},
 	})
@@ -72,12 +74,12 @@ func TestAccAzureRMIotDPSCertificate_update(t *testing.T) {
 	rInt := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMIotDPSCertificateDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMIotDPSCertificate_basic(rInt, testLocation()),
+				Config: testAccAzureRMIotDPSCertificate_basic(rInt, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMIotDPSCertificateExists(resourceName),
 				),
@@ -91,7 +93,7 @@ func TestAccAzureRMIotDPSCertificate_update(t *testing.T) {
 				},
 			},
 			{
-				Config: testAccAzureRMIotDPSCertificate_update(rInt, testLocation()),
+				Config: testAccAzureRMIotDPSCertificate_update(rInt, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMIotDPSCertificateExists(resourceName),
 				),
@@ -109,8 +111,8 @@ func TestAccAzureRMIotDPSCertificate_update(t *testing.T) {
 }
 
 func testCheckAzureRMIotDPSCertificateDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).iothub.DPSCertificateClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).IoTHub.DPSCertificateClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_iot_dps_certificate" {
@@ -136,7 +138,7 @@ func testCheckAzureRMIotDPSCertificateDestroy(s *terraform.State) error {
 
 func testCheckAzureRMIotDPSCertificateExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -150,7 +152,7 @@ func testCheckAzureRMIotDPSCertificateExists(resourceName string) resource.TestC
 			return fmt.Errorf("Bad: no resource group found in state for IoT Device Provisioning Service Certificate: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).iothub.DPSCertificateClient
+		client := acceptance.AzureProvider.Meta().(*clients.Client).IoTHub.DPSCertificateClient
 		resp, err := client.Get(ctx, name, resourceGroup, iotDPSName, "")
 		if err != nil {
 			if resp.StatusCode == http.StatusNotFound {
@@ -1,4 +1,4 @@
-package keyvault
+package client
 
 import (
 	keyvaultmgmt "github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
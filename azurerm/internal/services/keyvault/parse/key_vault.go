@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// KeyVaultID is a parsed Key Vault ID
+type KeyVaultID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewKeyVaultID returns a new KeyVaultID for the specified values
+func NewKeyVaultID(subscriptionId, resourceGroup, name string) KeyVaultID {
+	return KeyVaultID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Key Vault ID
+func (id KeyVaultID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.KeyVault/vaults/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseKeyVaultID parses a Key Vault ID into a KeyVaultID struct
+func ParseKeyVaultID(input string) (*KeyVaultID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Key Vault ID %q: %+v", input, err)
+	}
+
+	resourceId := KeyVaultID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.KeyVault"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("vaults"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateKeyVaultID validates that the specified value is a Key Vault ID
+func ValidateKeyVaultID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseKeyVaultID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Key Vault ID: %+v", k, err))
+	}
+
+	return
+}
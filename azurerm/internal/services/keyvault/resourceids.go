@@ -0,0 +1,3 @@
+package keyvault
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=KeyVault -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -6,18 +6,19 @@ import (
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 )
 
 func TestAccDataSourceAzureRMKeyVaultSecret_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_key_vault_secret.test"
 
 	rString := acctest.RandString(8)
-	location := testLocation()
+	location := acceptance.Location()
 	config := testAccDataSourceKeyVaultSecret_basic(rString, location)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: config,
@@ -34,12 +35,12 @@ func TestAccDataSourceAzureRMKeyVaultSecret_complete(t *testing.T) {
 	dataSourceName := "data.azurerm_key_vault_secret.test"
 
 	rString := acctest.RandString(8)
-	location := testLocation()
+	location := acceptance.Location()
 	config := testAccDataSourceKeyVaultSecret_complete(rString, location)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: config,
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -9,18 +9,21 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMKeyVaultAccessPolicy_basic(t *testing.T) {
 	resourceName := "azurerm_key_vault_access_policy.test"
 	rs := acctest.RandString(6)
-	config := testAccAzureRMKeyVaultAccessPolicy_basic(rs, testLocation())
+	config := testAccAzureRMKeyVaultAccessPolicy_basic(rs, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -44,11 +47,11 @@ func TestAccAzureRMKeyVaultAccessPolicy_basic(t *testing.T) {
 func TestAccAzureRMKeyVaultAccessPolicy_basicClassic(t *testing.T) {
 	resourceName := "azurerm_key_vault_access_policy.test"
 	rs := acctest.RandString(6)
-	config := testAccAzureRMKeyVaultAccessPolicy_basicClassic(rs, testLocation())
+	config := testAccAzureRMKeyVaultAccessPolicy_basicClassic(rs, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -77,11 +80,11 @@ func TestAccAzureRMKeyVaultAccessPolicy_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_key_vault_access_policy.test"
 	rs := acctest.RandString(6)
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -95,7 +98,7 @@ func TestAccAzureRMKeyVaultAccessPolicy_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMKeyVaultAccessPolicy_requiresImport(rs, location),
-				ExpectError: testRequiresImportError("azurerm_key_vault_access_policy"),
+				ExpectError: acceptance.RequiresImportError("azurerm_key_vault_access_policy"),
 			},
 		},
 	})
@@ -105,11 +108,11 @@ func TestAccAzureRMKeyVaultAccessPolicy_multiple(t *testing.T) {
 	resourceName1 := "azurerm_key_vault_access_policy.test_with_application_id"
 	resourceName2 := "azurerm_key_vault_access_policy.test_no_application_id"
 	rs := acctest.RandString(6)
-	config := testAccAzureRMKeyVaultAccessPolicy_multiple(rs, testLocation())
+	config := testAccAzureRMKeyVaultAccessPolicy_multiple(rs, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -149,12 +152,12 @@ func TestAccAzureRMKeyVaultAccessPolicy_multiple(t *testing.T) {
 func TestAccAzureRMKeyVaultAccessPolicy_update(t *testing.T) {
 	rs := acctest.RandString(6)
 	resourceName := "azurerm_key_vault_access_policy.test"
-	preConfig := testAccAzureRMKeyVaultAccessPolicy_basic(rs, testLocation())
-	postConfig := testAccAzureRMKeyVaultAccessPolicy_update(rs, testLocation())
+	preConfig := testAccAzureRMKeyVaultAccessPolicy_basic(rs, acceptance.Location())
+	postConfig := testAccAzureRMKeyVaultAccessPolicy_update(rs, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -180,11 +183,11 @@ func TestAccAzureRMKeyVaultAccessPolicy_update(t *testing.T) {
 
 func TestAccAzureRMKeyVaultAccessPolicy_nonExistentVault(t *testing.T) {
 	rs := acctest.RandString(6)
-	config := testAccAzureRMKeyVaultAccessPolicy_nonExistentVault(rs, testLocation())
+	config := testAccAzureRMKeyVaultAccessPolicy_nonExistentVault(rs, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -198,8 +201,8 @@ func TestAccAzureRMKeyVaultAccessPolicy_nonExistentVault(t *testing.T) {
 
 func testCheckAzureRMKeyVaultAccessPolicyExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*ArmClient).keyvault.VaultsClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).KeyVault.VaultsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		// Ensure we have enough information in state to look up in API
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -227,7 +230,7 @@ func testCheckAzureRMKeyVaultAccessPolicyExists(resourceName string) resource.Te
 			return fmt.Errorf("Bad: Get on keyVaultClient: %+v", err)
 		}
 
-		policy, err := findKeyVaultAccessPolicy(resp.Properties.AccessPolicies, objectId, applicationId)
+		policy, err := keyvault.FindKeyVaultAccessPolicy(resp.Properties.AccessPolicies, objectId, applicationId)
 		if err != nil {
 			return fmt.Errorf("Error finding Key Vault Access Policy %q : %+v", vaultName, err)
 		}
@@ -1,4 +1,4 @@
-package loganalytics
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/operationalinsights/mgmt/2015-11-01-preview/operationalinsights"
@@ -0,0 +1,48 @@
+package loganalytics
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+)
+
+func TestAccAzureRmLogAnalyticsWorkspaceName_validation(t *testing.T) {
+	str := acctest.RandString(63)
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "abc",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Ab-c",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-abc",
+			ErrCount: 1,
+		},
+		{
+			Value:    "abc-",
+			ErrCount: 1,
+		},
+		{
+			Value:    str,
+			ErrCount: 0,
+		},
+		{
+			Value:    str + "a",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateAzureRmLogAnalyticsWorkspaceName(tc.Value, "azurerm_log_analytics")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the AzureRM Log Analytics Workspace Name to trigger a validation error for '%s'", tc.Value)
+		}
+	}
+}
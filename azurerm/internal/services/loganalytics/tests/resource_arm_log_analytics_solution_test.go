@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -8,16 +8,18 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
 func TestAccAzureRMLogAnalyticsSolution_basicContainerMonitoring(t *testing.T) {
 	resourceName := "azurerm_log_analytics_solution.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMLogAnalyticsSolution_containerMonitoring(ri, testLocation())
+	config := testAccAzureRMLogAnalyticsSolution_containerMonitoring(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    testAccProviders,
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMLogAnalyticsSolutionDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -43,10 +45,10 @@ func TestAccAzureRMLogAnalyticsSolution_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_log_analytics_solution.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    testAccProviders,
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMLogAnalyticsSolutionDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -57,7 +59,7 @@ func TestAccAzureRMLogAnalyticsSolution_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMLogAnalyticsSolution_requiresImport(ri, location),
-				ExpectError: testRequiresImportError("azurerm_log_analytics_solution"),
+				ExpectError: acceptance.RequiresImportError("azurerm_log_analytics_solution"),
 			},
 		},
 	})
@@ -66,10 +68,10 @@ func TestAccAzureRMLogAnalyticsSolution_requiresImport(t *testing.T) {
 func TestAccAzureRMLogAnalyticsSolution_basicSecurity(t *testing.T) {
 	resourceName := "azurerm_log_analytics_solution.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMLogAnalyticsSolution_security(ri, testLocation())
+	config := testAccAzureRMLogAnalyticsSolution_security(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    testAccProviders,
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMLogAnalyticsSolutionDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -88,8 +90,8 @@ func TestAccAzureRMLogAnalyticsSolution_basicSecurity(t *testing.T) {
 }
 
 func testCheckAzureRMLogAnalyticsSolutionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).logAnalytics.SolutionsClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	conn := acceptance.AzureProvider.Meta().(*clients.Client).LogAnalytics.SolutionsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_log_analytics_solution" {
@@ -127,8 +129,8 @@ func testCheckAzureRMLogAnalyticsSolutionExists(resourceName string) resource.Te
 			return fmt.Errorf("Bad: no resource group found in state for Log Analytics Workspace: %q", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).logAnalytics.SolutionsClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		conn := acceptance.AzureProvider.Meta().(*clients.Client).LogAnalytics.SolutionsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, name)
 		if err != nil {
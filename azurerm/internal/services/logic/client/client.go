@@ -1,4 +1,4 @@
-package logic
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2016-06-01/logic"
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -6,16 +6,17 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
 func TestAccAzureRMLogicAppTriggerRecurrence_month(t *testing.T) {
 	resourceName := "azurerm_logic_app_trigger_recurrence.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMLogicAppWorkflowDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -43,10 +44,10 @@ func TestAccAzureRMLogicAppTriggerRecurrence_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_logic_app_trigger_recurrence.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMLogicAppWorkflowDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -59,7 +60,7 @@ func TestAccAzureRMLogicAppTriggerRecurrence_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMLogicAppTriggerRecurrence_requiresImport(ri, location, "Month", 1),
-				ExpectError: testRequiresImportError("azurerm_logic_app_trigger_recurrence"),
+				ExpectError: acceptance.RequiresImportError("azurerm_logic_app_trigger_recurrence"),
 			},
 		},
 	})
@@ -68,10 +69,10 @@ func TestAccAzureRMLogicAppTriggerRecurrence_requiresImport(t *testing.T) {
 func TestAccAzureRMLogicAppTriggerRecurrence_week(t *testing.T) {
 	resourceName := "azurerm_logic_app_trigger_recurrence.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMLogicAppWorkflowDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -94,10 +95,10 @@ func TestAccAzureRMLogicAppTriggerRecurrence_week(t *testing.T) {
 func TestAccAzureRMLogicAppTriggerRecurrence_day(t *testing.T) {
 	resourceName := "azurerm_logic_app_trigger_recurrence.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMLogicAppWorkflowDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -120,10 +121,10 @@ func TestAccAzureRMLogicAppTriggerRecurrence_day(t *testing.T) {
 func TestAccAzureRMLogicAppTriggerRecurrence_minute(t *testing.T) {
 	resourceName := "azurerm_logic_app_trigger_recurrence.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMLogicAppWorkflowDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -146,10 +147,10 @@ func TestAccAzureRMLogicAppTriggerRecurrence_minute(t *testing.T) {
 func TestAccAzureRMLogicAppTriggerRecurrence_second(t *testing.T) {
 	resourceName := "azurerm_logic_app_trigger_recurrence.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMLogicAppWorkflowDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -172,10 +173,10 @@ func TestAccAzureRMLogicAppTriggerRecurrence_second(t *testing.T) {
 func TestAccAzureRMLogicAppTriggerRecurrence_hour(t *testing.T) {
 	resourceName := "azurerm_logic_app_trigger_recurrence.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMLogicAppWorkflowDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -198,10 +199,10 @@ func TestAccAzureRMLogicAppTriggerRecurrence_hour(t *testing.T) {
 func TestAccAzureRMLogicAppTriggerRecurrence_update(t *testing.T) {
 	resourceName := "azurerm_logic_app_trigger_recurrence.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMLogicAppWorkflowDestroy,
 		Steps: []resource.TestStep{
 			{
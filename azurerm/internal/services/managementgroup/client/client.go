@@ -1,4 +1,4 @@
-package managementgroup
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/managementgroups"
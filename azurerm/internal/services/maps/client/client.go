@@ -1,4 +1,4 @@
-package maps
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/maps/mgmt/2018-05-01/maps"
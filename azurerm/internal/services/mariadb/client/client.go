@@ -1,4 +1,4 @@
-package mariadb
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2018-06-01/mariadb"
@@ -1,4 +1,4 @@
-package azurerm
+package mariadb
 
 import (
 	"fmt"
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -52,8 +54,9 @@ func resourceArmMariaDbConfiguration() *schema.Resource {
 }
 
 func resourceArmMariaDbConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).mariadb.ConfigurationsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).MariaDB.ConfigurationsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM MariaDb Configuration creation.")
 
@@ -91,8 +94,9 @@ func resourceArmMariaDbConfigurationCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceArmMariaDbConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).mariadb.ConfigurationsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).MariaDB.ConfigurationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -122,8 +126,9 @@ func resourceArmMariaDbConfigurationRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceArmMariaDbConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).mariadb.ConfigurationsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).MariaDB.ConfigurationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -1,4 +1,4 @@
-package media
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/mediaservices/mgmt/2018-07-01/media"
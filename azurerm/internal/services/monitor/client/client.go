@@ -1,4 +1,4 @@
-package monitor
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
@@ -1,4 +1,4 @@
-package azurerm
+package monitor
 
 import (
 	"fmt"
@@ -7,6 +7,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
 func dataSourceArmMonitorDiagnosticCategories() *schema.Resource {
@@ -37,8 +39,9 @@ func dataSourceArmMonitorDiagnosticCategories() *schema.Resource {
 }
 
 func dataSourceArmMonitorDiagnosticCategoriesRead(d *schema.ResourceData, meta interface{}) error {
-	categoriesClient := meta.(*ArmClient).monitor.DiagnosticSettingsCategoryClient
-	ctx := meta.(*ArmClient).StopContext
+	categoriesClient := meta.(*clients.Client).Monitor.DiagnosticSettingsCategoryClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	actualResourceId := d.Get("resource_id").(string)
 	// trim off the leading `/` since the CheckExistenceByID / List methods don't expect it
@@ -0,0 +1,57 @@
+package monitor
+
+import "testing"
+
+func TestValidateMetricAlertRuleTags(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Value    map[string]interface{}
+		ErrCount int
+	}{
+		{
+			Name: "Single Valid",
+			Value: map[string]interface{}{
+				"hello": "world",
+			},
+			ErrCount: 0,
+		},
+		{
+			Name: "Single Invalid",
+			Value: map[string]interface{}{
+				"$Type": "hello/world",
+			},
+			ErrCount: 1,
+		},
+		{
+			Name: "Single Invalid lowercase",
+			Value: map[string]interface{}{
+				"$type": "hello/world",
+			},
+			ErrCount: 1,
+		},
+		{
+			Name: "Multiple Valid",
+			Value: map[string]interface{}{
+				"hello": "world",
+				"foo":   "bar",
+			},
+			ErrCount: 0,
+		},
+		{
+			Name: "Multiple Invalid",
+			Value: map[string]interface{}{
+				"hello": "world",
+				"$type": "Microsoft.Foo/Bar",
+			},
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateMetricAlertRuleTags(tc.Value, "azurerm_metric_alert_rule")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %q to return %d errors but returned %d", tc.Name, tc.ErrCount, len(errors))
+		}
+	}
+}
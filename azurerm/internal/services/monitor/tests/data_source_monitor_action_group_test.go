@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -6,16 +6,17 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 )
 
 func TestAccDataSourceArmMonitorActionGroup_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_monitor_action_group.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccDataSourceArmMonitorActionGroup_basic(ri, testLocation())
+	config := testAccDataSourceArmMonitorActionGroup_basic(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: config,
@@ -35,11 +36,11 @@ func TestAccDataSourceArmMonitorActionGroup_basic(t *testing.T) {
 func TestAccDataSourceArmMonitorActionGroup_disabledBasic(t *testing.T) {
 	dataSourceName := "data.azurerm_monitor_action_group.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccDataSourceArmMonitorActionGroup_disabledBasic(ri, testLocation())
+	config := testAccDataSourceArmMonitorActionGroup_disabledBasic(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: config,
@@ -59,11 +60,11 @@ func TestAccDataSourceArmMonitorActionGroup_disabledBasic(t *testing.T) {
 func TestAccDataSourceArmMonitorActionGroup_complete(t *testing.T) {
 	dataSourceName := "data.azurerm_monitor_action_group.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccDataSourceArmMonitorActionGroup_complete(ri, testLocation())
+	config := testAccDataSourceArmMonitorActionGroup_complete(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: config,
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -7,73 +7,21 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-func TestValidateMetricAlertRuleTags(t *testing.T) {
-	cases := []struct {
-		Name     string
-		Value    map[string]interface{}
-		ErrCount int
-	}{
-		{
-			Name: "Single Valid",
-			Value: map[string]interface{}{
-				"hello": "world",
-			},
-			ErrCount: 0,
-		},
-		{
-			Name: "Single Invalid",
-			Value: map[string]interface{}{
-				"$Type": "hello/world",
-			},
-			ErrCount: 1,
-		},
-		{
-			Name: "Single Invalid lowercase",
-			Value: map[string]interface{}{
-				"$type": "hello/world",
-			},
-			ErrCount: 1,
-		},
-		{
-			Name: "Multiple Valid",
-			Value: map[string]interface{}{
-				"hello": "world",
-				"foo":   "bar",
-			},
-			ErrCount: 0,
-		},
-		{
-			Name: "Multiple Invalid",
-			Value: map[string]interface{}{
-				"hello": "world",
-				"$type": "Microsoft.Foo/Bar",
-			},
-			ErrCount: 1,
-		},
-	}
-
-	for _, tc := range cases {
-		_, errors := validateMetricAlertRuleTags(tc.Value, "azurerm_metric_alert_rule")
-
-		if len(errors) != tc.ErrCount {
-			t.Fatalf("Expected %q to return %d errors but returned %d", tc.Name, tc.ErrCount, len(errors))
-		}
-	}
-}
-
 func TestAccAzureRMMetricAlertRule_virtualMachineCpu(t *testing.T) {
 	resourceName := "azurerm_metric_alertrule.test"
 	ri := tf.AccRandTimeInt()
-	preConfig := testAccAzureRMMetricAlertRule_virtualMachineCpu(ri, testLocation(), true)
-	postConfig := testAccAzureRMMetricAlertRule_virtualMachineCpu(ri, testLocation(), false)
+	preConfig := testAccAzureRMMetricAlertRule_virtualMachineCpu(ri, acceptance.Location(), true)
+	postConfig := testAccAzureRMMetricAlertRule_virtualMachineCpu(ri, acceptance.Location(), false)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMMetricAlertRuleDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -113,11 +61,11 @@ func TestAccAzureRMMetricAlertRule_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_metric_alertrule.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMMetricAlertRuleDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -128,7 +76,7 @@ func TestAccAzureRMMetricAlertRule_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMMetricAlertRule_requiresImport(ri, location, true),
-				ExpectError: testRequiresImportError("azurerm_metric_alertrule"),
+				ExpectError: acceptance.RequiresImportError("azurerm_metric_alertrule"),
 			},
 		},
 	})
@@ -137,11 +85,11 @@ func TestAccAzureRMMetricAlertRule_requiresImport(t *testing.T) {
 func TestAccAzureRMMetricAlertRule_sqlDatabaseStorage(t *testing.T) {
 	resourceName := "azurerm_metric_alertrule.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMMetricAlertRule_sqlDatabaseStorage(ri, testLocation())
+	config := testAccAzureRMMetricAlertRule_sqlDatabaseStorage(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMMetricAlertRuleDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -169,8 +117,8 @@ func testCheckAzureRMMetricAlertRuleExists(resourceName string) resource.TestChe
 			return fmt.Errorf("Bad: no resource group found in state for Alert Rule: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).monitor.AlertRulesClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Monitor.AlertRulesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
@@ -186,8 +134,8 @@ func testCheckAzureRMMetricAlertRuleExists(resourceName string) resource.TestChe
 }
 
 func testCheckAzureRMMetricAlertRuleDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).monitor.AlertRulesClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Monitor.AlertRulesClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_metric_alertrule" {
@@ -214,7 +162,7 @@ func testCheckAzureRMMetricAlertRuleDestroy(s *terraform.State) error {
 }
 
 func testAccAzureRMMetricAlertRule_virtualMachineCpu(rInt int, location string, enabled bool) string {
-	template := testAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_explicit(rInt, location)
+	template := testAccAzureRMMonitorMetricAlertRule_virtualMachineTemplate(rInt, location)
 	return fmt.Sprintf(`
 %s
 
@@ -294,7 +242,7 @@ resource "azurerm_metric_alertrule" "import" {
 }
 
 func testAccAzureRMMetricAlertRule_sqlDatabaseStorage(rInt int, location string) string {
-	basicSqlServerDatabase := testAccAzureRMSqlDatabase_basic(rInt, location)
+	basicSqlServerDatabase := testAccAzureRMMonitorMetricAlertRule_sqlDatabase(rInt, location)
 
 	return fmt.Sprintf(`
 %s
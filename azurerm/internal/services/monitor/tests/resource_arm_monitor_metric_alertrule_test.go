@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -7,73 +7,21 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-func TestValidateMonitorMetricAlertRuleTags(t *testing.T) {
-	cases := []struct {
-		Name     string
-		Value    map[string]interface{}
-		ErrCount int
-	}{
-		{
-			Name: "Single Valid",
-			Value: map[string]interface{}{
-				"hello": "world",
-			},
-			ErrCount: 0,
-		},
-		{
-			Name: "Single Invalid",
-			Value: map[string]interface{}{
-				"$Type": "hello/world",
-			},
-			ErrCount: 1,
-		},
-		{
-			Name: "Single Invalid lowercase",
-			Value: map[string]interface{}{
-				"$type": "hello/world",
-			},
-			ErrCount: 1,
-		},
-		{
-			Name: "Multiple Valid",
-			Value: map[string]interface{}{
-				"hello": "world",
-				"foo":   "bar",
-			},
-			ErrCount: 0,
-		},
-		{
-			Name: "Multiple Invalid",
-			Value: map[string]interface{}{
-				"hello": "world",
-				"$type": "Microsoft.Foo/Bar",
-			},
-			ErrCount: 1,
-		},
-	}
-
-	for _, tc := range cases {
-		_, errors := validateMonitorMetricAlertRuleTags(tc.Value, "azurerm_metric_alert_rule")
-
-		if len(errors) != tc.ErrCount {
-			t.Fatalf("Expected %q to return %d errors but returned %d", tc.Name, tc.ErrCount, len(errors))
-		}
-	}
-}
-
 func TestAccAzureRMMonitorMetricAlertRule_virtualMachineCpu(t *testing.T) {
 	resourceName := "azurerm_monitor_metric_alertrule.test"
 	ri := tf.AccRandTimeInt()
-	preConfig := testAccAzureRMMonitorMetricAlertRule_virtualMachineCpu(ri, testLocation(), true)
-	postConfig := testAccAzureRMMonitorMetricAlertRule_virtualMachineCpu(ri, testLocation(), false)
+	preConfig := testAccAzureRMMonitorMetricAlertRule_virtualMachineCpu(ri, acceptance.Location(), true)
+	postConfig := testAccAzureRMMonitorMetricAlertRule_virtualMachineCpu(ri, acceptance.Location(), false)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMMonitorMetricAlertRuleDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -113,11 +61,11 @@ func TestAccAzureRMMonitorMetricAlertRule_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_monitor_metric_alertrule.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMMonitorMetricAlertRuleDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -128,7 +76,7 @@ func TestAccAzureRMMonitorMetricAlertRule_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMMonitorMetricAlertRule_requiresImport(ri, location, true),
-				ExpectError: testRequiresImportError("azurerm_monitor_metric_alertrule"),
+				ExpectError: acceptance.RequiresImportError("azurerm_monitor_metric_alertrule"),
 			},
 		},
 	})
@@ -137,11 +85,11 @@ func TestAccAzureRMMonitorMetricAlertRule_requiresImport(t *testing.T) {
 func TestAccAzureRMMonitorMetricAlertRule_sqlDatabaseStorage(t *testing.T) {
 	resourceName := "azurerm_monitor_metric_alertrule.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMMonitorMetricAlertRule_sqlDatabaseStorage(ri, testLocation())
+	config := testAccAzureRMMonitorMetricAlertRule_sqlDatabaseStorage(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMMonitorMetricAlertRuleDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -169,8 +117,8 @@ func testCheckAzureRMMonitorMetricAlertRuleExists(resourceName string) resource.
 			return fmt.Errorf("Bad: no resource group found in state for Alert Rule: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).monitor.AlertRulesClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Monitor.AlertRulesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
@@ -186,8 +134,8 @@ func testCheckAzureRMMonitorMetricAlertRuleExists(resourceName string) resource.
 }
 
 func testCheckAzureRMMonitorMetricAlertRuleDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).monitor.AlertRulesClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Monitor.AlertRulesClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_monitor_metric_alertrule" {
@@ -214,7 +162,7 @@ func testCheckAzureRMMonitorMetricAlertRuleDestroy(s *terraform.State) error {
 }
 
 func testAccAzureRMMonitorMetricAlertRule_virtualMachineCpu(rInt int, location string, enabled bool) string {
-	template := testAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_explicit(rInt, location)
+	template := testAccAzureRMMonitorMetricAlertRule_virtualMachineTemplate(rInt, location)
 	return fmt.Sprintf(`
 %s
 
@@ -294,7 +242,7 @@ resource "azurerm_monitor_metric_alertrule" "import" {
 }
 
 func testAccAzureRMMonitorMetricAlertRule_sqlDatabaseStorage(rInt int, location string) string {
-	basicSqlServerDatabase := testAccAzureRMSqlDatabase_basic(rInt, location)
+	basicSqlServerDatabase := testAccAzureRMMonitorMetricAlertRule_sqlDatabase(rInt, location)
 
 	return fmt.Sprintf(`
 %s
@@ -334,3 +282,105 @@ resource "azurerm_monitor_metric_alertrule" "test" {
 }
 `, basicSqlServerDatabase)
 }
+
+func testAccAzureRMMonitorMetricAlertRule_virtualMachineTemplate(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctni-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_virtual_machine" "test" {
+  name                  = "acctvm-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  network_interface_ids = ["${azurerm_network_interface.test.id}"]
+  vm_size               = "Standard_D1_v2"
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "osd-%d"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    disk_size_gb      = "50"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "hn%d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+
+  tags = {
+    environment = "Production"
+    cost-center = "Ops"
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt)
+}
+
+func testAccAzureRMMonitorMetricAlertRule_sqlDatabase(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+}
+`, rInt, location, rInt, rInt)
+}
@@ -1,4 +1,4 @@
-package msi
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/msi/mgmt/2015-08-31-preview/msi"
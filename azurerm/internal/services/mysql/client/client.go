@@ -1,4 +1,4 @@
-package mysql
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
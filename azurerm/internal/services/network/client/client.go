@@ -0,0 +1,244 @@
+package client
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	ApplicationGatewaysClient              *network.ApplicationGatewaysClient
+	ApplicationSecurityGroupsClient        *network.ApplicationSecurityGroupsClient
+	AzureFirewallsClient                   *network.AzureFirewallsClient
+	BastionHostsClient                     *network.BastionHostsClient
+	ConnectionMonitorsClient               *network.ConnectionMonitorsClient
+	DDOSProtectionPlansClient              *network.DdosProtectionPlansClient
+	ExpressRouteAuthsClient                *network.ExpressRouteCircuitAuthorizationsClient
+	ExpressRouteCircuitConnectionsClient   *network.ExpressRouteCircuitConnectionsClient
+	ExpressRouteCircuitsClient             *network.ExpressRouteCircuitsClient
+	ExpressRouteGatewaysClient             *network.ExpressRouteGatewaysClient
+	ExpressRoutePeeringsClient             *network.ExpressRouteCircuitPeeringsClient
+	ExpressRoutePortsClient                *network.ExpressRoutePortsClient
+	FirewallPoliciesClient                 *network.FirewallPoliciesClient
+	FirewallPolicyRuleGroupsClient         *network.FirewallPolicyRuleGroupsClient
+	HubVirtualNetworkConnectionClient      *network.HubVirtualNetworkConnectionsClient
+	InterfacesClient                       *network.InterfacesClient
+	InterfaceTapConfigurationsClient       *network.InterfaceTapConfigurationsClient
+	LoadBalancersClient                    *network.LoadBalancersClient
+	LocalNetworkGatewaysClient             *network.LocalNetworkGatewaysClient
+	NatGatewayClient                       *network.NatGatewaysClient
+	PointToSiteVpnGatewaysClient           *network.P2sVpnGatewaysClient
+	PrivateEndpointClient                  *network.PrivateEndpointsClient
+	PrivateLinkServiceClient               *network.PrivateLinkServicesClient
+	ProfileClient                          *network.ProfilesClient
+	PacketCapturesClient                   *network.PacketCapturesClient
+	PublicIPsClient                        *network.PublicIPAddressesClient
+	PublicIPPrefixesClient                 *network.PublicIPPrefixesClient
+	RouteFiltersClient                     *network.RouteFiltersClient
+	RoutesClient                           *network.RoutesClient
+	RouteTablesClient                      *network.RouteTablesClient
+	SecurityGroupClient                    *network.SecurityGroupsClient
+	SecurityRuleClient                     *network.SecurityRulesClient
+	ServiceEndpointPoliciesClient          *network.ServiceEndpointPoliciesClient
+	ServiceEndpointPolicyDefinitionsClient *network.ServiceEndpointPolicyDefinitionsClient
+	SubnetsClient                          *network.SubnetsClient
+	VirtualHubClient                       *network.VirtualHubsClient
+	VirtualNetworkTapsClient               *network.VirtualNetworkTapsClient
+	VnetGatewayConnectionsClient           *network.VirtualNetworkGatewayConnectionsClient
+	VnetGatewayClient                      *network.VirtualNetworkGatewaysClient
+	VnetClient                             *network.VirtualNetworksClient
+	VnetPeeringsClient                     *network.VirtualNetworkPeeringsClient
+	VirtualWanClient                       *network.VirtualWansClient
+	VpnGatewaysClient                      *network.VpnGatewaysClient
+	VpnSitesClient                         *network.VpnSitesClient
+	WatcherClient                          *network.WatchersClient
+	WebApplicationFirewallPoliciesClient   *network.WebApplicationFirewallPoliciesClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	ApplicationGatewaysClient := network.NewApplicationGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ApplicationGatewaysClient.Client, o.ResourceManagerAuthorizer)
+
+	ApplicationSecurityGroupsClient := network.NewApplicationSecurityGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ApplicationSecurityGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	AzureFirewallsClient := network.NewAzureFirewallsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&AzureFirewallsClient.Client, o.ResourceManagerAuthorizer)
+
+	BastionHostsClient := network.NewBastionHostsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&BastionHostsClient.Client, o.ResourceManagerAuthorizer)
+
+	ConnectionMonitorsClient := network.NewConnectionMonitorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ConnectionMonitorsClient.Client, o.ResourceManagerAuthorizer)
+
+	DDOSProtectionPlansClient := network.NewDdosProtectionPlansClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&DDOSProtectionPlansClient.Client, o.ResourceManagerAuthorizer)
+
+	ExpressRouteAuthsClient := network.NewExpressRouteCircuitAuthorizationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ExpressRouteAuthsClient.Client, o.ResourceManagerAuthorizer)
+
+	ExpressRouteCircuitConnectionsClient := network.NewExpressRouteCircuitConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ExpressRouteCircuitConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	ExpressRouteCircuitsClient := network.NewExpressRouteCircuitsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ExpressRouteCircuitsClient.Client, o.ResourceManagerAuthorizer)
+
+	ExpressRouteGatewaysClient := network.NewExpressRouteGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ExpressRouteGatewaysClient.Client, o.ResourceManagerAuthorizer)
+
+	ExpressRoutePeeringsClient := network.NewExpressRouteCircuitPeeringsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ExpressRoutePeeringsClient.Client, o.ResourceManagerAuthorizer)
+
+	ExpressRoutePortsClient := network.NewExpressRoutePortsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ExpressRoutePortsClient.Client, o.ResourceManagerAuthorizer)
+
+	FirewallPoliciesClient := network.NewFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&FirewallPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
+	FirewallPolicyRuleGroupsClient := network.NewFirewallPolicyRuleGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&FirewallPolicyRuleGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	HubVirtualNetworkConnectionClient := network.NewHubVirtualNetworkConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&HubVirtualNetworkConnectionClient.Client, o.ResourceManagerAuthorizer)
+
+	InterfacesClient := network.NewInterfacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&InterfacesClient.Client, o.ResourceManagerAuthorizer)
+
+	InterfaceTapConfigurationsClient := network.NewInterfaceTapConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&InterfaceTapConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	LoadBalancersClient := network.NewLoadBalancersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&LoadBalancersClient.Client, o.ResourceManagerAuthorizer)
+
+	LocalNetworkGatewaysClient := network.NewLocalNetworkGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&LocalNetworkGatewaysClient.Client, o.ResourceManagerAuthorizer)
+
+	NatGatewayClient := network.NewNatGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&NatGatewayClient.Client, o.ResourceManagerAuthorizer)
+
+	PointToSiteVpnGatewaysClient := network.NewP2sVpnGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&PointToSiteVpnGatewaysClient.Client, o.ResourceManagerAuthorizer)
+
+	PrivateEndpointClient := network.NewPrivateEndpointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&PrivateEndpointClient.Client, o.ResourceManagerAuthorizer)
+
+	PrivateLinkServiceClient := network.NewPrivateLinkServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&PrivateLinkServiceClient.Client, o.ResourceManagerAuthorizer)
+
+	ProfileClient := network.NewProfilesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ProfileClient.Client, o.ResourceManagerAuthorizer)
+
+	RouteFiltersClient := network.NewRouteFiltersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&RouteFiltersClient.Client, o.ResourceManagerAuthorizer)
+
+	ServiceEndpointPoliciesClient := network.NewServiceEndpointPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ServiceEndpointPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
+	ServiceEndpointPolicyDefinitionsClient := network.NewServiceEndpointPolicyDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ServiceEndpointPolicyDefinitionsClient.Client, o.ResourceManagerAuthorizer)
+
+	VirtualHubClient := network.NewVirtualHubsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VirtualHubClient.Client, o.ResourceManagerAuthorizer)
+
+	VirtualNetworkTapsClient := network.NewVirtualNetworkTapsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VirtualNetworkTapsClient.Client, o.ResourceManagerAuthorizer)
+
+	VnetClient := network.NewVirtualNetworksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VnetClient.Client, o.ResourceManagerAuthorizer)
+
+	PacketCapturesClient := network.NewPacketCapturesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&PacketCapturesClient.Client, o.ResourceManagerAuthorizer)
+
+	VnetPeeringsClient := network.NewVirtualNetworkPeeringsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VnetPeeringsClient.Client, o.ResourceManagerAuthorizer)
+
+	PublicIPsClient := network.NewPublicIPAddressesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&PublicIPsClient.Client, o.ResourceManagerAuthorizer)
+
+	PublicIPPrefixesClient := network.NewPublicIPPrefixesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&PublicIPPrefixesClient.Client, o.ResourceManagerAuthorizer)
+
+	RoutesClient := network.NewRoutesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&RoutesClient.Client, o.ResourceManagerAuthorizer)
+
+	RouteTablesClient := network.NewRouteTablesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&RouteTablesClient.Client, o.ResourceManagerAuthorizer)
+
+	SecurityGroupClient := network.NewSecurityGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&SecurityGroupClient.Client, o.ResourceManagerAuthorizer)
+
+	SecurityRuleClient := network.NewSecurityRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&SecurityRuleClient.Client, o.ResourceManagerAuthorizer)
+
+	SubnetsClient := network.NewSubnetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&SubnetsClient.Client, o.ResourceManagerAuthorizer)
+
+	VnetGatewayClient := network.NewVirtualNetworkGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VnetGatewayClient.Client, o.ResourceManagerAuthorizer)
+
+	VnetGatewayConnectionsClient := network.NewVirtualNetworkGatewayConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VnetGatewayConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	VirtualWanClient := network.NewVirtualWansClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VirtualWanClient.Client, o.ResourceManagerAuthorizer)
+
+	VpnGatewaysClient := network.NewVpnGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VpnGatewaysClient.Client, o.ResourceManagerAuthorizer)
+
+	VpnSitesClient := network.NewVpnSitesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VpnSitesClient.Client, o.ResourceManagerAuthorizer)
+
+	WatcherClient := network.NewWatchersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&WatcherClient.Client, o.ResourceManagerAuthorizer)
+
+	WebApplicationFirewallPoliciesClient := network.NewWebApplicationFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&WebApplicationFirewallPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ApplicationGatewaysClient:              &ApplicationGatewaysClient,
+		ApplicationSecurityGroupsClient:        &ApplicationSecurityGroupsClient,
+		AzureFirewallsClient:                   &AzureFirewallsClient,
+		BastionHostsClient:                     &BastionHostsClient,
+		ConnectionMonitorsClient:               &ConnectionMonitorsClient,
+		DDOSProtectionPlansClient:              &DDOSProtectionPlansClient,
+		ExpressRouteAuthsClient:                &ExpressRouteAuthsClient,
+		ExpressRouteCircuitConnectionsClient:   &ExpressRouteCircuitConnectionsClient,
+		ExpressRouteCircuitsClient:             &ExpressRouteCircuitsClient,
+		ExpressRouteGatewaysClient:             &ExpressRouteGatewaysClient,
+		ExpressRoutePeeringsClient:             &ExpressRoutePeeringsClient,
+		ExpressRoutePortsClient:                &ExpressRoutePortsClient,
+		FirewallPoliciesClient:                 &FirewallPoliciesClient,
+		FirewallPolicyRuleGroupsClient:         &FirewallPolicyRuleGroupsClient,
+		HubVirtualNetworkConnectionClient:      &HubVirtualNetworkConnectionClient,
+		InterfacesClient:                       &InterfacesClient,
+		InterfaceTapConfigurationsClient:       &InterfaceTapConfigurationsClient,
+		LoadBalancersClient:                    &LoadBalancersClient,
+		LocalNetworkGatewaysClient:             &LocalNetworkGatewaysClient,
+		NatGatewayClient:                       &NatGatewayClient,
+		PointToSiteVpnGatewaysClient:           &PointToSiteVpnGatewaysClient,
+		PrivateEndpointClient:                  &PrivateEndpointClient,
+		PrivateLinkServiceClient:               &PrivateLinkServiceClient,
+		ProfileClient:                          &ProfileClient,
+		PacketCapturesClient:                   &PacketCapturesClient,
+		PublicIPsClient:                        &PublicIPsClient,
+		PublicIPPrefixesClient:                 &PublicIPPrefixesClient,
+		RouteFiltersClient:                     &RouteFiltersClient,
+		RoutesClient:                           &RoutesClient,
+		RouteTablesClient:                      &RouteTablesClient,
+		SecurityGroupClient:                    &SecurityGroupClient,
+		SecurityRuleClient:                     &SecurityRuleClient,
+		ServiceEndpointPoliciesClient:          &ServiceEndpointPoliciesClient,
+		ServiceEndpointPolicyDefinitionsClient: &ServiceEndpointPolicyDefinitionsClient,
+		SubnetsClient:                          &SubnetsClient,
+		VirtualHubClient:                       &VirtualHubClient,
+		VirtualNetworkTapsClient:               &VirtualNetworkTapsClient,
+		VnetGatewayConnectionsClient:           &VnetGatewayConnectionsClient,
+		VnetGatewayClient:                      &VnetGatewayClient,
+		VnetClient:                             &VnetClient,
+		VnetPeeringsClient:                     &VnetPeeringsClient,
+		VirtualWanClient:                       &VirtualWanClient,
+		VpnGatewaysClient:                      &VpnGatewaysClient,
+		VpnSitesClient:                         &VpnSitesClient,
+		WatcherClient:                          &WatcherClient,
+		WebApplicationFirewallPoliciesClient:   &WebApplicationFirewallPoliciesClient,
+	}
+}
@@ -0,0 +1,154 @@
+package network
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmPrivateEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmPrivateEndpointRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"location": azure.SchemaLocationForDataSource(),
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"private_service_connection": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_manual_connection": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"private_connection_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subresource_names": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"request_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func dataSourceArmPrivateEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PrivateEndpointClient
+	interfacesClient := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Private Endpoint %q was not found in Resource Group %q", name, resourceGroup)
+		}
+		return fmt.Errorf("Error retrieving Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for Private Endpoint %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.PrivateEndpointProperties; props != nil {
+		subnetId := ""
+		if props.Subnet != nil && props.Subnet.ID != nil {
+			subnetId = *props.Subnet.ID
+		}
+		d.Set("subnet_id", subnetId)
+
+		networkInterfaceIds := make([]interface{}, 0)
+		privateIpAddress := ""
+		if nics := props.NetworkInterfaces; nics != nil {
+			for _, nic := range *nics {
+				if nic.ID == nil {
+					continue
+				}
+				networkInterfaceIds = append(networkInterfaceIds, *nic.ID)
+
+				if privateIpAddress == "" {
+					privateIpAddress, err = retrievePrivateEndpointIPAddress(ctx, interfacesClient, *nic.ID)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+		if err := d.Set("network_interface_ids", networkInterfaceIds); err != nil {
+			return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+		}
+
+		connections := props.PrivateLinkServiceConnections
+		isManual := false
+		if props.ManualPrivateLinkServiceConnections != nil && len(*props.ManualPrivateLinkServiceConnections) > 0 {
+			connections = props.ManualPrivateLinkServiceConnections
+			isManual = true
+		}
+		if err := d.Set("private_service_connection", flattenArmPrivateEndpointServiceConnection(connections, isManual, privateIpAddress)); err != nil {
+			return fmt.Errorf("Error setting `private_service_connection`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
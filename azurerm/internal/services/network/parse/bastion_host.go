@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// BastionHostID is a parsed Bastion Host ID
+type BastionHostID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewBastionHostID returns a new BastionHostID for the specified values
+func NewBastionHostID(subscriptionId, resourceGroup, name string) BastionHostID {
+	return BastionHostID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Bastion Host ID
+func (id BastionHostID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/bastionHosts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseBastionHostID parses a Bastion Host ID into a BastionHostID struct
+func ParseBastionHostID(input string) (*BastionHostID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Bastion Host ID %q: %+v", input, err)
+	}
+
+	resourceId := BastionHostID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("bastionHosts"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateBastionHostID validates that the specified value is a Bastion Host ID
+func ValidateBastionHostID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseBastionHostID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Bastion Host ID: %+v", k, err))
+	}
+
+	return
+}
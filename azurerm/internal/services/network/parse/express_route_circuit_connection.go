@@ -0,0 +1,88 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ExpressRouteCircuitConnectionID is a parsed Express Route Circuit Connection ID
+type ExpressRouteCircuitConnectionID struct {
+	SubscriptionId          string
+	ResourceGroup           string
+	ExpressRouteCircuitName string
+	PeeringName             string
+	Name                    string
+}
+
+// NewExpressRouteCircuitConnectionID returns a new ExpressRouteCircuitConnectionID for the specified values
+func NewExpressRouteCircuitConnectionID(subscriptionId, resourceGroup, expressRouteCircuitName, peeringName, name string) ExpressRouteCircuitConnectionID {
+	return ExpressRouteCircuitConnectionID{
+		SubscriptionId:          subscriptionId,
+		ResourceGroup:           resourceGroup,
+		ExpressRouteCircuitName: expressRouteCircuitName,
+		PeeringName:             peeringName,
+		Name:                    name,
+	}
+}
+
+// ID returns the formatted Express Route Circuit Connection ID
+func (id ExpressRouteCircuitConnectionID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/expressRouteCircuits/%s/peerings/%s/connections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ExpressRouteCircuitName, id.PeeringName, id.Name)
+}
+
+// ParseExpressRouteCircuitConnectionID parses a Express Route Circuit Connection ID into a ExpressRouteCircuitConnectionID struct
+func ParseExpressRouteCircuitConnectionID(input string) (*ExpressRouteCircuitConnectionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Express Route Circuit Connection ID %q: %+v", input, err)
+	}
+
+	resourceId := ExpressRouteCircuitConnectionID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.ExpressRouteCircuitName, err = id.PopSegment("expressRouteCircuits"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.PeeringName, err = id.PopSegment("peerings"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("connections"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateExpressRouteCircuitConnectionID validates that the specified value is a Express Route Circuit Connection ID
+func ValidateExpressRouteCircuitConnectionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseExpressRouteCircuitConnectionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Express Route Circuit Connection ID: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,82 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ExpressRouteCircuitPeeringID is a parsed Express Route Circuit Peering ID
+type ExpressRouteCircuitPeeringID struct {
+	SubscriptionId          string
+	ResourceGroup           string
+	ExpressRouteCircuitName string
+	Name                    string
+}
+
+// NewExpressRouteCircuitPeeringID returns a new ExpressRouteCircuitPeeringID for the specified values
+func NewExpressRouteCircuitPeeringID(subscriptionId, resourceGroup, expressRouteCircuitName, name string) ExpressRouteCircuitPeeringID {
+	return ExpressRouteCircuitPeeringID{
+		SubscriptionId:          subscriptionId,
+		ResourceGroup:           resourceGroup,
+		ExpressRouteCircuitName: expressRouteCircuitName,
+		Name:                    name,
+	}
+}
+
+// ID returns the formatted Express Route Circuit Peering ID
+func (id ExpressRouteCircuitPeeringID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/expressRouteCircuits/%s/peerings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ExpressRouteCircuitName, id.Name)
+}
+
+// ParseExpressRouteCircuitPeeringID parses a Express Route Circuit Peering ID into a ExpressRouteCircuitPeeringID struct
+func ParseExpressRouteCircuitPeeringID(input string) (*ExpressRouteCircuitPeeringID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Express Route Circuit Peering ID %q: %+v", input, err)
+	}
+
+	resourceId := ExpressRouteCircuitPeeringID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.ExpressRouteCircuitName, err = id.PopSegment("expressRouteCircuits"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("peerings"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateExpressRouteCircuitPeeringID validates that the specified value is a Express Route Circuit Peering ID
+func ValidateExpressRouteCircuitPeeringID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseExpressRouteCircuitPeeringID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Express Route Circuit Peering ID: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ExpressRouteGatewayID is a parsed Express Route Gateway ID
+type ExpressRouteGatewayID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewExpressRouteGatewayID returns a new ExpressRouteGatewayID for the specified values
+func NewExpressRouteGatewayID(subscriptionId, resourceGroup, name string) ExpressRouteGatewayID {
+	return ExpressRouteGatewayID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Express Route Gateway ID
+func (id ExpressRouteGatewayID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/expressRouteGateways/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseExpressRouteGatewayID parses a Express Route Gateway ID into a ExpressRouteGatewayID struct
+func ParseExpressRouteGatewayID(input string) (*ExpressRouteGatewayID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Express Route Gateway ID %q: %+v", input, err)
+	}
+
+	resourceId := ExpressRouteGatewayID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("expressRouteGateways"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateExpressRouteGatewayID validates that the specified value is a Express Route Gateway ID
+func ValidateExpressRouteGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseExpressRouteGatewayID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Express Route Gateway ID: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ExpressRoutePortID is a parsed Express Route Port ID
+type ExpressRoutePortID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewExpressRoutePortID returns a new ExpressRoutePortID for the specified values
+func NewExpressRoutePortID(subscriptionId, resourceGroup, name string) ExpressRoutePortID {
+	return ExpressRoutePortID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Express Route Port ID
+func (id ExpressRoutePortID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/expressRoutePorts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseExpressRoutePortID parses a Express Route Port ID into a ExpressRoutePortID struct
+func ParseExpressRoutePortID(input string) (*ExpressRoutePortID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Express Route Port ID %q: %+v", input, err)
+	}
+
+	resourceId := ExpressRoutePortID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("expressRoutePorts"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateExpressRoutePortID validates that the specified value is a Express Route Port ID
+func ValidateExpressRoutePortID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseExpressRoutePortID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Express Route Port ID: %+v", k, err))
+	}
+
+	return
+}
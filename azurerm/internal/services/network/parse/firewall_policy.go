@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// FirewallPolicyID is a parsed Firewall Policy ID
+type FirewallPolicyID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewFirewallPolicyID returns a new FirewallPolicyID for the specified values
+func NewFirewallPolicyID(subscriptionId, resourceGroup, name string) FirewallPolicyID {
+	return FirewallPolicyID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Firewall Policy ID
+func (id FirewallPolicyID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/firewallPolicies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseFirewallPolicyID parses a Firewall Policy ID into a FirewallPolicyID struct
+func ParseFirewallPolicyID(input string) (*FirewallPolicyID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Policy ID %q: %+v", input, err)
+	}
+
+	resourceId := FirewallPolicyID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("firewallPolicies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateFirewallPolicyID validates that the specified value is a Firewall Policy ID
+func ValidateFirewallPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseFirewallPolicyID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Firewall Policy ID: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,82 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// FirewallPolicyRuleGroupID is a parsed Firewall Policy Rule Group ID
+type FirewallPolicyRuleGroupID struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	FirewallPolicyName string
+	Name               string
+}
+
+// NewFirewallPolicyRuleGroupID returns a new FirewallPolicyRuleGroupID for the specified values
+func NewFirewallPolicyRuleGroupID(subscriptionId, resourceGroup, firewallPolicyName, name string) FirewallPolicyRuleGroupID {
+	return FirewallPolicyRuleGroupID{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		FirewallPolicyName: firewallPolicyName,
+		Name:               name,
+	}
+}
+
+// ID returns the formatted Firewall Policy Rule Group ID
+func (id FirewallPolicyRuleGroupID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/firewallPolicies/%s/ruleGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.Name)
+}
+
+// ParseFirewallPolicyRuleGroupID parses a Firewall Policy Rule Group ID into a FirewallPolicyRuleGroupID struct
+func ParseFirewallPolicyRuleGroupID(input string) (*FirewallPolicyRuleGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Policy Rule Group ID %q: %+v", input, err)
+	}
+
+	resourceId := FirewallPolicyRuleGroupID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.FirewallPolicyName, err = id.PopSegment("firewallPolicies"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("ruleGroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateFirewallPolicyRuleGroupID validates that the specified value is a Firewall Policy Rule Group ID
+func ValidateFirewallPolicyRuleGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseFirewallPolicyRuleGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Firewall Policy Rule Group ID: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NetworkInterfaceID is a parsed Network Interface ID
+type NetworkInterfaceID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewNetworkInterfaceID returns a new NetworkInterfaceID for the specified values
+func NewNetworkInterfaceID(subscriptionId, resourceGroup, name string) NetworkInterfaceID {
+	return NetworkInterfaceID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Network Interface ID
+func (id NetworkInterfaceID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkInterfaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseNetworkInterfaceID parses a Network Interface ID into a NetworkInterfaceID struct
+func ParseNetworkInterfaceID(input string) (*NetworkInterfaceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Network Interface ID %q: %+v", input, err)
+	}
+
+	resourceId := NetworkInterfaceID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("networkInterfaces"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNetworkInterfaceID validates that the specified value is a Network Interface ID
+func ValidateNetworkInterfaceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseNetworkInterfaceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Network Interface ID: %+v", k, err))
+	}
+
+	return
+}
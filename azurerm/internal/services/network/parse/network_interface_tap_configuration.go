@@ -0,0 +1,82 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NetworkInterfaceTapConfigurationID is a parsed Network Interface Tap Configuration ID
+type NetworkInterfaceTapConfigurationID struct {
+	SubscriptionId       string
+	ResourceGroup        string
+	NetworkInterfaceName string
+	Name                 string
+}
+
+// NewNetworkInterfaceTapConfigurationID returns a new NetworkInterfaceTapConfigurationID for the specified values
+func NewNetworkInterfaceTapConfigurationID(subscriptionId, resourceGroup, networkInterfaceName, name string) NetworkInterfaceTapConfigurationID {
+	return NetworkInterfaceTapConfigurationID{
+		SubscriptionId:       subscriptionId,
+		ResourceGroup:        resourceGroup,
+		NetworkInterfaceName: networkInterfaceName,
+		Name:                 name,
+	}
+}
+
+// ID returns the formatted Network Interface Tap Configuration ID
+func (id NetworkInterfaceTapConfigurationID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkInterfaces/%s/tapConfigurations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetworkInterfaceName, id.Name)
+}
+
+// ParseNetworkInterfaceTapConfigurationID parses a Network Interface Tap Configuration ID into a NetworkInterfaceTapConfigurationID struct
+func ParseNetworkInterfaceTapConfigurationID(input string) (*NetworkInterfaceTapConfigurationID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Network Interface Tap Configuration ID %q: %+v", input, err)
+	}
+
+	resourceId := NetworkInterfaceTapConfigurationID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.NetworkInterfaceName, err = id.PopSegment("networkInterfaces"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("tapConfigurations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNetworkInterfaceTapConfigurationID validates that the specified value is a Network Interface Tap Configuration ID
+func ValidateNetworkInterfaceTapConfigurationID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseNetworkInterfaceTapConfigurationID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Network Interface Tap Configuration ID: %+v", k, err))
+	}
+
+	return
+}
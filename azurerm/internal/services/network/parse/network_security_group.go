@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NetworkSecurityGroupID is a parsed Network Security Group ID
+type NetworkSecurityGroupID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewNetworkSecurityGroupID returns a new NetworkSecurityGroupID for the specified values
+func NewNetworkSecurityGroupID(subscriptionId, resourceGroup, name string) NetworkSecurityGroupID {
+	return NetworkSecurityGroupID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Network Security Group ID
+func (id NetworkSecurityGroupID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkSecurityGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseNetworkSecurityGroupID parses a Network Security Group ID into a NetworkSecurityGroupID struct
+func ParseNetworkSecurityGroupID(input string) (*NetworkSecurityGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Network Security Group ID %q: %+v", input, err)
+	}
+
+	resourceId := NetworkSecurityGroupID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("networkSecurityGroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNetworkSecurityGroupID validates that the specified value is a Network Security Group ID
+func ValidateNetworkSecurityGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseNetworkSecurityGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Network Security Group ID: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PointToSiteVpnGatewayID is a parsed Point To Site Vpn Gateway ID
+type PointToSiteVpnGatewayID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewPointToSiteVpnGatewayID returns a new PointToSiteVpnGatewayID for the specified values
+func NewPointToSiteVpnGatewayID(subscriptionId, resourceGroup, name string) PointToSiteVpnGatewayID {
+	return PointToSiteVpnGatewayID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Point To Site Vpn Gateway ID
+func (id PointToSiteVpnGatewayID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/p2sVpnGateways/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParsePointToSiteVpnGatewayID parses a Point To Site Vpn Gateway ID into a PointToSiteVpnGatewayID struct
+func ParsePointToSiteVpnGatewayID(input string) (*PointToSiteVpnGatewayID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Point To Site Vpn Gateway ID %q: %+v", input, err)
+	}
+
+	resourceId := PointToSiteVpnGatewayID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("p2sVpnGateways"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePointToSiteVpnGatewayID validates that the specified value is a Point To Site Vpn Gateway ID
+func ValidatePointToSiteVpnGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePointToSiteVpnGatewayID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Point To Site Vpn Gateway ID: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PrivateEndpointID is a parsed Private Endpoint ID
+type PrivateEndpointID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewPrivateEndpointID returns a new PrivateEndpointID for the specified values
+func NewPrivateEndpointID(subscriptionId, resourceGroup, name string) PrivateEndpointID {
+	return PrivateEndpointID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Private Endpoint ID
+func (id PrivateEndpointID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateEndpoints/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParsePrivateEndpointID parses a Private Endpoint ID into a PrivateEndpointID struct
+func ParsePrivateEndpointID(input string) (*PrivateEndpointID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Private Endpoint ID %q: %+v", input, err)
+	}
+
+	resourceId := PrivateEndpointID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("privateEndpoints"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePrivateEndpointID validates that the specified value is a Private Endpoint ID
+func ValidatePrivateEndpointID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePrivateEndpointID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Private Endpoint ID: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PrivateLinkServiceID is a parsed Private Link Service ID
+type PrivateLinkServiceID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewPrivateLinkServiceID returns a new PrivateLinkServiceID for the specified values
+func NewPrivateLinkServiceID(subscriptionId, resourceGroup, name string) PrivateLinkServiceID {
+	return PrivateLinkServiceID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Private Link Service ID
+func (id PrivateLinkServiceID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateLinkServices/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParsePrivateLinkServiceID parses a Private Link Service ID into a PrivateLinkServiceID struct
+func ParsePrivateLinkServiceID(input string) (*PrivateLinkServiceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Private Link Service ID %q: %+v", input, err)
+	}
+
+	resourceId := PrivateLinkServiceID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("privateLinkServices"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePrivateLinkServiceID validates that the specified value is a Private Link Service ID
+func ValidatePrivateLinkServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePrivateLinkServiceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Private Link Service ID: %+v", k, err))
+	}
+
+	return
+}
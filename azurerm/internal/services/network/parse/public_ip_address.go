@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PublicIPAddressID is a parsed Public IP Address ID
+type PublicIPAddressID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewPublicIPAddressID returns a new PublicIPAddressID for the specified values
+func NewPublicIPAddressID(subscriptionId, resourceGroup, name string) PublicIPAddressID {
+	return PublicIPAddressID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Public IP Address ID
+func (id PublicIPAddressID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/publicIPAddresses/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParsePublicIPAddressID parses a Public IP Address ID into a PublicIPAddressID struct
+func ParsePublicIPAddressID(input string) (*PublicIPAddressID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Public IP Address ID %q: %+v", input, err)
+	}
+
+	resourceId := PublicIPAddressID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("publicIPAddresses"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePublicIPAddressID validates that the specified value is a Public IP Address ID
+func ValidatePublicIPAddressID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePublicIPAddressID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Public IP Address ID: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PublicIPPrefixID is a parsed Public IP Prefix ID
+type PublicIPPrefixID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewPublicIPPrefixID returns a new PublicIPPrefixID for the specified values
+func NewPublicIPPrefixID(subscriptionId, resourceGroup, name string) PublicIPPrefixID {
+	return PublicIPPrefixID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Public IP Prefix ID
+func (id PublicIPPrefixID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/publicIPPrefixes/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParsePublicIPPrefixID parses a Public IP Prefix ID into a PublicIPPrefixID struct
+func ParsePublicIPPrefixID(input string) (*PublicIPPrefixID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Public IP Prefix ID %q: %+v", input, err)
+	}
+
+	resourceId := PublicIPPrefixID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("publicIPPrefixes"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePublicIPPrefixID validates that the specified value is a Public IP Prefix ID
+func ValidatePublicIPPrefixID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePublicIPPrefixID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Public IP Prefix ID: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceEndpointPolicyID is a parsed Service Endpoint Policy ID
+type ServiceEndpointPolicyID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewServiceEndpointPolicyID returns a new ServiceEndpointPolicyID for the specified values
+func NewServiceEndpointPolicyID(subscriptionId, resourceGroup, name string) ServiceEndpointPolicyID {
+	return ServiceEndpointPolicyID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Service Endpoint Policy ID
+func (id ServiceEndpointPolicyID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/serviceEndpointPolicies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseServiceEndpointPolicyID parses a Service Endpoint Policy ID into a ServiceEndpointPolicyID struct
+func ParseServiceEndpointPolicyID(input string) (*ServiceEndpointPolicyID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Service Endpoint Policy ID %q: %+v", input, err)
+	}
+
+	resourceId := ServiceEndpointPolicyID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("serviceEndpointPolicies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceEndpointPolicyID validates that the specified value is a Service Endpoint Policy ID
+func ValidateServiceEndpointPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseServiceEndpointPolicyID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Service Endpoint Policy ID: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,82 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceEndpointPolicyDefinitionID is a parsed Service Endpoint Policy Definition ID
+type ServiceEndpointPolicyDefinitionID struct {
+	SubscriptionId            string
+	ResourceGroup             string
+	ServiceEndpointPolicyName string
+	Name                      string
+}
+
+// NewServiceEndpointPolicyDefinitionID returns a new ServiceEndpointPolicyDefinitionID for the specified values
+func NewServiceEndpointPolicyDefinitionID(subscriptionId, resourceGroup, serviceEndpointPolicyName, name string) ServiceEndpointPolicyDefinitionID {
+	return ServiceEndpointPolicyDefinitionID{
+		SubscriptionId:            subscriptionId,
+		ResourceGroup:             resourceGroup,
+		ServiceEndpointPolicyName: serviceEndpointPolicyName,
+		Name:                      name,
+	}
+}
+
+// ID returns the formatted Service Endpoint Policy Definition ID
+func (id ServiceEndpointPolicyDefinitionID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/serviceEndpointPolicies/%s/serviceEndpointPolicyDefinitions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceEndpointPolicyName, id.Name)
+}
+
+// ParseServiceEndpointPolicyDefinitionID parses a Service Endpoint Policy Definition ID into a ServiceEndpointPolicyDefinitionID struct
+func ParseServiceEndpointPolicyDefinitionID(input string) (*ServiceEndpointPolicyDefinitionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Service Endpoint Policy Definition ID %q: %+v", input, err)
+	}
+
+	resourceId := ServiceEndpointPolicyDefinitionID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.ServiceEndpointPolicyName, err = id.PopSegment("serviceEndpointPolicies"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("serviceEndpointPolicyDefinitions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceEndpointPolicyDefinitionID validates that the specified value is a Service Endpoint Policy Definition ID
+func ValidateServiceEndpointPolicyDefinitionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseServiceEndpointPolicyDefinitionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Service Endpoint Policy Definition ID: %+v", k, err))
+	}
+
+	return
+}
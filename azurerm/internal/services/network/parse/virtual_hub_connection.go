@@ -0,0 +1,82 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualHubConnectionID is a parsed Virtual Hub Connection ID
+type VirtualHubConnectionID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	VirtualHubName string
+	Name           string
+}
+
+// NewVirtualHubConnectionID returns a new VirtualHubConnectionID for the specified values
+func NewVirtualHubConnectionID(subscriptionId, resourceGroup, virtualHubName, name string) VirtualHubConnectionID {
+	return VirtualHubConnectionID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		VirtualHubName: virtualHubName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Virtual Hub Connection ID
+func (id VirtualHubConnectionID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualHubs/%s/hubVirtualNetworkConnections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.Name)
+}
+
+// ParseVirtualHubConnectionID parses a Virtual Hub Connection ID into a VirtualHubConnectionID struct
+func ParseVirtualHubConnectionID(input string) (*VirtualHubConnectionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Hub Connection ID %q: %+v", input, err)
+	}
+
+	resourceId := VirtualHubConnectionID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("hubVirtualNetworkConnections"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualHubConnectionID validates that the specified value is a Virtual Hub Connection ID
+func ValidateVirtualHubConnectionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVirtualHubConnectionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Virtual Hub Connection ID: %+v", k, err))
+	}
+
+	return
+}
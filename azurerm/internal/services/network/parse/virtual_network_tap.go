@@ -0,0 +1,76 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualNetworkTapID is a parsed Virtual Network Tap ID
+type VirtualNetworkTapID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualNetworkTapID returns a new VirtualNetworkTapID for the specified values
+func NewVirtualNetworkTapID(subscriptionId, resourceGroup, name string) VirtualNetworkTapID {
+	return VirtualNetworkTapID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Virtual Network Tap ID
+func (id VirtualNetworkTapID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworkTaps/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualNetworkTapID parses a Virtual Network Tap ID into a VirtualNetworkTapID struct
+func ParseVirtualNetworkTapID(input string) (*VirtualNetworkTapID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network Tap ID %q: %+v", input, err)
+	}
+
+	resourceId := VirtualNetworkTapID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, "Microsoft.Network"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualNetworkTaps"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualNetworkTapID validates that the specified value is a Virtual Network Tap ID
+func ValidateVirtualNetworkTapID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVirtualNetworkTapID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Virtual Network Tap ID: %+v", k, err))
+	}
+
+	return
+}
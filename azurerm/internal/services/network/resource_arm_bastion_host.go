@@ -0,0 +1,364 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var bastionHostResourceName = "azurerm_bastion_host"
+
+func resourceArmBastionHost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBastionHostCreateUpdate,
+		Read:   resourceArmBastionHostRead,
+		Update: resourceArmBastionHostCreateUpdate,
+		Delete: resourceArmBastionHostDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseBastionHostID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMBastionHostName,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"ip_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"subnet_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAzureRMBastionHostSubnetName,
+						},
+
+						"public_ip_address_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: parse.ValidatePublicIPAddressID,
+						},
+					},
+				},
+			},
+
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmBastionHostCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.BastionHostsClient
+	publicIPsClient := meta.(*clients.Client).Network.PublicIPsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_bastion_host", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	ipConfigs := d.Get("ip_configuration").([]interface{})
+	ipConfig := ipConfigs[0].(map[string]interface{})
+
+	subnet, err := parse.ParseSubnetID(ipConfig["subnet_id"].(string))
+	if err != nil {
+		return err
+	}
+
+	publicIp, err := parse.ParsePublicIPAddressID(ipConfig["public_ip_address_id"].(string))
+	if err != nil {
+		return err
+	}
+
+	// the Public IP must be a Standard SKU with a Static allocation, which can't be validated at plan time
+	if d.IsNewResource() {
+		if err := validateAzureRMBastionHostPublicIP(ctx, publicIPsClient, *publicIp); err != nil {
+			return err
+		}
+	}
+
+	locks.ByName(name, bastionHostResourceName)
+	defer locks.UnlockByName(name, bastionHostResourceName)
+
+	locks.ByName(subnet.VirtualNetworkName, VirtualNetworkResourceName)
+	defer locks.UnlockByName(subnet.VirtualNetworkName, VirtualNetworkResourceName)
+
+	locks.ByName(subnet.Name, SubnetResourceName)
+	defer locks.UnlockByName(subnet.Name, SubnetResourceName)
+
+	locks.ByName(publicIp.Name, publicIpResourceName)
+	defer locks.UnlockByName(publicIp.Name, publicIpResourceName)
+
+	parameters := network.BastionHost{
+		Location: utils.String(location),
+		BastionHostPropertiesFormat: &network.BastionHostPropertiesFormat{
+			IPConfigurations: expandArmBastionHostIPConfiguration(ipConfigs),
+		},
+		Tags: tags.Expand(t),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for Bastion Host %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmBastionHostRead(d, meta)
+}
+
+func resourceArmBastionHostRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.BastionHostsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseBastionHostID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Bastion Host %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Bastion Host %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.BastionHostPropertiesFormat; props != nil {
+		d.Set("dns_name", props.DNSName)
+
+		if err := d.Set("ip_configuration", flattenArmBastionHostIPConfiguration(props.IPConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `ip_configuration`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmBastionHostDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.BastionHostsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseBastionHostID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, bastionHostResourceName)
+	defer locks.UnlockByName(id.Name, bastionHostResourceName)
+
+	if ipConfigs := d.Get("ip_configuration").([]interface{}); len(ipConfigs) > 0 && ipConfigs[0] != nil {
+		ipConfig := ipConfigs[0].(map[string]interface{})
+
+		subnet, err := parse.ParseSubnetID(ipConfig["subnet_id"].(string))
+		if err != nil {
+			return err
+		}
+
+		locks.ByName(subnet.VirtualNetworkName, VirtualNetworkResourceName)
+		defer locks.UnlockByName(subnet.VirtualNetworkName, VirtualNetworkResourceName)
+
+		locks.ByName(subnet.Name, SubnetResourceName)
+		defer locks.UnlockByName(subnet.Name, SubnetResourceName)
+
+		publicIp, err := parse.ParsePublicIPAddressID(ipConfig["public_ip_address_id"].(string))
+		if err != nil {
+			return err
+		}
+
+		locks.ByName(publicIp.Name, publicIpResourceName)
+		defer locks.UnlockByName(publicIp.Name, publicIpResourceName)
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Bastion Host %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Bastion Host %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmBastionHostIPConfiguration(input []interface{}) *[]network.BastionHostIPConfiguration {
+	results := make([]network.BastionHostIPConfiguration, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, network.BastionHostIPConfiguration{
+			Name: utils.String(v["name"].(string)),
+			BastionHostIPConfigurationPropertiesFormat: &network.BastionHostIPConfigurationPropertiesFormat{
+				Subnet: &network.SubResource{
+					ID: utils.String(v["subnet_id"].(string)),
+				},
+				PublicIPAddress: &network.SubResource{
+					ID: utils.String(v["public_ip_address_id"].(string)),
+				},
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenArmBastionHostIPConfiguration(input *[]network.BastionHostIPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		subnetId := ""
+		publicIpAddressId := ""
+		if props := item.BastionHostIPConfigurationPropertiesFormat; props != nil {
+			if props.Subnet != nil && props.Subnet.ID != nil {
+				subnetId = *props.Subnet.ID
+			}
+			if props.PublicIPAddress != nil && props.PublicIPAddress.ID != nil {
+				publicIpAddressId = *props.PublicIPAddress.ID
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                 name,
+			"subnet_id":            subnetId,
+			"public_ip_address_id": publicIpAddressId,
+		})
+	}
+
+	return results
+}
+
+func validateAzureRMBastionHostPublicIP(ctx context.Context, client *network.PublicIPAddressesClient, id parse.PublicIPAddressID) error {
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Public IP %q (Resource Group %q) for the Bastion Host: %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if resp.Sku == nil || resp.Sku.Name != network.PublicIPAddressSkuNameStandard {
+		return fmt.Errorf("The Public IP %q (Resource Group %q) used for a Bastion Host must use the `Standard` SKU", id.Name, id.ResourceGroup)
+	}
+
+	if props := resp.PublicIPAddressPropertiesFormat; props == nil || props.PublicIPAllocationMethod != network.Static {
+		return fmt.Errorf("The Public IP %q (Resource Group %q) used for a Bastion Host must use a `Static` allocation method", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func validateAzureRMBastionHostName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// The name must begin with a letter or number, end with a letter, number or underscore, and may contain only letters, numbers, underscores, periods, or hyphens.
+	if !regexp.MustCompile(`^[0-9a-zA-Z]([0-9a-zA-Z._-]{0,78}[0-9a-zA-Z_])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 80 characters, begin with a letter or number, end with a letter, number or underscore, and may contain only letters, numbers, underscores, periods, or hyphens", k))
+	}
+
+	return warnings, errors
+}
+
+func validateAzureRMBastionHostSubnetName(v interface{}, k string) (warnings []string, errors []error) {
+	id, err := parse.ParseSubnetID(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Subnet ID: %+v", k, err))
+		return warnings, errors
+	}
+
+	if id.Name != "AzureBastionSubnet" {
+		errors = append(errors, fmt.Errorf("The name of the Subnet for %q must be exactly 'AzureBastionSubnet' to be used for the Bastion Host resource", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,78 @@
+package network
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateAzureRMBastionHostName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"abc123",
+		"a_b_c",
+		"hy-ph-en",
+		"valid_",
+		"v-a_l1.d_",
+		strings.Repeat("w", 80),
+	}
+	for _, v := range validNames {
+		_, errors := validateAzureRMBastionHostName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Bastion Host Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"_invalid",
+		"-invalid",
+		".invalid",
+		"hel!!o",
+		"invalid.",
+		"invalid-",
+		strings.Repeat("w", 81),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateAzureRMBastionHostName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Bastion Host Name", v)
+		}
+	}
+}
+
+func TestValidateAzureRMBastionHostSubnetName(t *testing.T) {
+	testData := []struct {
+		input string
+		valid bool
+	}{
+		{
+			input: "",
+			valid: false,
+		},
+		{
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			valid: false,
+		},
+		{
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/internal",
+			valid: false,
+		},
+		{
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/azurebastionsubnet",
+			valid: false,
+		},
+		{
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/AzureBastionSubnet",
+			valid: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := validateAzureRMBastionHostSubnetName(v.input, "subnet_id")
+		if valid := len(errors) == 0; valid != v.valid {
+			t.Fatalf("Expected %t but got %t for %q", v.valid, valid, v.input)
+		}
+	}
+}
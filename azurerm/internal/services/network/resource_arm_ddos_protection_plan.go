@@ -1,4 +1,4 @@
-package azurerm
+package network
 
 import (
 	"fmt"
@@ -8,9 +8,10 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -58,15 +59,16 @@ As such the existing 'azurerm_ddos_protection_plan' resource is deprecated and w
 }
 
 func resourceArmDDoSProtectionPlanCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.DDOSProtectionPlansClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Network.DDOSProtectionPlansClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DDoS protection plan creation")
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -90,8 +92,8 @@ func resourceArmDDoSProtectionPlanCreateUpdate(d *schema.ResourceData, meta inte
 	locks.ByName(name, azureDDoSProtectionPlanResourceName)
 	defer locks.UnlockByName(name, azureDDoSProtectionPlanResourceName)
 
-	locks.MultipleByName(vnetsToLock, virtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, virtualNetworkResourceName)
+	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
 	parameters := network.DdosProtectionPlan{
 		Location: &location,
@@ -122,8 +124,9 @@ func resourceArmDDoSProtectionPlanCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceArmDDoSProtectionPlanRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.DDOSProtectionPlansClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Network.DDOSProtectionPlansClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -160,8 +163,9 @@ func resourceArmDDoSProtectionPlanRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmDDoSProtectionPlanDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.DDOSProtectionPlansClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Network.DDOSProtectionPlansClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -189,8 +193,8 @@ func resourceArmDDoSProtectionPlanDelete(d *schema.ResourceData, meta interface{
 	locks.ByName(name, azureDDoSProtectionPlanResourceName)
 	defer locks.UnlockByName(name, azureDDoSProtectionPlanResourceName)
 
-	locks.MultipleByName(vnetsToLock, virtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, virtualNetworkResourceName)
+	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -216,7 +220,7 @@ func extractVnetNames(d *schema.ResourceData) (*[]string, error) {
 
 		vnetName := vnetResourceID.Path["virtualNetworks"]
 
-		if !sliceContainsValue(vnetNames, vnetName) {
+		if !utils.SliceContainsValue(vnetNames, vnetName) {
 			vnetNames = append(vnetNames, vnetName)
 		}
 	}
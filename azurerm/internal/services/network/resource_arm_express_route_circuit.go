@@ -1,4 +1,4 @@
-package azurerm
+package network
 
 import (
 	"fmt"
@@ -10,9 +10,11 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -41,21 +43,38 @@ func resourceArmExpressRouteCircuit() *schema.Resource {
 
 			"service_provider_name": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				ForceNew:         true,
 				DiffSuppressFunc: suppress.CaseDifference,
+				ConflictsWith:    []string{"express_route_port_id", "bandwidth_in_gbps"},
 			},
 
 			"peering_location": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				ForceNew:         true,
 				DiffSuppressFunc: suppress.CaseDifference,
+				ConflictsWith:    []string{"express_route_port_id", "bandwidth_in_gbps"},
 			},
 
 			"bandwidth_in_mbps": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"express_route_port_id", "bandwidth_in_gbps"},
+			},
+
+			"express_route_port_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  parse.ValidateExpressRoutePortID,
+				ConflictsWith: []string{"service_provider_name", "peering_location", "bandwidth_in_mbps"},
+			},
+
+			"bandwidth_in_gbps": {
+				Type:          schema.TypeFloat,
+				Optional:      true,
+				ConflictsWith: []string{"service_provider_name", "peering_location", "bandwidth_in_mbps"},
 			},
 
 			"sku": {
@@ -110,8 +129,9 @@ func resourceArmExpressRouteCircuit() *schema.Resource {
 }
 
 func resourceArmExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.ExpressRouteCircuitsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Network.ExpressRouteCircuitsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM ExpressRoute Circuit creation.")
 
@@ -121,7 +141,7 @@ func resourceArmExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta int
 	locks.ByName(name, expressRouteCircuitResourceName)
 	defer locks.UnlockByName(name, expressRouteCircuitResourceName)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -138,6 +158,15 @@ func resourceArmExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta int
 	serviceProviderName := d.Get("service_provider_name").(string)
 	peeringLocation := d.Get("peering_location").(string)
 	bandwidthInMbps := int32(d.Get("bandwidth_in_mbps").(int))
+	expressRoutePortId := d.Get("express_route_port_id").(string)
+	bandwidthInGbps := d.Get("bandwidth_in_gbps").(float64)
+	if expressRoutePortId != "" {
+		if bandwidthInGbps == 0 {
+			return fmt.Errorf("`bandwidth_in_gbps` must be specified when `express_route_port_id` is set")
+		}
+	} else if serviceProviderName == "" || peeringLocation == "" || bandwidthInMbps == 0 {
+		return fmt.Errorf("`service_provider_name`, `peering_location` and `bandwidth_in_mbps` must be specified when `express_route_port_id` isn't set")
+	}
 	sku := expandExpressRouteCircuitSku(d)
 	allowRdfeOps := d.Get("allow_classic_operations").(bool)
 	t := d.Get("tags").(map[string]interface{})
@@ -179,6 +208,17 @@ func resourceArmExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta int
 			erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties.PeeringLocation = &peeringLocation
 			erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties.BandwidthInMbps = &bandwidthInMbps
 		}
+		if expressRoutePortId != "" {
+			erc.ExpressRouteCircuitPropertiesFormat.BandwidthInGbps = &bandwidthInGbps
+		}
+	} else if expressRoutePortId != "" {
+		erc.ExpressRouteCircuitPropertiesFormat = &network.ExpressRouteCircuitPropertiesFormat{
+			AllowClassicOperations: &allowRdfeOps,
+			ExpressRoutePort: &network.SubResource{
+				ID: utils.String(expressRoutePortId),
+			},
+			BandwidthInGbps: &bandwidthInGbps,
+		}
 	} else {
 		erc.ExpressRouteCircuitPropertiesFormat = &network.ExpressRouteCircuitPropertiesFormat{
 			AllowClassicOperations: &allowRdfeOps,
@@ -213,8 +253,9 @@ func resourceArmExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceArmExpressRouteCircuitRead(d *schema.ResourceData, meta interface{}) error {
-	ercClient := meta.(*ArmClient).network.ExpressRouteCircuitsClient
-	ctx := meta.(*ArmClient).StopContext
+	ercClient := meta.(*clients.Client).Network.ExpressRouteCircuitsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -254,6 +295,13 @@ func resourceArmExpressRouteCircuitRead(d *schema.ResourceData, meta interface{}
 		d.Set("bandwidth_in_mbps", props.BandwidthInMbps)
 	}
 
+	expressRoutePortId := ""
+	if resp.ExpressRoutePort != nil && resp.ExpressRoutePort.ID != nil {
+		expressRoutePortId = *resp.ExpressRoutePort.ID
+	}
+	d.Set("express_route_port_id", expressRoutePortId)
+	d.Set("bandwidth_in_gbps", resp.BandwidthInGbps)
+
 	d.Set("service_provider_provisioning_state", string(resp.ServiceProviderProvisioningState))
 	d.Set("service_key", resp.ServiceKey)
 	d.Set("allow_classic_operations", resp.AllowClassicOperations)
@@ -262,8 +310,9 @@ func resourceArmExpressRouteCircuitRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceArmExpressRouteCircuitDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.ExpressRouteCircuitsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Network.ExpressRouteCircuitsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
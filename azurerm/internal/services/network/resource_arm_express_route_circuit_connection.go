@@ -0,0 +1,214 @@
+package network
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmExpressRouteCircuitConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmExpressRouteCircuitConnectionCreate,
+		Read:   resourceArmExpressRouteCircuitConnectionRead,
+		Delete: resourceArmExpressRouteCircuitConnectionDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseExpressRouteCircuitConnectionID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"peering_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateExpressRouteCircuitPeeringID,
+			},
+
+			"peer_peering_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateExpressRouteCircuitPeeringID,
+			},
+
+			"address_prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CIDR,
+			},
+
+			"authorization_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"circuit_connection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmExpressRouteCircuitConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRouteCircuitConnectionsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	peeringId := d.Get("peering_id").(string)
+	peering, err := parse.ParseExpressRouteCircuitPeeringID(peeringId)
+	if err != nil {
+		return err
+	}
+
+	if meta.(*clients.Client).Features.Resources.RequireImport {
+		existing, err := client.Get(ctx, peering.ResourceGroup, peering.ExpressRouteCircuitName, peering.Name, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Connection %q (ExpressRoute Circuit %q / Peering %q / Resource Group %q): %+v", name, peering.ExpressRouteCircuitName, peering.Name, peering.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_express_route_circuit_connection", *existing.ID)
+		}
+	}
+
+	locks.ByName(peering.ExpressRouteCircuitName, expressRouteCircuitResourceName)
+	defer locks.UnlockByName(peering.ExpressRouteCircuitName, expressRouteCircuitResourceName)
+
+	parameters := network.ExpressRouteCircuitConnection{
+		Name: utils.String(name),
+		ExpressRouteCircuitConnectionPropertiesFormat: &network.ExpressRouteCircuitConnectionPropertiesFormat{
+			ExpressRouteCircuitPeering: &network.SubResource{
+				ID: utils.String(peeringId),
+			},
+			PeerExpressRouteCircuitPeering: &network.SubResource{
+				ID: utils.String(d.Get("peer_peering_id").(string)),
+			},
+			AddressPrefix: utils.String(d.Get("address_prefix").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("authorization_key"); ok {
+		parameters.ExpressRouteCircuitConnectionPropertiesFormat.AuthorizationKey = utils.String(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, peering.ResourceGroup, peering.ExpressRouteCircuitName, peering.Name, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating Connection %q (ExpressRoute Circuit %q / Peering %q / Resource Group %q): %+v", name, peering.ExpressRouteCircuitName, peering.Name, peering.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Connection %q (ExpressRoute Circuit %q / Peering %q / Resource Group %q): %+v", name, peering.ExpressRouteCircuitName, peering.Name, peering.ResourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, peering.ResourceGroup, peering.ExpressRouteCircuitName, peering.Name, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Connection %q (ExpressRoute Circuit %q / Peering %q / Resource Group %q): %+v", name, peering.ExpressRouteCircuitName, peering.Name, peering.ResourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for Connection %q (ExpressRoute Circuit %q / Peering %q / Resource Group %q)", name, peering.ExpressRouteCircuitName, peering.Name, peering.ResourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmExpressRouteCircuitConnectionRead(d, meta)
+}
+
+func resourceArmExpressRouteCircuitConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRouteCircuitConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseExpressRouteCircuitConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ExpressRouteCircuitName, id.PeeringName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Connection %q was not found in ExpressRoute Circuit %q / Peering %q (Resource Group %q) - removing from state!", id.Name, id.ExpressRouteCircuitName, id.PeeringName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Connection %q (ExpressRoute Circuit %q / Peering %q / Resource Group %q): %+v", id.Name, id.ExpressRouteCircuitName, id.PeeringName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("peering_id", parse.NewExpressRouteCircuitPeeringID(id.SubscriptionId, id.ResourceGroup, id.ExpressRouteCircuitName, id.PeeringName).ID())
+
+	if props := resp.ExpressRouteCircuitConnectionPropertiesFormat; props != nil {
+		peerPeeringId := ""
+		if props.PeerExpressRouteCircuitPeering != nil && props.PeerExpressRouteCircuitPeering.ID != nil {
+			peerPeeringId = *props.PeerExpressRouteCircuitPeering.ID
+		}
+		d.Set("peer_peering_id", peerPeeringId)
+		d.Set("address_prefix", props.AddressPrefix)
+		d.Set("circuit_connection_status", string(props.CircuitConnectionStatus))
+
+		// the API doesn't always return the Authorization Key, so we only update it when it's present
+		if props.AuthorizationKey != nil && *props.AuthorizationKey != "" {
+			d.Set("authorization_key", props.AuthorizationKey)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmExpressRouteCircuitConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRouteCircuitConnectionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseExpressRouteCircuitConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
+	defer locks.UnlockByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ExpressRouteCircuitName, id.PeeringName, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Connection %q (ExpressRoute Circuit %q / Peering %q / Resource Group %q): %+v", id.Name, id.ExpressRouteCircuitName, id.PeeringName, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Connection %q (ExpressRoute Circuit %q / Peering %q / Resource Group %q): %+v", id.Name, id.ExpressRouteCircuitName, id.PeeringName, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,391 @@
+package network
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmExpressRoutePort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmExpressRoutePortCreateUpdate,
+		Read:   resourceArmExpressRoutePortRead,
+		Update: resourceArmExpressRoutePortCreateUpdate,
+		Delete: resourceArmExpressRoutePortDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseExpressRoutePortID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"peering_location": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validate.NoEmptyStrings,
+			},
+
+			"bandwidth_in_gbps": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice([]int{10, 100}),
+			},
+
+			"encapsulation": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Dot1Q),
+					string(network.QinQ),
+				}, false),
+			},
+
+			"link1": expressRoutePortLinkSchema(),
+
+			"link2": expressRoutePortLinkSchema(),
+
+			"ethertype": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"mtu": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func expressRoutePortLinkSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"admin_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"router_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"interface_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"patch_panel_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"rack_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"connector_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmExpressRoutePortCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRoutePortsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing ExpressRoute Port %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_express_route_port", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := network.ExpressRoutePort{
+		Location: utils.String(location),
+		ExpressRoutePortPropertiesFormat: &network.ExpressRoutePortPropertiesFormat{
+			PeeringLocation: utils.String(d.Get("peering_location").(string)),
+			BandwidthInGbps: utils.Int32(int32(d.Get("bandwidth_in_gbps").(int))),
+			Encapsulation:   network.ExpressRoutePortsEncapsulation(d.Get("encapsulation").(string)),
+		},
+		Tags: tags.Expand(t),
+	}
+
+	// the Links are created by Azure along with the Port, as such they can only be configured once they exist
+	if !d.IsNewResource() {
+		parameters.ExpressRoutePortPropertiesFormat.Links = expandArmExpressRoutePortLinks(d.Get("link1").([]interface{}), d.Get("link2").([]interface{}))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating ExpressRoute Port %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of ExpressRoute Port %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving ExpressRoute Port %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for ExpressRoute Port %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	// if the Links have been configured at creation time we need to update the Port now that they exist
+	if d.IsNewResource() && (expressRoutePortLinkAdminEnabled(d.Get("link1").([]interface{})) || expressRoutePortLinkAdminEnabled(d.Get("link2").([]interface{}))) {
+		parameters.ExpressRoutePortPropertiesFormat.Links = expandArmExpressRoutePortLinks(d.Get("link1").([]interface{}), d.Get("link2").([]interface{}))
+
+		future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+		if err != nil {
+			return fmt.Errorf("Error updating the Links for ExpressRoute Port %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the Links for ExpressRoute Port %q (Resource Group %q) to be updated: %+v", name, resourceGroup, err)
+		}
+	}
+
+	return resourceArmExpressRoutePortRead(d, meta)
+}
+
+func resourceArmExpressRoutePortRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRoutePortsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseExpressRoutePortID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] ExpressRoute Port %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving ExpressRoute Port %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.ExpressRoutePortPropertiesFormat; props != nil {
+		d.Set("peering_location", props.PeeringLocation)
+		d.Set("bandwidth_in_gbps", props.BandwidthInGbps)
+		d.Set("encapsulation", string(props.Encapsulation))
+		d.Set("ethertype", props.EtherType)
+		d.Set("mtu", props.Mtu)
+		d.Set("guid", props.ResourceGUID)
+
+		link1, link2 := flattenArmExpressRoutePortLinks(props.Links)
+		if err := d.Set("link1", link1); err != nil {
+			return fmt.Errorf("Error setting `link1`: %+v", err)
+		}
+		if err := d.Set("link2", link2); err != nil {
+			return fmt.Errorf("Error setting `link2`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmExpressRoutePortDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRoutePortsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseExpressRoutePortID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting ExpressRoute Port %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of ExpressRoute Port %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expressRoutePortLinkAdminEnabled(input []interface{}) bool {
+	if len(input) == 0 || input[0] == nil {
+		return false
+	}
+
+	v := input[0].(map[string]interface{})
+	return v["admin_enabled"].(bool)
+}
+
+func expandArmExpressRoutePortLinks(link1 []interface{}, link2 []interface{}) *[]network.ExpressRouteLink {
+	results := make([]network.ExpressRouteLink, 0)
+
+	links := []struct {
+		name  string
+		input []interface{}
+	}{
+		{name: "link1", input: link1},
+		{name: "link2", input: link2},
+	}
+
+	for _, link := range links {
+		adminState := network.ExpressRouteLinkAdminStateDisabled
+		if expressRoutePortLinkAdminEnabled(link.input) {
+			adminState = network.ExpressRouteLinkAdminStateEnabled
+		}
+
+		results = append(results, network.ExpressRouteLink{
+			Name: utils.String(link.name),
+			ExpressRouteLinkPropertiesFormat: &network.ExpressRouteLinkPropertiesFormat{
+				AdminState: adminState,
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenArmExpressRoutePortLinks(input *[]network.ExpressRouteLink) ([]interface{}, []interface{}) {
+	link1 := make([]interface{}, 0)
+	link2 := make([]interface{}, 0)
+	if input == nil {
+		return link1, link2
+	}
+
+	for _, item := range *input {
+		if item.Name == nil {
+			continue
+		}
+
+		id := ""
+		if item.ID != nil {
+			id = *item.ID
+		}
+
+		adminEnabled := false
+		routerName := ""
+		interfaceName := ""
+		patchPanelId := ""
+		rackId := ""
+		connectorType := ""
+		if props := item.ExpressRouteLinkPropertiesFormat; props != nil {
+			adminEnabled = props.AdminState == network.ExpressRouteLinkAdminStateEnabled
+			connectorType = string(props.ConnectorType)
+
+			if props.RouterName != nil {
+				routerName = *props.RouterName
+			}
+			if props.InterfaceName != nil {
+				interfaceName = *props.InterfaceName
+			}
+			if props.PatchPanelID != nil {
+				patchPanelId = *props.PatchPanelID
+			}
+			if props.RackID != nil {
+				rackId = *props.RackID
+			}
+		}
+
+		link := []interface{}{
+			map[string]interface{}{
+				"admin_enabled":  adminEnabled,
+				"id":             id,
+				"router_name":    routerName,
+				"interface_name": interfaceName,
+				"patch_panel_id": patchPanelId,
+				"rack_id":        rackId,
+				"connector_type": connectorType,
+			},
+		}
+
+		switch *item.Name {
+		case "link1":
+			link1 = link
+		case "link2":
+			link2 = link
+		}
+	}
+
+	return link1, link2
+}
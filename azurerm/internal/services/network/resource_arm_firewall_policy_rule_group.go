@@ -0,0 +1,669 @@
+package network
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmFirewallPolicyRuleGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmFirewallPolicyRuleGroupCreateUpdate,
+		Read:   resourceArmFirewallPolicyRuleGroupRead,
+		Update: resourceArmFirewallPolicyRuleGroupCreateUpdate,
+		Delete: resourceArmFirewallPolicyRuleGroupDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseFirewallPolicyRuleGroupID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"firewall_policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateFirewallPolicyID,
+			},
+
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 65000),
+			},
+
+			"filter_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(100, 65000),
+						},
+
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.FirewallPolicyFilterRuleActionTypeAllow),
+								string(network.FirewallPolicyFilterRuleActionTypeDeny),
+							}, false),
+						},
+
+						"application_condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"description": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"source_addresses": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+
+									"destination_addresses": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+
+									"fqdn_tags": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+
+									"target_fqdns": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+
+									"protocol": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(network.FirewallPolicyRuleConditionApplicationProtocolTypeHTTP),
+														string(network.FirewallPolicyRuleConditionApplicationProtocolTypeHTTPS),
+													}, false),
+												},
+
+												"port": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 64000),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"network_condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: firewallPolicyRuleGroupNetworkConditionSchema(),
+							},
+						},
+					},
+				},
+			},
+
+			"nat_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(100, 65000),
+						},
+
+						"translated_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"translated_port": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"network_condition": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: firewallPolicyRuleGroupNetworkConditionSchema(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func firewallPolicyRuleGroupNetworkConditionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"protocols": {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.FirewallPolicyRuleConditionNetworkProtocolAny),
+					string(network.FirewallPolicyRuleConditionNetworkProtocolICMP),
+					string(network.FirewallPolicyRuleConditionNetworkProtocolTCP),
+					string(network.FirewallPolicyRuleConditionNetworkProtocolUDP),
+				}, false),
+			},
+		},
+
+		"source_addresses": {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+		},
+
+		"destination_addresses": {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+		},
+
+		"destination_ports": {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func resourceArmFirewallPolicyRuleGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.FirewallPolicyRuleGroupsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	policy, err := parse.ParseFirewallPolicyID(d.Get("firewall_policy_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, policy.ResourceGroup, policy.Name, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Rule Group %q (Firewall Policy %q / Resource Group %q): %+v", name, policy.Name, policy.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_firewall_policy_rule_group", *existing.ID)
+		}
+	}
+
+	locks.ByName(policy.Name, firewallPolicyResourceName)
+	defer locks.UnlockByName(policy.Name, firewallPolicyResourceName)
+
+	rules := make([]network.BasicFirewallPolicyRule, 0)
+	rules = append(rules, expandArmFirewallPolicyRuleGroupFilterRules(d.Get("filter_rule").([]interface{}))...)
+	rules = append(rules, expandArmFirewallPolicyRuleGroupNatRules(d.Get("nat_rule").([]interface{}))...)
+
+	parameters := network.FirewallPolicyRuleGroup{
+		FirewallPolicyRuleGroupProperties: &network.FirewallPolicyRuleGroupProperties{
+			Priority: utils.Int32(int32(d.Get("priority").(int))),
+			Rules:    &rules,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, policy.ResourceGroup, policy.Name, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Rule Group %q (Firewall Policy %q / Resource Group %q): %+v", name, policy.Name, policy.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Rule Group %q (Firewall Policy %q / Resource Group %q): %+v", name, policy.Name, policy.ResourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, policy.ResourceGroup, policy.Name, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Rule Group %q (Firewall Policy %q / Resource Group %q): %+v", name, policy.Name, policy.ResourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for Rule Group %q (Firewall Policy %q / Resource Group %q)", name, policy.Name, policy.ResourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmFirewallPolicyRuleGroupRead(d, meta)
+}
+
+func resourceArmFirewallPolicyRuleGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.FirewallPolicyRuleGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseFirewallPolicyRuleGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Rule Group %q was not found in Firewall Policy %q (Resource Group %q) - removing from state!", id.Name, id.FirewallPolicyName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Rule Group %q (Firewall Policy %q / Resource Group %q): %+v", id.Name, id.FirewallPolicyName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("firewall_policy_id", parse.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName).ID())
+
+	if props := resp.FirewallPolicyRuleGroupProperties; props != nil {
+		priority := 0
+		if props.Priority != nil {
+			priority = int(*props.Priority)
+		}
+		d.Set("priority", priority)
+
+		filterRules, natRules := flattenArmFirewallPolicyRuleGroupRules(props.Rules)
+		if err := d.Set("filter_rule", filterRules); err != nil {
+			return fmt.Errorf("Error setting `filter_rule`: %+v", err)
+		}
+
+		if err := d.Set("nat_rule", natRules); err != nil {
+			return fmt.Errorf("Error setting `nat_rule`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmFirewallPolicyRuleGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.FirewallPolicyRuleGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseFirewallPolicyRuleGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.FirewallPolicyName, firewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, firewallPolicyResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.FirewallPolicyName, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Rule Group %q (Firewall Policy %q / Resource Group %q): %+v", id.Name, id.FirewallPolicyName, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Rule Group %q (Firewall Policy %q / Resource Group %q): %+v", id.Name, id.FirewallPolicyName, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmFirewallPolicyRuleGroupFilterRules(input []interface{}) []network.BasicFirewallPolicyRule {
+	results := make([]network.BasicFirewallPolicyRule, 0)
+
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		rule := v.(map[string]interface{})
+
+		conditions := make([]network.BasicFirewallPolicyRuleCondition, 0)
+		for _, c := range rule["application_condition"].([]interface{}) {
+			if c == nil {
+				continue
+			}
+
+			condition := c.(map[string]interface{})
+			conditions = append(conditions, network.ApplicationRuleCondition{
+				Name:                 utils.String(condition["name"].(string)),
+				Description:          utils.String(condition["description"].(string)),
+				SourceAddresses:      utils.ExpandStringSlice(condition["source_addresses"].(*schema.Set).List()),
+				DestinationAddresses: utils.ExpandStringSlice(condition["destination_addresses"].(*schema.Set).List()),
+				FqdnTags:             utils.ExpandStringSlice(condition["fqdn_tags"].(*schema.Set).List()),
+				TargetFqdns:          utils.ExpandStringSlice(condition["target_fqdns"].(*schema.Set).List()),
+				Protocols:            expandArmFirewallPolicyRuleGroupApplicationProtocols(condition["protocol"].([]interface{})),
+			})
+		}
+
+		for _, c := range rule["network_condition"].([]interface{}) {
+			if c == nil {
+				continue
+			}
+
+			conditions = append(conditions, expandArmFirewallPolicyRuleGroupNetworkCondition(c.(map[string]interface{})))
+		}
+
+		results = append(results, network.FirewallPolicyFilterRule{
+			Name:     utils.String(rule["name"].(string)),
+			Priority: utils.Int32(int32(rule["priority"].(int))),
+			Action: &network.FirewallPolicyFilterRuleAction{
+				Type: network.FirewallPolicyFilterRuleActionType(rule["action"].(string)),
+			},
+			RuleConditions: &conditions,
+		})
+	}
+
+	return results
+}
+
+func expandArmFirewallPolicyRuleGroupNatRules(input []interface{}) []network.BasicFirewallPolicyRule {
+	results := make([]network.BasicFirewallPolicyRule, 0)
+
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		rule := v.(map[string]interface{})
+		natRule := network.FirewallPolicyNatRule{
+			Name:     utils.String(rule["name"].(string)),
+			Priority: utils.Int32(int32(rule["priority"].(int))),
+			Action: &network.FirewallPolicyNatRuleAction{
+				Type: network.DNAT,
+			},
+			TranslatedAddress: utils.String(rule["translated_address"].(string)),
+			TranslatedPort:    utils.String(rule["translated_port"].(string)),
+		}
+
+		if conditions := rule["network_condition"].([]interface{}); len(conditions) > 0 && conditions[0] != nil {
+			natRule.RuleCondition = expandArmFirewallPolicyRuleGroupNetworkCondition(conditions[0].(map[string]interface{}))
+		}
+
+		results = append(results, natRule)
+	}
+
+	return results
+}
+
+func expandArmFirewallPolicyRuleGroupNetworkCondition(input map[string]interface{}) network.RuleCondition {
+	protocols := make([]network.FirewallPolicyRuleConditionNetworkProtocol, 0)
+	for _, v := range input["protocols"].(*schema.Set).List() {
+		protocols = append(protocols, network.FirewallPolicyRuleConditionNetworkProtocol(v.(string)))
+	}
+
+	return network.RuleCondition{
+		Name:                 utils.String(input["name"].(string)),
+		Description:          utils.String(input["description"].(string)),
+		IPProtocols:          &protocols,
+		SourceAddresses:      utils.ExpandStringSlice(input["source_addresses"].(*schema.Set).List()),
+		DestinationAddresses: utils.ExpandStringSlice(input["destination_addresses"].(*schema.Set).List()),
+		DestinationPorts:     utils.ExpandStringSlice(input["destination_ports"].(*schema.Set).List()),
+	}
+}
+
+func expandArmFirewallPolicyRuleGroupApplicationProtocols(input []interface{}) *[]network.FirewallPolicyRuleConditionApplicationProtocol {
+	results := make([]network.FirewallPolicyRuleConditionApplicationProtocol, 0)
+
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		protocol := v.(map[string]interface{})
+		results = append(results, network.FirewallPolicyRuleConditionApplicationProtocol{
+			ProtocolType: network.FirewallPolicyRuleConditionApplicationProtocolType(protocol["type"].(string)),
+			Port:         utils.Int32(int32(protocol["port"].(int))),
+		})
+	}
+
+	return &results
+}
+
+func flattenArmFirewallPolicyRuleGroupRules(input *[]network.BasicFirewallPolicyRule) ([]interface{}, []interface{}) {
+	filterRules := make([]interface{}, 0)
+	natRules := make([]interface{}, 0)
+	if input == nil {
+		return filterRules, natRules
+	}
+
+	for _, v := range *input {
+		if rule, ok := v.AsFirewallPolicyFilterRule(); ok {
+			name := ""
+			if rule.Name != nil {
+				name = *rule.Name
+			}
+
+			priority := 0
+			if rule.Priority != nil {
+				priority = int(*rule.Priority)
+			}
+
+			action := ""
+			if rule.Action != nil {
+				action = string(rule.Action.Type)
+			}
+
+			applicationConditions := make([]interface{}, 0)
+			networkConditions := make([]interface{}, 0)
+			if rule.RuleConditions != nil {
+				for _, c := range *rule.RuleConditions {
+					if condition, ok := c.AsApplicationRuleCondition(); ok {
+						applicationConditions = append(applicationConditions, flattenArmFirewallPolicyRuleGroupApplicationCondition(condition))
+						continue
+					}
+
+					if condition, ok := c.AsRuleCondition(); ok {
+						networkConditions = append(networkConditions, flattenArmFirewallPolicyRuleGroupNetworkCondition(condition))
+					}
+				}
+			}
+
+			filterRules = append(filterRules, map[string]interface{}{
+				"name":                  name,
+				"priority":              priority,
+				"action":                action,
+				"application_condition": applicationConditions,
+				"network_condition":     networkConditions,
+			})
+			continue
+		}
+
+		if rule, ok := v.AsFirewallPolicyNatRule(); ok {
+			name := ""
+			if rule.Name != nil {
+				name = *rule.Name
+			}
+
+			priority := 0
+			if rule.Priority != nil {
+				priority = int(*rule.Priority)
+			}
+
+			translatedAddress := ""
+			if rule.TranslatedAddress != nil {
+				translatedAddress = *rule.TranslatedAddress
+			}
+
+			translatedPort := ""
+			if rule.TranslatedPort != nil {
+				translatedPort = *rule.TranslatedPort
+			}
+
+			networkConditions := make([]interface{}, 0)
+			if rule.RuleCondition != nil {
+				if condition, ok := rule.RuleCondition.AsRuleCondition(); ok {
+					networkConditions = append(networkConditions, flattenArmFirewallPolicyRuleGroupNetworkCondition(condition))
+				}
+			}
+
+			natRules = append(natRules, map[string]interface{}{
+				"name":               name,
+				"priority":           priority,
+				"translated_address": translatedAddress,
+				"translated_port":    translatedPort,
+				"network_condition":  networkConditions,
+			})
+		}
+	}
+
+	return filterRules, natRules
+}
+
+func flattenArmFirewallPolicyRuleGroupApplicationCondition(input *network.ApplicationRuleCondition) map[string]interface{} {
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	description := ""
+	if input.Description != nil {
+		description = *input.Description
+	}
+
+	protocols := make([]interface{}, 0)
+	if input.Protocols != nil {
+		for _, protocol := range *input.Protocols {
+			port := 0
+			if protocol.Port != nil {
+				port = int(*protocol.Port)
+			}
+
+			protocols = append(protocols, map[string]interface{}{
+				"type": string(protocol.ProtocolType),
+				"port": port,
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"name":                  name,
+		"description":           description,
+		"source_addresses":      utils.FlattenStringSlice(input.SourceAddresses),
+		"destination_addresses": utils.FlattenStringSlice(input.DestinationAddresses),
+		"fqdn_tags":             utils.FlattenStringSlice(input.FqdnTags),
+		"target_fqdns":          utils.FlattenStringSlice(input.TargetFqdns),
+		"protocol":              protocols,
+	}
+}
+
+func flattenArmFirewallPolicyRuleGroupNetworkCondition(input *network.RuleCondition) map[string]interface{} {
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	description := ""
+	if input.Description != nil {
+		description = *input.Description
+	}
+
+	protocols := make([]interface{}, 0)
+	if input.IPProtocols != nil {
+		for _, protocol := range *input.IPProtocols {
+			protocols = append(protocols, string(protocol))
+		}
+	}
+
+	return map[string]interface{}{
+		"name":                  name,
+		"description":           description,
+		"protocols":             protocols,
+		"source_addresses":      utils.FlattenStringSlice(input.SourceAddresses),
+		"destination_addresses": utils.FlattenStringSlice(input.DestinationAddresses),
+		"destination_ports":     utils.FlattenStringSlice(input.DestinationPorts),
+	}
+}
@@ -0,0 +1,63 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+)
+
+func TestResourceAzureRMLoadBalancerRuleNameLabel_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "-word",
+			ErrCount: 1,
+		},
+		{
+			Value:    "testing-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "test#test",
+			ErrCount: 1,
+		},
+		{
+			Value:    acctest.RandStringFromCharSet(81, "abcdedfed"),
+			ErrCount: 1,
+		},
+		{
+			Value:    "test.rule",
+			ErrCount: 0,
+		},
+		{
+			Value:    "test_rule",
+			ErrCount: 0,
+		},
+		{
+			Value:    "test-rule",
+			ErrCount: 0,
+		},
+		{
+			Value:    "TestRule",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Test123Rule",
+			ErrCount: 0,
+		},
+		{
+			Value:    "TestRule",
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmLoadBalancerRuleName(tc.Value, "azurerm_lb_rule")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Load Balancer Rule Name Label to trigger a validation error")
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package network
+
+import (
+	"testing"
+)
+
+func TestResourceAzureRMLoadBalancerPrivateIpAddressAllocation_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Static",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Dynamic",
+			ErrCount: 0,
+		},
+		{
+			Value:    "STATIC",
+			ErrCount: 0,
+		},
+		{
+			Value:    "static",
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateLoadBalancerPrivateIpAddressAllocation(tc.Value, "azurerm_lb")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Load Balancer private_ip_address_allocation to trigger a validation error")
+		}
+	}
+}
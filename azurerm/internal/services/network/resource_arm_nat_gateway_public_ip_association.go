@@ -0,0 +1,247 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmNatGatewayPublicIpAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNatGatewayPublicIpAssociationCreate,
+		Read:   resourceArmNatGatewayPublicIpAssociationRead,
+		Delete: resourceArmNatGatewayPublicIpAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nat_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateNatGatewayID,
+			},
+
+			"public_ip_address_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidatePublicIPAddressID,
+			},
+		},
+	}
+}
+
+func resourceArmNatGatewayPublicIpAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NatGatewayClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for NAT Gateway <-> Public IP Association creation.")
+
+	natGatewayId := d.Get("nat_gateway_id").(string)
+	publicIpAddressId := d.Get("public_ip_address_id").(string)
+
+	natGateway, err := parse.ParseNatGatewayID(natGatewayId)
+	if err != nil {
+		return err
+	}
+
+	publicIp, err := parse.ParsePublicIPAddressID(publicIpAddressId)
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(natGateway.Name, natGatewayResourceName)
+	defer locks.UnlockByName(natGateway.Name, natGatewayResourceName)
+
+	locks.ByName(publicIp.Name, publicIpResourceName)
+	defer locks.UnlockByName(publicIp.Name, publicIpResourceName)
+
+	read, err := client.Get(ctx, natGateway.ResourceGroup, natGateway.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			return fmt.Errorf("NAT Gateway %q (Resource Group %q) was not found!", natGateway.Name, natGateway.ResourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving NAT Gateway %q (Resource Group %q): %+v", natGateway.Name, natGateway.ResourceGroup, err)
+	}
+
+	props := read.NatGatewayPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for NAT Gateway %q (Resource Group %q)", natGateway.Name, natGateway.ResourceGroup)
+	}
+
+	publicIps := make([]network.SubResource, 0)
+
+	// first double-check it doesn't exist
+	resourceId := fmt.Sprintf("%s|%s", natGatewayId, publicIpAddressId)
+	if props.PublicIPAddresses != nil {
+		for _, existing := range *props.PublicIPAddresses {
+			if existing.ID == nil {
+				continue
+			}
+
+			if strings.EqualFold(*existing.ID, publicIpAddressId) {
+				if meta.(*clients.Client).Features.Resources.RequireImport {
+					return tf.ImportAsExistsError("azurerm_nat_gateway_public_ip_association", resourceId)
+				}
+
+				continue
+			}
+
+			publicIps = append(publicIps, existing)
+		}
+	}
+
+	publicIps = append(publicIps, network.SubResource{
+		ID: utils.String(publicIpAddressId),
+	})
+	props.PublicIPAddresses = &publicIps
+
+	future, err := client.CreateOrUpdate(ctx, natGateway.ResourceGroup, natGateway.Name, read)
+	if err != nil {
+		return fmt.Errorf("Error updating Public IP Association for NAT Gateway %q (Resource Group %q): %+v", natGateway.Name, natGateway.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Public IP Association for NAT Gateway %q (Resource Group %q): %+v", natGateway.Name, natGateway.ResourceGroup, err)
+	}
+
+	d.SetId(resourceId)
+
+	return resourceArmNatGatewayPublicIpAssociationRead(d, meta)
+}
+
+func resourceArmNatGatewayPublicIpAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NatGatewayClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	splitId := strings.Split(d.Id(), "|")
+	if len(splitId) != 2 {
+		return fmt.Errorf("Expected ID to be in the format {natGatewayId}|{publicIpAddressId} but got %q", d.Id())
+	}
+
+	natGateway, err := parse.ParseNatGatewayID(splitId[0])
+	if err != nil {
+		return err
+	}
+
+	publicIpAddressId := splitId[1]
+
+	read, err := client.Get(ctx, natGateway.ResourceGroup, natGateway.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] NAT Gateway %q (Resource Group %q) could not be found - removing from state!", natGateway.Name, natGateway.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving NAT Gateway %q (Resource Group %q): %+v", natGateway.Name, natGateway.ResourceGroup, err)
+	}
+
+	found := false
+	if props := read.NatGatewayPropertiesFormat; props != nil && props.PublicIPAddresses != nil {
+		for _, publicIp := range *props.PublicIPAddresses {
+			if publicIp.ID == nil {
+				continue
+			}
+
+			if strings.EqualFold(*publicIp.ID, publicIpAddressId) {
+				found = true
+				break
+			}
+		}
+	}
+
+	if !found {
+		log.Printf("[DEBUG] Association between NAT Gateway %q (Resource Group %q) and Public IP %q was not found - removing from state!", natGateway.Name, natGateway.ResourceGroup, publicIpAddressId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("nat_gateway_id", read.ID)
+	d.Set("public_ip_address_id", publicIpAddressId)
+
+	return nil
+}
+
+func resourceArmNatGatewayPublicIpAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NatGatewayClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	splitId := strings.Split(d.Id(), "|")
+	if len(splitId) != 2 {
+		return fmt.Errorf("Expected ID to be in the format {natGatewayId}|{publicIpAddressId} but got %q", d.Id())
+	}
+
+	natGateway, err := parse.ParseNatGatewayID(splitId[0])
+	if err != nil {
+		return err
+	}
+
+	publicIpAddressId := splitId[1]
+	publicIp, err := parse.ParsePublicIPAddressID(publicIpAddressId)
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(natGateway.Name, natGatewayResourceName)
+	defer locks.UnlockByName(natGateway.Name, natGatewayResourceName)
+
+	locks.ByName(publicIp.Name, publicIpResourceName)
+	defer locks.UnlockByName(publicIp.Name, publicIpResourceName)
+
+	read, err := client.Get(ctx, natGateway.ResourceGroup, natGateway.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving NAT Gateway %q (Resource Group %q): %+v", natGateway.Name, natGateway.ResourceGroup, err)
+	}
+
+	props := read.NatGatewayPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for NAT Gateway %q (Resource Group %q)", natGateway.Name, natGateway.ResourceGroup)
+	}
+
+	publicIps := make([]network.SubResource, 0)
+	if props.PublicIPAddresses != nil {
+		for _, existing := range *props.PublicIPAddresses {
+			if existing.ID == nil {
+				continue
+			}
+
+			if strings.EqualFold(*existing.ID, publicIpAddressId) {
+				continue
+			}
+
+			publicIps = append(publicIps, existing)
+		}
+	}
+	props.PublicIPAddresses = &publicIps
+
+	future, err := client.CreateOrUpdate(ctx, natGateway.ResourceGroup, natGateway.Name, read)
+	if err != nil {
+		return fmt.Errorf("Error removing Public IP Association for NAT Gateway %q (Resource Group %q): %+v", natGateway.Name, natGateway.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of Public IP Association for NAT Gateway %q (Resource Group %q): %+v", natGateway.Name, natGateway.ResourceGroup, err)
+	}
+
+	return nil
+}
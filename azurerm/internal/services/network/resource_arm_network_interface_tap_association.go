@@ -0,0 +1,182 @@
+package network
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmNetworkInterfaceTapAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkInterfaceTapAssociationCreate,
+		Read:   resourceArmNetworkInterfaceTapAssociationRead,
+		Delete: resourceArmNetworkInterfaceTapAssociationDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseNetworkInterfaceTapConfigurationID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateNetworkInterfaceID,
+			},
+
+			"virtual_network_tap_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateVirtualNetworkTapID,
+			},
+		},
+	}
+}
+
+func resourceArmNetworkInterfaceTapAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfaceTapConfigurationsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Network Interface <-> Virtual Network Tap Association creation.")
+
+	networkInterfaceId, err := parse.ParseNetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+
+	virtualNetworkTapId := d.Get("virtual_network_tap_id").(string)
+	tap, err := parse.ParseVirtualNetworkTapID(virtualNetworkTapId)
+	if err != nil {
+		return err
+	}
+
+	// the Tap Configuration is named after the Virtual Network Tap, since a Network Interface
+	// can only be associated with a given Virtual Network Tap once
+	name := tap.Name
+	networkInterfaceName := networkInterfaceId.Name
+	resourceGroup := networkInterfaceId.ResourceGroup
+
+	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
+	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
+
+	if meta.(*clients.Client).Features.Resources.RequireImport {
+		existing, err := client.Get(ctx, resourceGroup, networkInterfaceName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Tap Configuration %q (Network Interface %q / Resource Group %q): %+v", name, networkInterfaceName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_network_interface_tap_association", *existing.ID)
+		}
+	}
+
+	parameters := network.InterfaceTapConfiguration{
+		Name: utils.String(name),
+		InterfaceTapConfigurationPropertiesFormat: &network.InterfaceTapConfigurationPropertiesFormat{
+			VirtualNetworkTap: &network.VirtualNetworkTap{
+				ID: utils.String(virtualNetworkTapId),
+			},
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating Virtual Network Tap Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Virtual Network Tap Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, networkInterfaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Tap Configuration %q (Network Interface %q / Resource Group %q): %+v", name, networkInterfaceName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for Tap Configuration %q (Network Interface %q / Resource Group %q)", name, networkInterfaceName, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmNetworkInterfaceTapAssociationRead(d, meta)
+}
+
+func resourceArmNetworkInterfaceTapAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfaceTapConfigurationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNetworkInterfaceTapConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Association between Network Interface %q (Resource Group %q) and Virtual Network Tap %q was not found - removing from state!", id.NetworkInterfaceName, id.ResourceGroup, id.Name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Tap Configuration %q (Network Interface %q / Resource Group %q): %+v", id.Name, id.NetworkInterfaceName, id.ResourceGroup, err)
+	}
+
+	virtualNetworkTapId := ""
+	if props := resp.InterfaceTapConfigurationPropertiesFormat; props != nil {
+		if tap := props.VirtualNetworkTap; tap != nil && tap.ID != nil {
+			virtualNetworkTapId = *tap.ID
+		}
+	}
+
+	d.Set("network_interface_id", parse.NewNetworkInterfaceID(id.SubscriptionId, id.ResourceGroup, id.NetworkInterfaceName).ID())
+	d.Set("virtual_network_tap_id", virtualNetworkTapId)
+
+	return nil
+}
+
+func resourceArmNetworkInterfaceTapAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfaceTapConfigurationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNetworkInterfaceTapConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+	defer locks.UnlockByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.NetworkInterfaceName, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error removing Virtual Network Tap Association from Network Interface %q (Resource Group %q): %+v", id.NetworkInterfaceName, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for removal of Virtual Network Tap Association from Network Interface %q (Resource Group %q): %+v", id.NetworkInterfaceName, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -1,4 +1,4 @@
-package azurerm
+package network
 
 import (
 	"fmt"
@@ -9,9 +9,10 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -86,15 +87,16 @@ func resourceArmNetworkProfile() *schema.Resource {
 }
 
 func resourceArmNetworkProfileCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.ProfileClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Network.ProfileClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Network Profile creation")
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -123,11 +125,11 @@ func resourceArmNetworkProfileCreateUpdate(d *schema.ResourceData, meta interfac
 	locks.ByName(name, azureNetworkProfileResourceName)
 	defer locks.UnlockByName(name, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(subnetsToLock, subnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, subnetResourceName)
+	locks.MultipleByName(subnetsToLock, SubnetResourceName)
+	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
 
-	locks.MultipleByName(vnetsToLock, virtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, virtualNetworkResourceName)
+	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
 	parameters := network.Profile{
 		Location: &location,
@@ -156,8 +158,9 @@ func resourceArmNetworkProfileCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceArmNetworkProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.ProfileClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Network.ProfileClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -199,8 +202,9 @@ func resourceArmNetworkProfileRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmNetworkProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.ProfileClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Network.ProfileClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -228,11 +232,11 @@ func resourceArmNetworkProfileDelete(d *schema.ResourceData, meta interface{}) e
 	locks.ByName(name, azureNetworkProfileResourceName)
 	defer locks.UnlockByName(name, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(subnetsToLock, subnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, subnetResourceName)
+	locks.MultipleByName(subnetsToLock, SubnetResourceName)
+	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
 
-	locks.MultipleByName(vnetsToLock, virtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, virtualNetworkResourceName)
+	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
 	if _, err = client.Delete(ctx, resourceGroup, name); err != nil {
 		return fmt.Errorf("Error deleting Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -302,11 +306,11 @@ func expandNetworkProfileVirtualNetworkSubnetNames(d *schema.ResourceData) (*[]s
 			subnetName := subnetResourceID.Path["subnets"]
 			vnetName := subnetResourceID.Path["virtualNetworks"]
 
-			if !sliceContainsValue(subnetNames, subnetName) {
+			if !utils.SliceContainsValue(subnetNames, subnetName) {
 				subnetNames = append(subnetNames, subnetName)
 			}
 
-			if !sliceContainsValue(vnetNames, vnetName) {
+			if !utils.SliceContainsValue(vnetNames, vnetName) {
 				vnetNames = append(vnetNames, vnetName)
 			}
 		}
@@ -0,0 +1,469 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmNetworkWatcherFlowLog() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkWatcherFlowLogCreateUpdate,
+		Read:   resourceArmNetworkWatcherFlowLogRead,
+		Update: resourceArmNetworkWatcherFlowLogCreateUpdate,
+		Delete: resourceArmNetworkWatcherFlowLogDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, _, err := ParseNetworkWatcherFlowLogID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"network_watcher_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"network_security_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateNetworkSecurityGroupID,
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"retention_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 2),
+			},
+
+			"traffic_analytics": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"workspace_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.UUID,
+						},
+
+						"workspace_region": {
+							Type:             schema.TypeString,
+							Required:         true,
+							StateFunc:        azure.NormalizeLocation,
+							DiffSuppressFunc: azure.SuppressLocationDiff,
+						},
+
+						"workspace_resource_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"interval_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      60,
+							ValidateFunc: validation.IntInSlice([]int{10, 60}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmNetworkWatcherFlowLogCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.WatcherClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	watcherName := d.Get("network_watcher_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	networkSecurityGroupId := d.Get("network_security_group_id").(string)
+
+	nsg, err := parse.ParseNetworkSecurityGroupID(networkSecurityGroupId)
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(nsg.Name, networkSecurityGroupResourceName)
+	defer locks.UnlockByName(nsg.Name, networkSecurityGroupResourceName)
+
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
+		existing, err := RetrieveNetworkWatcherFlowLog(ctx, client, resourceGroup, watcherName, networkSecurityGroupId)
+		if err != nil {
+			return err
+		}
+
+		if existing != nil && existing.FlowLogProperties != nil && existing.FlowLogProperties.Enabled != nil && *existing.FlowLogProperties.Enabled {
+			watcher, err := client.Get(ctx, resourceGroup, watcherName)
+			if err != nil {
+				return fmt.Errorf("Error retrieving Network Watcher %q (Resource Group %q): %+v", watcherName, resourceGroup, err)
+			}
+
+			if watcher.ID != nil {
+				return tf.ImportAsExistsError("azurerm_network_watcher_flow_log", fmt.Sprintf("%s|%s", *watcher.ID, networkSecurityGroupId))
+			}
+		}
+	}
+
+	parameters := network.FlowLogInformation{
+		TargetResourceID: utils.String(networkSecurityGroupId),
+		FlowLogProperties: &network.FlowLogProperties{
+			StorageID:       utils.String(d.Get("storage_account_id").(string)),
+			Enabled:         utils.Bool(d.Get("enabled").(bool)),
+			RetentionPolicy: expandArmNetworkWatcherFlowLogRetentionPolicy(d.Get("retention_policy").([]interface{})),
+			Format: &network.FlowLogFormatParameters{
+				Type:    network.JSON,
+				Version: utils.Int32(int32(d.Get("version").(int))),
+			},
+		},
+		FlowAnalyticsConfiguration: expandArmNetworkWatcherFlowLogTrafficAnalytics(d.Get("traffic_analytics").([]interface{})),
+	}
+
+	future, err := client.SetFlowLogConfiguration(ctx, resourceGroup, watcherName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error setting Flow Log Configuration for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Flow Log Configuration for Network Security Group %q (Network Watcher %q / Resource Group %q) to be set: %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	watcher, err := client.Get(ctx, resourceGroup, watcherName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Watcher %q (Resource Group %q): %+v", watcherName, resourceGroup, err)
+	}
+
+	if watcher.ID == nil {
+		return fmt.Errorf("Cannot read ID for Network Watcher %q (Resource Group %q)", watcherName, resourceGroup)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", *watcher.ID, networkSecurityGroupId))
+
+	return resourceArmNetworkWatcherFlowLogRead(d, meta)
+}
+
+func resourceArmNetworkWatcherFlowLogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.WatcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	watcherId, networkSecurityGroupId, err := ParseNetworkWatcherFlowLogID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := watcherId.ResourceGroup
+	watcherName := watcherId.Path["networkWatchers"]
+
+	// the Network Watcher is checked first, since retrieving the Flow Log Status returns a 400 when it doesn't exist
+	watcher, err := client.Get(ctx, resourceGroup, watcherName)
+	if err != nil {
+		if utils.ResponseWasNotFound(watcher.Response) {
+			log.Printf("[DEBUG] Network Watcher %q was not found in Resource Group %q - removing from state!", watcherName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Network Watcher %q (Resource Group %q): %+v", watcherName, resourceGroup, err)
+	}
+
+	resp, err := RetrieveNetworkWatcherFlowLog(ctx, client, resourceGroup, watcherName, networkSecurityGroupId)
+	if err != nil {
+		return err
+	}
+
+	if resp == nil {
+		log.Printf("[DEBUG] Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q) was not found - removing from state!", networkSecurityGroupId, watcherName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network_watcher_name", watcherName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("network_security_group_id", networkSecurityGroupId)
+
+	if props := resp.FlowLogProperties; props != nil {
+		d.Set("enabled", props.Enabled)
+		d.Set("storage_account_id", props.StorageID)
+
+		version := 1
+		if format := props.Format; format != nil && format.Version != nil {
+			version = int(*format.Version)
+		}
+		d.Set("version", version)
+
+		if err := d.Set("retention_policy", flattenArmNetworkWatcherFlowLogRetentionPolicy(props.RetentionPolicy)); err != nil {
+			return fmt.Errorf("Error setting `retention_policy`: %+v", err)
+		}
+	}
+
+	if err := d.Set("traffic_analytics", flattenArmNetworkWatcherFlowLogTrafficAnalytics(resp.FlowAnalyticsConfiguration)); err != nil {
+		return fmt.Errorf("Error setting `traffic_analytics`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmNetworkWatcherFlowLogDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.WatcherClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	watcherId, networkSecurityGroupId, err := ParseNetworkWatcherFlowLogID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := watcherId.ResourceGroup
+	watcherName := watcherId.Path["networkWatchers"]
+
+	nsg, err := parse.ParseNetworkSecurityGroupID(networkSecurityGroupId)
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(nsg.Name, networkSecurityGroupResourceName)
+	defer locks.UnlockByName(nsg.Name, networkSecurityGroupResourceName)
+
+	// Flow Logs can't be deleted - instead they're disabled, along with Traffic Analytics
+	existing, err := RetrieveNetworkWatcherFlowLog(ctx, client, resourceGroup, watcherName, networkSecurityGroupId)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil || existing.FlowLogProperties == nil {
+		return nil
+	}
+
+	existing.TargetResourceID = utils.String(networkSecurityGroupId)
+	existing.FlowLogProperties.Enabled = utils.Bool(false)
+	if analytics := existing.FlowAnalyticsConfiguration; analytics != nil && analytics.NetworkWatcherFlowAnalyticsConfiguration != nil {
+		analytics.NetworkWatcherFlowAnalyticsConfiguration.Enabled = utils.Bool(false)
+	}
+
+	future, err := client.SetFlowLogConfiguration(ctx, resourceGroup, watcherName, *existing)
+	if err != nil {
+		return fmt.Errorf("Error disabling Flow Log Configuration for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Flow Log Configuration for Network Security Group %q (Network Watcher %q / Resource Group %q) to be disabled: %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// ParseNetworkWatcherFlowLogID parses the (Terraform specific) ID of a Flow Log,
+// which is in the format `{networkWatcherId}|{networkSecurityGroupId}`
+func ParseNetworkWatcherFlowLogID(input string) (*azure.ResourceID, string, error) {
+	splitId := strings.Split(input, "|")
+	if len(splitId) != 2 {
+		return nil, "", fmt.Errorf("Expected ID to be in the format {networkWatcherId}|{networkSecurityGroupId} but got %q", input)
+	}
+
+	watcherId, err := azure.ParseAzureResourceID(splitId[0])
+	if err != nil {
+		return nil, "", err
+	}
+
+	if watcherId.Path["networkWatchers"] == "" {
+		return nil, "", fmt.Errorf("Error: Network Watcher Name was missing from the ID %q", input)
+	}
+
+	if _, err := parse.ParseNetworkSecurityGroupID(splitId[1]); err != nil {
+		return nil, "", err
+	}
+
+	return watcherId, splitId[1], nil
+}
+
+func RetrieveNetworkWatcherFlowLog(ctx context.Context, client *network.WatchersClient, resourceGroup, watcherName, networkSecurityGroupId string) (*network.FlowLogInformation, error) {
+	parameters := network.FlowLogStatusParameters{
+		TargetResourceID: utils.String(networkSecurityGroupId),
+	}
+	future, err := client.GetFlowLogStatus(ctx, resourceGroup, watcherName, parameters)
+	if err != nil {
+		// the API returns a 404 when the Network Security Group doesn't exist
+		if response.WasNotFound(future.Response()) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("Error retrieving Flow Log Status for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("Error waiting for retrieval of Flow Log Status for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Flow Log Status for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	return &result, nil
+}
+
+func expandArmNetworkWatcherFlowLogRetentionPolicy(input []interface{}) *network.RetentionPolicyParameters {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &network.RetentionPolicyParameters{
+		Enabled: utils.Bool(v["enabled"].(bool)),
+		Days:    utils.Int32(int32(v["days"].(int))),
+	}
+}
+
+func flattenArmNetworkWatcherFlowLogRetentionPolicy(input *network.RetentionPolicyParameters) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	enabled := false
+	if input.Enabled != nil {
+		enabled = *input.Enabled
+	}
+
+	days := 0
+	if input.Days != nil {
+		days = int(*input.Days)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled": enabled,
+			"days":    days,
+		},
+	}
+}
+
+func expandArmNetworkWatcherFlowLogTrafficAnalytics(input []interface{}) *network.TrafficAnalyticsProperties {
+	if len(input) == 0 || input[0] == nil {
+		return &network.TrafficAnalyticsProperties{
+			NetworkWatcherFlowAnalyticsConfiguration: &network.TrafficAnalyticsConfigurationProperties{
+				Enabled: utils.Bool(false),
+			},
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &network.TrafficAnalyticsProperties{
+		NetworkWatcherFlowAnalyticsConfiguration: &network.TrafficAnalyticsConfigurationProperties{
+			Enabled:                  utils.Bool(v["enabled"].(bool)),
+			WorkspaceID:              utils.String(v["workspace_id"].(string)),
+			WorkspaceRegion:          utils.String(azure.NormalizeLocation(v["workspace_region"].(string))),
+			WorkspaceResourceID:      utils.String(v["workspace_resource_id"].(string)),
+			TrafficAnalyticsInterval: utils.Int32(int32(v["interval_in_minutes"].(int))),
+		},
+	}
+}
+
+func flattenArmNetworkWatcherFlowLogTrafficAnalytics(input *network.TrafficAnalyticsProperties) []interface{} {
+	if input == nil || input.NetworkWatcherFlowAnalyticsConfiguration == nil {
+		return []interface{}{}
+	}
+
+	config := *input.NetworkWatcherFlowAnalyticsConfiguration
+
+	// when Traffic Analytics has never been configured the Workspace isn't returned
+	if config.WorkspaceID == nil {
+		return []interface{}{}
+	}
+
+	enabled := false
+	if config.Enabled != nil {
+		enabled = *config.Enabled
+	}
+
+	workspaceRegion := ""
+	if config.WorkspaceRegion != nil {
+		workspaceRegion = azure.NormalizeLocation(*config.WorkspaceRegion)
+	}
+
+	workspaceResourceId := ""
+	if config.WorkspaceResourceID != nil {
+		workspaceResourceId = *config.WorkspaceResourceID
+	}
+
+	interval := 60
+	if config.TrafficAnalyticsInterval != nil {
+		interval = int(*config.TrafficAnalyticsInterval)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":               enabled,
+			"workspace_id":          *config.WorkspaceID,
+			"workspace_region":      workspaceRegion,
+			"workspace_resource_id": workspaceResourceId,
+			"interval_in_minutes":   interval,
+		},
+	}
+}
@@ -0,0 +1,276 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPointToSiteVPNGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPointToSiteVPNGatewayCreateUpdate,
+		Read:   resourceArmPointToSiteVPNGatewayRead,
+		Update: resourceArmPointToSiteVPNGatewayCreateUpdate,
+		Delete: resourceArmPointToSiteVPNGatewayDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParsePointToSiteVpnGatewayID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"virtual_hub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateVirtualHubID,
+			},
+
+			"vpn_server_configuration_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"scale_unit": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"vpn_client_address_pool": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.CIDR,
+				},
+			},
+
+			"custom_route_address_prefixes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.CIDR,
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmPointToSiteVPNGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PointToSiteVpnGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Point-to-Site VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_point_to_site_vpn_gateway", *existing.ID)
+		}
+	}
+
+	virtualHubId := d.Get("virtual_hub_id").(string)
+	virtualHub, err := parse.ParseVirtualHubID(virtualHubId)
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(virtualHub.Name, virtualHubResourceName)
+	defer locks.UnlockByName(virtualHub.Name, virtualHubResourceName)
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := network.P2SVpnGateway{
+		Location: utils.String(location),
+		P2SVpnGatewayProperties: &network.P2SVpnGatewayProperties{
+			P2SVpnServerConfiguration: &network.SubResource{
+				ID: utils.String(d.Get("vpn_server_configuration_id").(string)),
+			},
+			VirtualHub: &network.SubResource{
+				ID: utils.String(virtualHubId),
+			},
+			VpnClientAddressPool: &network.AddressSpace{
+				AddressPrefixes: utils.ExpandStringSlice(d.Get("vpn_client_address_pool").(*schema.Set).List()),
+			},
+			VpnGatewayScaleUnit: utils.Int32(int32(d.Get("scale_unit").(int))),
+		},
+		Tags: tags.Expand(t),
+	}
+
+	if v, ok := d.GetOk("custom_route_address_prefixes"); ok {
+		parameters.P2SVpnGatewayProperties.CustomRoutes = &network.AddressSpace{
+			AddressPrefixes: utils.ExpandStringSlice(v.(*schema.Set).List()),
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Point-to-Site VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Point-to-Site VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Point-to-Site VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for Point-to-Site VPN Gateway %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmPointToSiteVPNGatewayRead(d, meta)
+}
+
+func resourceArmPointToSiteVPNGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PointToSiteVpnGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParsePointToSiteVpnGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Point-to-Site VPN Gateway %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Point-to-Site VPN Gateway %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.P2SVpnGatewayProperties; props != nil {
+		var customRoutes []interface{}
+		if props.CustomRoutes != nil {
+			customRoutes = utils.FlattenStringSlice(props.CustomRoutes.AddressPrefixes)
+		}
+		if err := d.Set("custom_route_address_prefixes", customRoutes); err != nil {
+			return fmt.Errorf("Error setting `custom_route_address_prefixes`: %+v", err)
+		}
+
+		var vpnClientAddressPool []interface{}
+		if props.VpnClientAddressPool != nil {
+			vpnClientAddressPool = utils.FlattenStringSlice(props.VpnClientAddressPool.AddressPrefixes)
+		}
+		if err := d.Set("vpn_client_address_pool", vpnClientAddressPool); err != nil {
+			return fmt.Errorf("Error setting `vpn_client_address_pool`: %+v", err)
+		}
+
+		vpnServerConfigurationId := ""
+		if props.P2SVpnServerConfiguration != nil && props.P2SVpnServerConfiguration.ID != nil {
+			vpnServerConfigurationId = *props.P2SVpnServerConfiguration.ID
+		}
+		d.Set("vpn_server_configuration_id", vpnServerConfigurationId)
+
+		scaleUnit := 0
+		if props.VpnGatewayScaleUnit != nil {
+			scaleUnit = int(*props.VpnGatewayScaleUnit)
+		}
+		d.Set("scale_unit", scaleUnit)
+
+		virtualHubId := ""
+		if props.VirtualHub != nil && props.VirtualHub.ID != nil {
+			virtualHubId = *props.VirtualHub.ID
+		}
+		d.Set("virtual_hub_id", virtualHubId)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmPointToSiteVPNGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PointToSiteVpnGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParsePointToSiteVpnGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	virtualHub, err := parse.ParseVirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(virtualHub.Name, virtualHubResourceName)
+	defer locks.UnlockByName(virtualHub.Name, virtualHubResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Point-to-Site VPN Gateway %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Point-to-Site VPN Gateway %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,408 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPrivateEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateEndpointCreateUpdate,
+		Read:   resourceArmPrivateEndpointRead,
+		Update: resourceArmPrivateEndpointCreateUpdate,
+		Delete: resourceArmPrivateEndpointDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParsePrivateEndpointID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateSubnetID,
+			},
+
+			"private_service_connection": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"is_manual_connection": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"private_connection_resource_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"subresource_names": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"request_message": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 140),
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmPrivateEndpointCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PrivateEndpointClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_private_endpoint", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	subnetId := d.Get("subnet_id").(string)
+	t := d.Get("tags").(map[string]interface{})
+
+	connection, err := expandArmPrivateEndpointServiceConnection(d.Get("private_service_connection").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	properties := network.PrivateEndpointProperties{
+		Subnet: &network.Subnet{
+			ID: utils.String(subnetId),
+		},
+	}
+	if d.Get("private_service_connection.0.is_manual_connection").(bool) {
+		properties.ManualPrivateLinkServiceConnections = &[]network.PrivateLinkServiceConnection{*connection}
+	} else {
+		properties.PrivateLinkServiceConnections = &[]network.PrivateLinkServiceConnection{*connection}
+	}
+
+	parameters := network.PrivateEndpoint{
+		Location:                  utils.String(location),
+		PrivateEndpointProperties: &properties,
+		Tags:                      tags.Expand(t),
+	}
+
+	subnet, err := parse.ParseSubnetID(subnetId)
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(subnet.VirtualNetworkName, VirtualNetworkResourceName)
+	defer locks.UnlockByName(subnet.VirtualNetworkName, VirtualNetworkResourceName)
+
+	locks.ByName(subnet.Name, SubnetResourceName)
+	defer locks.UnlockByName(subnet.Name, SubnetResourceName)
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for Private Endpoint %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmPrivateEndpointRead(d, meta)
+}
+
+func resourceArmPrivateEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PrivateEndpointClient
+	interfacesClient := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParsePrivateEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Private Endpoint %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.PrivateEndpointProperties; props != nil {
+		subnetId := ""
+		if props.Subnet != nil && props.Subnet.ID != nil {
+			subnetId = *props.Subnet.ID
+		}
+		d.Set("subnet_id", subnetId)
+
+		networkInterfaceIds := make([]interface{}, 0)
+		privateIpAddress := ""
+		if nics := props.NetworkInterfaces; nics != nil {
+			for _, nic := range *nics {
+				if nic.ID == nil {
+					continue
+				}
+				networkInterfaceIds = append(networkInterfaceIds, *nic.ID)
+
+				// the Private IP Address is only exposed on the Network Interface
+				if privateIpAddress == "" {
+					privateIpAddress, err = retrievePrivateEndpointIPAddress(ctx, interfacesClient, *nic.ID)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+		if err := d.Set("network_interface_ids", networkInterfaceIds); err != nil {
+			return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+		}
+
+		connections := props.PrivateLinkServiceConnections
+		isManual := false
+		if props.ManualPrivateLinkServiceConnections != nil && len(*props.ManualPrivateLinkServiceConnections) > 0 {
+			connections = props.ManualPrivateLinkServiceConnections
+			isManual = true
+		}
+		if err := d.Set("private_service_connection", flattenArmPrivateEndpointServiceConnection(connections, isManual, privateIpAddress)); err != nil {
+			return fmt.Errorf("Error setting `private_service_connection`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmPrivateEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PrivateEndpointClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParsePrivateEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	subnet, err := parse.ParseSubnetID(d.Get("subnet_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(subnet.VirtualNetworkName, VirtualNetworkResourceName)
+	defer locks.UnlockByName(subnet.VirtualNetworkName, VirtualNetworkResourceName)
+
+	locks.ByName(subnet.Name, SubnetResourceName)
+	defer locks.UnlockByName(subnet.Name, SubnetResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmPrivateEndpointServiceConnection(input []interface{}) (*network.PrivateLinkServiceConnection, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, fmt.Errorf("Error: a `private_service_connection` block must be specified")
+	}
+	v := input[0].(map[string]interface{})
+
+	name := v["name"].(string)
+	isManual := v["is_manual_connection"].(bool)
+	requestMessage := v["request_message"].(string)
+
+	if isManual && requestMessage == "" {
+		return nil, fmt.Errorf("Error: `request_message` must be set when `is_manual_connection` is `true`")
+	}
+	if !isManual && requestMessage != "" {
+		return nil, fmt.Errorf("Error: `request_message` can only be set when `is_manual_connection` is `true`")
+	}
+
+	properties := network.PrivateLinkServiceConnectionProperties{
+		PrivateLinkServiceID: utils.String(v["private_connection_resource_id"].(string)),
+		GroupIds:             utils.ExpandStringSlice(v["subresource_names"].([]interface{})),
+	}
+	if isManual {
+		properties.RequestMessage = utils.String(requestMessage)
+	}
+
+	return &network.PrivateLinkServiceConnection{
+		Name:                                   utils.String(name),
+		PrivateLinkServiceConnectionProperties: &properties,
+	}, nil
+}
+
+func flattenArmPrivateEndpointServiceConnection(input *[]network.PrivateLinkServiceConnection, isManual bool, privateIpAddress string) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		privateConnectionId := ""
+		requestMessage := ""
+		status := ""
+		subresourceNames := make([]interface{}, 0)
+		if props := item.PrivateLinkServiceConnectionProperties; props != nil {
+			if props.PrivateLinkServiceID != nil {
+				privateConnectionId = *props.PrivateLinkServiceID
+			}
+			if props.RequestMessage != nil && isManual {
+				requestMessage = *props.RequestMessage
+			}
+			if state := props.PrivateLinkServiceConnectionState; state != nil && state.Status != nil {
+				status = *state.Status
+			}
+			subresourceNames = utils.FlattenStringSlice(props.GroupIds)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                           name,
+			"is_manual_connection":           isManual,
+			"private_connection_resource_id": privateConnectionId,
+			"subresource_names":              subresourceNames,
+			"request_message":                requestMessage,
+			"status":                         status,
+			"private_ip_address":             privateIpAddress,
+		})
+	}
+
+	return results
+}
+
+func retrievePrivateEndpointIPAddress(ctx context.Context, client *network.InterfacesClient, networkInterfaceId string) (string, error) {
+	id, err := parse.ParseNetworkInterfaceID(networkInterfaceId)
+	if err != nil {
+		return "", err
+	}
+
+	nic, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q) for the Private Endpoint: %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if props := nic.InterfacePropertiesFormat; props != nil && props.IPConfigurations != nil {
+		for _, config := range *props.IPConfigurations {
+			if ipProps := config.InterfaceIPConfigurationPropertiesFormat; ipProps != nil && ipProps.PrivateIPAddress != nil {
+				return *ipProps.PrivateIPAddress, nil
+			}
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,437 @@
+package network
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPrivateLinkService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateLinkServiceCreateUpdate,
+		Read:   resourceArmPrivateLinkServiceRead,
+		Update: resourceArmPrivateLinkServiceCreateUpdate,
+		Delete: resourceArmPrivateLinkServiceDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParsePrivateLinkServiceID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"nat_ip_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 8,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"subnet_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: parse.ValidateSubnetID,
+						},
+
+						"primary": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"private_ip_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.IPv4Address,
+						},
+
+						"private_ip_address_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.IPv4),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.IPv4),
+								string(network.IPv6),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"load_balancer_frontend_ip_configuration_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
+			},
+
+			"auto_approval_subscription_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+				Set: schema.HashString,
+			},
+
+			"visibility_subscription_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+				Set: schema.HashString,
+			},
+
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmPrivateLinkServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PrivateLinkServiceClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_private_link_service", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	ipConfigurations := d.Get("nat_ip_configuration").([]interface{})
+	subnetsToLock, vnetsToLock, err := expandArmPrivateLinkServiceSubnetNames(ipConfigurations)
+	if err != nil {
+		return err
+	}
+
+	parameters := network.PrivateLinkService{
+		Location: utils.String(location),
+		PrivateLinkServiceProperties: &network.PrivateLinkServiceProperties{
+			IPConfigurations:                     expandArmPrivateLinkServiceIPConfiguration(ipConfigurations),
+			LoadBalancerFrontendIPConfigurations: expandArmPrivateLinkServiceFrontendIPConfiguration(d.Get("load_balancer_frontend_ip_configuration_ids").(*schema.Set).List()),
+			AutoApproval: &network.PrivateLinkServicePropertiesAutoApproval{
+				Subscriptions: utils.ExpandStringSlice(d.Get("auto_approval_subscription_ids").(*schema.Set).List()),
+			},
+			Visibility: &network.PrivateLinkServicePropertiesVisibility{
+				Subscriptions: utils.ExpandStringSlice(d.Get("visibility_subscription_ids").(*schema.Set).List()),
+			},
+		},
+		Tags: tags.Expand(t),
+	}
+
+	locks.MultipleByName(&vnetsToLock, VirtualNetworkResourceName)
+	defer locks.UnlockMultipleByName(&vnetsToLock, VirtualNetworkResourceName)
+
+	locks.MultipleByName(&subnetsToLock, SubnetResourceName)
+	defer locks.UnlockMultipleByName(&subnetsToLock, SubnetResourceName)
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for Private Link Service %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmPrivateLinkServiceRead(d, meta)
+}
+
+func resourceArmPrivateLinkServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PrivateLinkServiceClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParsePrivateLinkServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Private Link Service %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Private Link Service %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.PrivateLinkServiceProperties; props != nil {
+		d.Set("alias", props.Alias)
+
+		if err := d.Set("nat_ip_configuration", flattenArmPrivateLinkServiceIPConfiguration(props.IPConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `nat_ip_configuration`: %+v", err)
+		}
+
+		if err := d.Set("load_balancer_frontend_ip_configuration_ids", flattenArmPrivateLinkServiceFrontendIPConfiguration(props.LoadBalancerFrontendIPConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `load_balancer_frontend_ip_configuration_ids`: %+v", err)
+		}
+
+		autoApprovalSubscriptionIds := make([]interface{}, 0)
+		if approval := props.AutoApproval; approval != nil {
+			autoApprovalSubscriptionIds = utils.FlattenStringSlice(approval.Subscriptions)
+		}
+		if err := d.Set("auto_approval_subscription_ids", autoApprovalSubscriptionIds); err != nil {
+			return fmt.Errorf("Error setting `auto_approval_subscription_ids`: %+v", err)
+		}
+
+		visibilitySubscriptionIds := make([]interface{}, 0)
+		if visibility := props.Visibility; visibility != nil {
+			visibilitySubscriptionIds = utils.FlattenStringSlice(visibility.Subscriptions)
+		}
+		if err := d.Set("visibility_subscription_ids", visibilitySubscriptionIds); err != nil {
+			return fmt.Errorf("Error setting `visibility_subscription_ids`: %+v", err)
+		}
+
+		networkInterfaceIds := make([]interface{}, 0)
+		if nics := props.NetworkInterfaces; nics != nil {
+			for _, nic := range *nics {
+				if nic.ID != nil {
+					networkInterfaceIds = append(networkInterfaceIds, *nic.ID)
+				}
+			}
+		}
+		if err := d.Set("network_interface_ids", networkInterfaceIds); err != nil {
+			return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmPrivateLinkServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PrivateLinkServiceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParsePrivateLinkServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	subnetsToLock, vnetsToLock, err := expandArmPrivateLinkServiceSubnetNames(d.Get("nat_ip_configuration").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	locks.MultipleByName(&vnetsToLock, VirtualNetworkResourceName)
+	defer locks.UnlockMultipleByName(&vnetsToLock, VirtualNetworkResourceName)
+
+	locks.MultipleByName(&subnetsToLock, SubnetResourceName)
+	defer locks.UnlockMultipleByName(&subnetsToLock, SubnetResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Private Link Service %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Private Link Service %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmPrivateLinkServiceIPConfiguration(input []interface{}) *[]network.PrivateLinkServiceIPConfiguration {
+	results := make([]network.PrivateLinkServiceIPConfiguration, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		privateIpAddress := v["private_ip_address"].(string)
+
+		properties := network.PrivateLinkServiceIPConfigurationProperties{
+			PrivateIPAddressVersion:   network.IPVersion(v["private_ip_address_version"].(string)),
+			PrivateIPAllocationMethod: network.Dynamic,
+			Primary:                   utils.Bool(v["primary"].(bool)),
+			Subnet: &network.Subnet{
+				ID: utils.String(v["subnet_id"].(string)),
+			},
+		}
+		if privateIpAddress != "" {
+			properties.PrivateIPAddress = utils.String(privateIpAddress)
+			properties.PrivateIPAllocationMethod = network.Static
+		}
+
+		results = append(results, network.PrivateLinkServiceIPConfiguration{
+			Name: utils.String(v["name"].(string)),
+			PrivateLinkServiceIPConfigurationProperties: &properties,
+		})
+	}
+
+	return &results
+}
+
+func flattenArmPrivateLinkServiceIPConfiguration(input *[]network.PrivateLinkServiceIPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		primary := false
+		privateIpAddress := ""
+		privateIpAddressVersion := ""
+		subnetId := ""
+		if props := item.PrivateLinkServiceIPConfigurationProperties; props != nil {
+			if props.Primary != nil {
+				primary = *props.Primary
+			}
+			// the Private IP Address is only user-specified when it's allocated Statically
+			if props.PrivateIPAllocationMethod == network.Static && props.PrivateIPAddress != nil {
+				privateIpAddress = *props.PrivateIPAddress
+			}
+			privateIpAddressVersion = string(props.PrivateIPAddressVersion)
+			if props.Subnet != nil && props.Subnet.ID != nil {
+				subnetId = *props.Subnet.ID
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                       name,
+			"primary":                    primary,
+			"private_ip_address":         privateIpAddress,
+			"private_ip_address_version": privateIpAddressVersion,
+			"subnet_id":                  subnetId,
+		})
+	}
+
+	return results
+}
+
+func expandArmPrivateLinkServiceFrontendIPConfiguration(input []interface{}) *[]network.FrontendIPConfiguration {
+	results := make([]network.FrontendIPConfiguration, 0)
+
+	for _, item := range input {
+		results = append(results, network.FrontendIPConfiguration{
+			ID: utils.String(item.(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenArmPrivateLinkServiceFrontendIPConfiguration(input *[]network.FrontendIPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.ID != nil {
+			results = append(results, *item.ID)
+		}
+	}
+
+	return results
+}
+
+func expandArmPrivateLinkServiceSubnetNames(input []interface{}) ([]string, []string, error) {
+	subnetNames := make([]string, 0)
+	virtualNetworkNames := make([]string, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		id, err := parse.ParseSubnetID(v["subnet_id"].(string))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !utils.SliceContainsValue(subnetNames, id.Name) {
+			subnetNames = append(subnetNames, id.Name)
+		}
+		if !utils.SliceContainsValue(virtualNetworkNames, id.VirtualNetworkName) {
+			virtualNetworkNames = append(virtualNetworkNames, id.VirtualNetworkName)
+		}
+	}
+
+	return subnetNames, virtualNetworkNames, nil
+}
@@ -1,4 +1,4 @@
-package azurerm
+package network
 
 import (
 	"fmt"
@@ -9,10 +9,14 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var publicIpPrefixResourceName = "azurerm_public_ip_prefix"
+
 func resourceArmPublicIpPrefix() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmPublicIpPrefixCreateUpdate,
@@ -66,8 +70,9 @@ func resourceArmPublicIpPrefix() *schema.Resource {
 }
 
 func resourceArmPublicIpPrefixCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.PublicIPPrefixesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Network.PublicIPPrefixesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Public IP Prefix creation.")
 
@@ -115,8 +120,9 @@ func resourceArmPublicIpPrefixCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceArmPublicIpPrefixRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.PublicIPPrefixesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Network.PublicIPPrefixesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -155,8 +161,9 @@ func resourceArmPublicIpPrefixRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmPublicIpPrefixDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.PublicIPPrefixesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Network.PublicIPPrefixesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
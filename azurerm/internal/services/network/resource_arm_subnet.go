@@ -1,21 +1,23 @@
-package azurerm
+package network
 
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
-	networksvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-var subnetResourceName = "azurerm_subnet"
+var SubnetResourceName = "azurerm_subnet"
 
 func resourceArmSubnet() *schema.Resource {
 	return &schema.Resource{
@@ -23,9 +25,10 @@ func resourceArmSubnet() *schema.Resource {
 		Read:   resourceArmSubnetRead,
 		Update: resourceArmSubnetCreateUpdate,
 		Delete: resourceArmSubnetDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseSubnetID(id)
+			return err
+		}),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -73,6 +76,28 @@ func resourceArmSubnet() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"service_endpoint_policy_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: parse.ValidateServiceEndpointPolicyID,
+				},
+			},
+
+			"enforce_private_link_endpoint_network_policies": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enforce_private_link_service_network_policies": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"delegation": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -130,8 +155,9 @@ func resourceArmSubnet() *schema.Resource {
 }
 
 func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.SubnetsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Network.SubnetsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Subnet creation.")
 
@@ -139,7 +165,7 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 	vnetName := d.Get("virtual_network_name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, vnetName, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -154,8 +180,8 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 
 	addressPrefix := d.Get("address_prefix").(string)
 
-	locks.ByName(vnetName, virtualNetworkResourceName)
-	defer locks.UnlockByName(vnetName, virtualNetworkResourceName)
+	locks.ByName(vnetName, VirtualNetworkResourceName)
+	defer locks.UnlockByName(vnetName, VirtualNetworkResourceName)
 
 	properties := network.SubnetPropertiesFormat{
 		AddressPrefix: &addressPrefix,
@@ -167,7 +193,7 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 			ID: &nsgId,
 		}
 
-		parsedNsgId, err := networksvc.ParseNetworkSecurityGroupResourceID(nsgId)
+		parsedNsgId, err := ParseNetworkSecurityGroupResourceID(nsgId)
 		if err != nil {
 			return err
 		}
@@ -184,7 +210,7 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 			ID: &rtId,
 		}
 
-		parsedRouteTableId, err := networksvc.ParseRouteTableResourceID(rtId)
+		parsedRouteTableId, err := ParseRouteTableResourceID(rtId)
 		if err != nil {
 			return err
 		}
@@ -198,9 +224,31 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 	serviceEndpoints := expandSubnetServiceEndpoints(d)
 	properties.ServiceEndpoints = &serviceEndpoints
 
+	serviceEndpointPolicies := expandSubnetServiceEndpointPolicies(d.Get("service_endpoint_policy_ids").(*schema.Set).List())
+	properties.ServiceEndpointPolicies = &serviceEndpointPolicies
+
 	delegations := expandSubnetDelegation(d)
 	properties.Delegations = &delegations
 
+	privateEndpointNetworkPolicies := d.Get("enforce_private_link_endpoint_network_policies").(bool)
+	properties.PrivateEndpointNetworkPolicies = expandSubnetPrivateLinkNetworkPolicy(privateEndpointNetworkPolicies)
+
+	privateLinkServiceNetworkPolicies := d.Get("enforce_private_link_service_network_policies").(bool)
+	properties.PrivateLinkServiceNetworkPolicies = expandSubnetPrivateLinkNetworkPolicy(privateLinkServiceNetworkPolicies)
+
+	// the NAT Gateway is managed via the `azurerm_subnet_nat_gateway_association` resource,
+	// as such we need to retain the existing value when updating the Subnet
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, vnetName, name, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+		}
+
+		if props := existing.SubnetPropertiesFormat; props != nil {
+			properties.NatGateway = props.NatGateway
+		}
+	}
+
 	subnet := network.Subnet{
 		Name:                   &name,
 		SubnetPropertiesFormat: &properties,
@@ -229,16 +277,17 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.SubnetsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Network.SubnetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := parse.ParseSubnetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	vnetName := id.Path["virtualNetworks"]
-	name := id.Path["subnets"]
+	vnetName := id.VirtualNetworkName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, vnetName, name, "")
 
@@ -279,30 +328,39 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
+		serviceEndpointPolicies := flattenSubnetServiceEndpointPolicies(props.ServiceEndpointPolicies)
+		if err := d.Set("service_endpoint_policy_ids", serviceEndpointPolicies); err != nil {
+			return fmt.Errorf("Error setting `service_endpoint_policy_ids`: %+v", err)
+		}
+
 		delegation := flattenSubnetDelegation(props.Delegations)
 		if err := d.Set("delegation", delegation); err != nil {
 			return fmt.Errorf("Error flattening `delegation`: %+v", err)
 		}
+
+		d.Set("enforce_private_link_endpoint_network_policies", flattenSubnetPrivateLinkNetworkPolicy(props.PrivateEndpointNetworkPolicies))
+		d.Set("enforce_private_link_service_network_policies", flattenSubnetPrivateLinkNetworkPolicy(props.PrivateLinkServiceNetworkPolicies))
 	}
 
 	return nil
 }
 
 func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.SubnetsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Network.SubnetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := parse.ParseSubnetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["subnets"]
-	vnetName := id.Path["virtualNetworks"]
+	name := id.Name
+	vnetName := id.VirtualNetworkName
 
 	if v, ok := d.GetOk("network_security_group_id"); ok {
 		networkSecurityGroupId := v.(string)
-		parsedNetworkSecurityGroupId, err2 := networksvc.ParseNetworkSecurityGroupResourceID(networkSecurityGroupId)
+		parsedNetworkSecurityGroupId, err2 := ParseNetworkSecurityGroupResourceID(networkSecurityGroupId)
 		if err2 != nil {
 			return err2
 		}
@@ -313,7 +371,7 @@ func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 
 	if v, ok := d.GetOk("route_table_id"); ok {
 		rtId := v.(string)
-		parsedRouteTableId, err2 := networksvc.ParseRouteTableResourceID(rtId)
+		parsedRouteTableId, err2 := ParseRouteTableResourceID(rtId)
 		if err2 != nil {
 			return err2
 		}
@@ -322,11 +380,11 @@ func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 		defer locks.UnlockByName(parsedRouteTableId.Name, routeTableResourceName)
 	}
 
-	locks.ByName(vnetName, virtualNetworkResourceName)
-	defer locks.UnlockByName(vnetName, virtualNetworkResourceName)
+	locks.ByName(vnetName, VirtualNetworkResourceName)
+	defer locks.UnlockByName(vnetName, VirtualNetworkResourceName)
 
-	locks.ByName(name, subnetResourceName)
-	defer locks.UnlockByName(name, subnetResourceName)
+	locks.ByName(name, SubnetResourceName)
+	defer locks.UnlockByName(name, SubnetResourceName)
 
 	future, err := client.Delete(ctx, resGroup, vnetName, name)
 	if err != nil {
@@ -372,6 +430,34 @@ func flattenSubnetServiceEndpoints(serviceEndpoints *[]network.ServiceEndpointPr
 	return endpoints
 }
 
+func expandSubnetServiceEndpointPolicies(input []interface{}) []network.ServiceEndpointPolicy {
+	policies := make([]network.ServiceEndpointPolicy, 0)
+
+	for _, v := range input {
+		policies = append(policies, network.ServiceEndpointPolicy{
+			ID: utils.String(v.(string)),
+		})
+	}
+
+	return policies
+}
+
+func flattenSubnetServiceEndpointPolicies(input *[]network.ServiceEndpointPolicy) []interface{} {
+	ids := make([]interface{}, 0)
+
+	if input == nil {
+		return ids
+	}
+
+	for _, policy := range *input {
+		if policy.ID != nil {
+			ids = append(ids, *policy.ID)
+		}
+	}
+
+	return ids
+}
+
 func flattenSubnetIPConfigurations(ipConfigurations *[]network.IPConfiguration) []string {
 	ips := make([]string, 0)
 
@@ -450,3 +536,17 @@ func flattenSubnetDelegation(delegations *[]network.Delegation) []interface{} {
 
 	return retDeles
 }
+
+// expandSubnetPrivateLinkNetworkPolicy returns the value for the Private Link Network Policies on the Subnet,
+// which need to be Disabled to allow a Private Endpoint or Private Link Service to be deployed into it
+func expandSubnetPrivateLinkNetworkPolicy(enforce bool) *string {
+	if enforce {
+		return utils.String("Disabled")
+	}
+
+	return utils.String("Enabled")
+}
+
+func flattenSubnetPrivateLinkNetworkPolicy(input *string) bool {
+	return input != nil && strings.EqualFold(*input, "Disabled")
+}
@@ -0,0 +1,225 @@
+package network
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSubnetNatGatewayAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubnetNatGatewayAssociationCreate,
+		Read:   resourceArmSubnetNatGatewayAssociationRead,
+		Delete: resourceArmSubnetNatGatewayAssociationDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseSubnetID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateSubnetID,
+			},
+
+			"nat_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateNatGatewayID,
+			},
+		},
+	}
+}
+
+func resourceArmSubnetNatGatewayAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.SubnetsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Subnet <-> NAT Gateway Association creation.")
+
+	natGatewayId := d.Get("nat_gateway_id").(string)
+
+	subnetId, err := parse.ParseSubnetID(d.Get("subnet_id").(string))
+	if err != nil {
+		return err
+	}
+
+	natGateway, err := parse.ParseNatGatewayID(natGatewayId)
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(natGateway.Name, natGatewayResourceName)
+	defer locks.UnlockByName(natGateway.Name, natGatewayResourceName)
+
+	locks.ByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
+	defer locks.UnlockByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
+
+	locks.ByName(subnetId.Name, SubnetResourceName)
+	defer locks.UnlockByName(subnetId.Name, SubnetResourceName)
+
+	subnet, err := client.Get(ctx, subnetId.ResourceGroup, subnetId.VirtualNetworkName, subnetId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(subnet.Response) {
+			return fmt.Errorf("Subnet %q (Virtual Network %q / Resource Group %q) was not found!", subnetId.Name, subnetId.VirtualNetworkName, subnetId.ResourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetId.Name, subnetId.VirtualNetworkName, subnetId.ResourceGroup, err)
+	}
+
+	if props := subnet.SubnetPropertiesFormat; props != nil {
+		if meta.(*clients.Client).Features.Resources.RequireImport {
+			if gateway := props.NatGateway; gateway != nil {
+				// we're intentionally not checking the ID - if there's a NAT Gateway, it needs to be imported
+				if gateway.ID != nil && subnet.ID != nil {
+					return tf.ImportAsExistsError("azurerm_subnet_nat_gateway_association", *subnet.ID)
+				}
+			}
+		}
+
+		props.NatGateway = &network.SubResource{
+			ID: utils.String(natGatewayId),
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, subnetId.ResourceGroup, subnetId.VirtualNetworkName, subnetId.Name, subnet)
+	if err != nil {
+		return fmt.Errorf("Error updating NAT Gateway Association for Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetId.Name, subnetId.VirtualNetworkName, subnetId.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of NAT Gateway Association for Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetId.Name, subnetId.VirtualNetworkName, subnetId.ResourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, subnetId.ResourceGroup, subnetId.VirtualNetworkName, subnetId.Name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetId.Name, subnetId.VirtualNetworkName, subnetId.ResourceGroup, err)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSubnetNatGatewayAssociationRead(d, meta)
+}
+
+func resourceArmSubnetNatGatewayAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.SubnetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseSubnetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) could not be found - removing from state!", id.Name, id.VirtualNetworkName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", id.Name, id.VirtualNetworkName, id.ResourceGroup, err)
+	}
+
+	props := resp.SubnetPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for Subnet %q (Virtual Network %q / Resource Group %q)", id.Name, id.VirtualNetworkName, id.ResourceGroup)
+	}
+
+	natGateway := props.NatGateway
+	if natGateway == nil {
+		log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) doesn't have a NAT Gateway - removing from state!", id.Name, id.VirtualNetworkName, id.ResourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("subnet_id", resp.ID)
+	d.Set("nat_gateway_id", natGateway.ID)
+
+	return nil
+}
+
+func resourceArmSubnetNatGatewayAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.SubnetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseSubnetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// retrieve the subnet
+	read, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) could not be found - removing from state!", id.Name, id.VirtualNetworkName, id.ResourceGroup)
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", id.Name, id.VirtualNetworkName, id.ResourceGroup, err)
+	}
+
+	props := read.SubnetPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("`Properties` was nil for Subnet %q (Virtual Network %q / Resource Group %q)", id.Name, id.VirtualNetworkName, id.ResourceGroup)
+	}
+
+	if props.NatGateway == nil || props.NatGateway.ID == nil {
+		log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) has no NAT Gateway - removing from state!", id.Name, id.VirtualNetworkName, id.ResourceGroup)
+		return nil
+	}
+
+	// once we have the NAT Gateway ID to lock on, lock on that
+	natGateway, err := parse.ParseNatGatewayID(*props.NatGateway.ID)
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(natGateway.Name, natGatewayResourceName)
+	defer locks.UnlockByName(natGateway.Name, natGatewayResourceName)
+
+	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+
+	locks.ByName(id.Name, SubnetResourceName)
+	defer locks.UnlockByName(id.Name, SubnetResourceName)
+
+	// then re-retrieve it to ensure we've got the latest state
+	read, err = client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) could not be found - removing from state!", id.Name, id.VirtualNetworkName, id.ResourceGroup)
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", id.Name, id.VirtualNetworkName, id.ResourceGroup, err)
+	}
+
+	read.SubnetPropertiesFormat.NatGateway = nil
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, read)
+	if err != nil {
+		return fmt.Errorf("Error removing NAT Gateway Association from Subnet %q (Virtual Network %q / Resource Group %q): %+v", id.Name, id.VirtualNetworkName, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of NAT Gateway Association from Subnet %q (Virtual Network %q / Resource Group %q): %+v", id.Name, id.VirtualNetworkName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package network
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSubnetServiceEndpointStoragePolicyDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubnetServiceEndpointStoragePolicyDefinitionCreateUpdate,
+		Read:   resourceArmSubnetServiceEndpointStoragePolicyDefinitionRead,
+		Update: resourceArmSubnetServiceEndpointStoragePolicyDefinitionCreateUpdate,
+		Delete: resourceArmSubnetServiceEndpointStoragePolicyDefinitionDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseServiceEndpointPolicyDefinitionID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"service_endpoint_policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateServiceEndpointPolicyID,
+			},
+
+			"service_resources": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmSubnetServiceEndpointStoragePolicyDefinitionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ServiceEndpointPolicyDefinitionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	policy, err := parse.ParseServiceEndpointPolicyID(d.Get("service_endpoint_policy_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, policy.ResourceGroup, policy.Name, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Definition %q (Service Endpoint Storage Policy %q / Resource Group %q): %+v", name, policy.Name, policy.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_subnet_service_endpoint_storage_policy_definition", *existing.ID)
+		}
+	}
+
+	locks.ByName(policy.Name, serviceEndpointPolicyResourceName)
+	defer locks.UnlockByName(policy.Name, serviceEndpointPolicyResourceName)
+
+	parameters := network.ServiceEndpointPolicyDefinition{
+		Name: utils.String(name),
+		ServiceEndpointPolicyDefinitionPropertiesFormat: &network.ServiceEndpointPolicyDefinitionPropertiesFormat{
+			Description:      utils.String(d.Get("description").(string)),
+			Service:          utils.String("Microsoft.Storage"),
+			ServiceResources: utils.ExpandStringSlice(d.Get("service_resources").(*schema.Set).List()),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, policy.ResourceGroup, policy.Name, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Definition %q (Service Endpoint Storage Policy %q / Resource Group %q): %+v", name, policy.Name, policy.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Definition %q (Service Endpoint Storage Policy %q / Resource Group %q): %+v", name, policy.Name, policy.ResourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, policy.ResourceGroup, policy.Name, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Definition %q (Service Endpoint Storage Policy %q / Resource Group %q): %+v", name, policy.Name, policy.ResourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for Definition %q (Service Endpoint Storage Policy %q / Resource Group %q)", name, policy.Name, policy.ResourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmSubnetServiceEndpointStoragePolicyDefinitionRead(d, meta)
+}
+
+func resourceArmSubnetServiceEndpointStoragePolicyDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ServiceEndpointPolicyDefinitionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseServiceEndpointPolicyDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServiceEndpointPolicyName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Definition %q was not found in Service Endpoint Storage Policy %q (Resource Group %q) - removing from state!", id.Name, id.ServiceEndpointPolicyName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Definition %q (Service Endpoint Storage Policy %q / Resource Group %q): %+v", id.Name, id.ServiceEndpointPolicyName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("service_endpoint_policy_id", parse.NewServiceEndpointPolicyID(id.SubscriptionId, id.ResourceGroup, id.ServiceEndpointPolicyName).ID())
+
+	if props := resp.ServiceEndpointPolicyDefinitionPropertiesFormat; props != nil {
+		d.Set("description", props.Description)
+
+		if err := d.Set("service_resources", utils.FlattenStringSlice(props.ServiceResources)); err != nil {
+			return fmt.Errorf("Error setting `service_resources`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmSubnetServiceEndpointStoragePolicyDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ServiceEndpointPolicyDefinitionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseServiceEndpointPolicyDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.ServiceEndpointPolicyName, serviceEndpointPolicyResourceName)
+	defer locks.UnlockByName(id.ServiceEndpointPolicyName, serviceEndpointPolicyResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ServiceEndpointPolicyName, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Definition %q (Service Endpoint Storage Policy %q / Resource Group %q): %+v", id.Name, id.ServiceEndpointPolicyName, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Definition %q (Service Endpoint Storage Policy %q / Resource Group %q): %+v", id.Name, id.ServiceEndpointPolicyName, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,241 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVirtualHubConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualHubConnectionCreateUpdate,
+		Read:   resourceArmVirtualHubConnectionRead,
+		Update: resourceArmVirtualHubConnectionCreateUpdate,
+		Delete: resourceArmVirtualHubConnectionDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseVirtualHubConnectionID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"virtual_hub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateVirtualHubID,
+			},
+
+			"remote_virtual_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateVirtualNetworkID,
+			},
+
+			"hub_to_virtual_network_traffic_allowed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"virtual_network_to_hub_gateways_traffic_allowed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"internet_security_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualHubConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	virtualHub, err := parse.ParseVirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(virtualHub.Name, virtualHubResourceName)
+	defer locks.UnlockByName(virtualHub.Name, virtualHubResourceName)
+
+	// Virtual Hub Connections can only be managed via the Virtual Hub
+	hub, err := client.Get(ctx, virtualHub.ResourceGroup, virtualHub.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(hub.Response) {
+			return fmt.Errorf("Virtual Hub %q (Resource Group %q) was not found!", virtualHub.Name, virtualHub.ResourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", virtualHub.Name, virtualHub.ResourceGroup, err)
+	}
+
+	props := hub.VirtualHubProperties
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for Virtual Hub %q (Resource Group %q)", virtualHub.Name, virtualHub.ResourceGroup)
+	}
+
+	connections := make([]network.HubVirtualNetworkConnection, 0)
+	if props.VirtualNetworkConnections != nil {
+		for _, existing := range *props.VirtualNetworkConnections {
+			if existing.Name != nil && strings.EqualFold(*existing.Name, name) {
+				if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() && existing.ID != nil {
+					return tf.ImportAsExistsError("azurerm_virtual_hub_connection", *existing.ID)
+				}
+
+				continue
+			}
+
+			connections = append(connections, existing)
+		}
+	}
+
+	connections = append(connections, network.HubVirtualNetworkConnection{
+		Name: utils.String(name),
+		HubVirtualNetworkConnectionProperties: &network.HubVirtualNetworkConnectionProperties{
+			RemoteVirtualNetwork: &network.SubResource{
+				ID: utils.String(d.Get("remote_virtual_network_id").(string)),
+			},
+			AllowHubToRemoteVnetTransit:         utils.Bool(d.Get("hub_to_virtual_network_traffic_allowed").(bool)),
+			AllowRemoteVnetToUseHubVnetGateways: utils.Bool(d.Get("virtual_network_to_hub_gateways_traffic_allowed").(bool)),
+			EnableInternetSecurity:              utils.Bool(d.Get("internet_security_enabled").(bool)),
+		},
+	})
+	props.VirtualNetworkConnections = &connections
+
+	future, err := client.CreateOrUpdate(ctx, virtualHub.ResourceGroup, virtualHub.Name, hub)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Connection %q for Virtual Hub %q (Resource Group %q): %+v", name, virtualHub.Name, virtualHub.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Connection %q for Virtual Hub %q (Resource Group %q): %+v", name, virtualHub.Name, virtualHub.ResourceGroup, err)
+	}
+
+	connectionsClient := meta.(*clients.Client).Network.HubVirtualNetworkConnectionClient
+	resp, err := connectionsClient.Get(ctx, virtualHub.ResourceGroup, virtualHub.Name, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Connection %q for Virtual Hub %q (Resource Group %q): %+v", name, virtualHub.Name, virtualHub.ResourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for Connection %q for Virtual Hub %q (Resource Group %q)", name, virtualHub.Name, virtualHub.ResourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmVirtualHubConnectionRead(d, meta)
+}
+
+func resourceArmVirtualHubConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.HubVirtualNetworkConnectionClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseVirtualHubConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Connection %q for Virtual Hub %q was not found in Resource Group %q - removing from state!", id.Name, id.VirtualHubName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Connection %q for Virtual Hub %q (Resource Group %q): %+v", id.Name, id.VirtualHubName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("virtual_hub_id", parse.NewVirtualHubID(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName).ID())
+
+	if props := resp.HubVirtualNetworkConnectionProperties; props != nil {
+		remoteVirtualNetworkId := ""
+		if props.RemoteVirtualNetwork != nil && props.RemoteVirtualNetwork.ID != nil {
+			remoteVirtualNetworkId = *props.RemoteVirtualNetwork.ID
+		}
+		d.Set("remote_virtual_network_id", remoteVirtualNetworkId)
+		d.Set("hub_to_virtual_network_traffic_allowed", props.AllowHubToRemoteVnetTransit)
+		d.Set("virtual_network_to_hub_gateways_traffic_allowed", props.AllowRemoteVnetToUseHubVnetGateways)
+		d.Set("internet_security_enabled", props.EnableInternetSecurity)
+	}
+
+	return nil
+}
+
+func resourceArmVirtualHubConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseVirtualHubConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+	hub, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName)
+	if err != nil {
+		if utils.ResponseWasNotFound(hub.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", id.VirtualHubName, id.ResourceGroup, err)
+	}
+
+	props := hub.VirtualHubProperties
+	if props == nil || props.VirtualNetworkConnections == nil {
+		return nil
+	}
+
+	connections := make([]network.HubVirtualNetworkConnection, 0)
+	for _, existing := range *props.VirtualNetworkConnections {
+		if existing.Name != nil && strings.EqualFold(*existing.Name, id.Name) {
+			continue
+		}
+
+		connections = append(connections, existing)
+	}
+	props.VirtualNetworkConnections = &connections
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualHubName, hub)
+	if err != nil {
+		return fmt.Errorf("Error removing Connection %q from Virtual Hub %q (Resource Group %q): %+v", id.Name, id.VirtualHubName, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of Connection %q from Virtual Hub %q (Resource Group %q): %+v", id.Name, id.VirtualHubName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
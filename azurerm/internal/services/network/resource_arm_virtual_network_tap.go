@@ -0,0 +1,262 @@
+package network
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var virtualNetworkTapResourceName = "azurerm_virtual_network_tap"
+
+func resourceArmVirtualNetworkTap() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualNetworkTapCreateUpdate,
+		Read:   resourceArmVirtualNetworkTapRead,
+		Update: resourceArmVirtualNetworkTapCreateUpdate,
+		Delete: resourceArmVirtualNetworkTapDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseVirtualNetworkTapID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"destination_network_interface_ip_configuration_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  azure.ValidateResourceID,
+				ConflictsWith: []string{"destination_load_balancer_frontend_ip_configuration_id"},
+			},
+
+			"destination_load_balancer_frontend_ip_configuration_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  azure.ValidateResourceID,
+				ConflictsWith: []string{"destination_network_interface_ip_configuration_id"},
+			},
+
+			"destination_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.PortNumber,
+			},
+
+			"network_interface_tap_configuration_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"resource_guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmVirtualNetworkTapCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualNetworkTapsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Virtual Network Tap %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_virtual_network_tap", *existing.ID)
+		}
+	}
+
+	networkInterfaceIPConfigurationId := d.Get("destination_network_interface_ip_configuration_id").(string)
+	loadBalancerFrontendIPConfigurationId := d.Get("destination_load_balancer_frontend_ip_configuration_id").(string)
+	if networkInterfaceIPConfigurationId == "" && loadBalancerFrontendIPConfigurationId == "" {
+		return fmt.Errorf("Error creating/updating Virtual Network Tap %q (Resource Group %q): one of `destination_network_interface_ip_configuration_id` or `destination_load_balancer_frontend_ip_configuration_id` must be specified", name, resourceGroup)
+	}
+
+	locks.ByName(name, virtualNetworkTapResourceName)
+	defer locks.UnlockByName(name, virtualNetworkTapResourceName)
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := network.VirtualNetworkTap{
+		Location:                          utils.String(location),
+		VirtualNetworkTapPropertiesFormat: &network.VirtualNetworkTapPropertiesFormat{},
+		Tags:                              tags.Expand(t),
+	}
+
+	if networkInterfaceIPConfigurationId != "" {
+		parameters.VirtualNetworkTapPropertiesFormat.DestinationNetworkInterfaceIPConfiguration = &network.InterfaceIPConfiguration{
+			ID: utils.String(networkInterfaceIPConfigurationId),
+		}
+	}
+
+	if loadBalancerFrontendIPConfigurationId != "" {
+		parameters.VirtualNetworkTapPropertiesFormat.DestinationLoadBalancerFrontEndIPConfiguration = &network.FrontendIPConfiguration{
+			ID: utils.String(loadBalancerFrontendIPConfigurationId),
+		}
+	}
+
+	if v, ok := d.GetOk("destination_port"); ok {
+		parameters.VirtualNetworkTapPropertiesFormat.DestinationPort = utils.Int32(int32(v.(int)))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Virtual Network Tap %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Virtual Network Tap %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Network Tap %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for Virtual Network Tap %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmVirtualNetworkTapRead(d, meta)
+}
+
+func resourceArmVirtualNetworkTapRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualNetworkTapsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseVirtualNetworkTapID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Virtual Network Tap %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Network Tap %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.VirtualNetworkTapPropertiesFormat; props != nil {
+		networkInterfaceIPConfigurationId := ""
+		if config := props.DestinationNetworkInterfaceIPConfiguration; config != nil && config.ID != nil {
+			networkInterfaceIPConfigurationId = *config.ID
+		}
+		d.Set("destination_network_interface_ip_configuration_id", networkInterfaceIPConfigurationId)
+
+		loadBalancerFrontendIPConfigurationId := ""
+		if config := props.DestinationLoadBalancerFrontEndIPConfiguration; config != nil && config.ID != nil {
+			loadBalancerFrontendIPConfigurationId = *config.ID
+		}
+		d.Set("destination_load_balancer_frontend_ip_configuration_id", loadBalancerFrontendIPConfigurationId)
+
+		destinationPort := 0
+		if props.DestinationPort != nil {
+			destinationPort = int(*props.DestinationPort)
+		}
+		d.Set("destination_port", destinationPort)
+		d.Set("resource_guid", props.ResourceGUID)
+
+		if err := d.Set("network_interface_tap_configuration_ids", flattenArmVirtualNetworkTapConfigurationIds(props.NetworkInterfaceTapConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `network_interface_tap_configuration_ids`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmVirtualNetworkTapDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualNetworkTapsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseVirtualNetworkTapID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, virtualNetworkTapResourceName)
+	defer locks.UnlockByName(id.Name, virtualNetworkTapResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Virtual Network Tap %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Virtual Network Tap %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenArmVirtualNetworkTapConfigurationIds(input *[]network.InterfaceTapConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.ID != nil {
+			results = append(results, *item.ID)
+		}
+	}
+
+	return results
+}
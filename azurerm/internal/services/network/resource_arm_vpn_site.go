@@ -0,0 +1,393 @@
+package network
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVPNSite() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVPNSiteCreateUpdate,
+		Read:   resourceArmVPNSiteRead,
+		Update: resourceArmVPNSiteCreateUpdate,
+		Delete: resourceArmVPNSiteDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseVpnSiteID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"virtual_wan_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: parse.ValidateVirtualWanID,
+			},
+
+			"address_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.CIDR,
+				},
+			},
+
+			"device_model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"device_vendor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"link": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"ip_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.IPv4Address,
+						},
+
+						"provider_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"speed_in_mbps": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"bgp": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"asn": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+
+									"peering_address": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.IPv4Address,
+									},
+								},
+							},
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmVPNSiteCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VpnSitesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_vpn_site", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	properties := network.VpnSiteProperties{
+		VirtualWan: &network.SubResource{
+			ID: utils.String(d.Get("virtual_wan_id").(string)),
+		},
+		DeviceProperties: &network.DeviceProperties{},
+		AddressSpace: &network.AddressSpace{
+			AddressPrefixes: utils.ExpandStringSlice(d.Get("address_cidrs").(*schema.Set).List()),
+		},
+		VpnSiteLinks: expandArmVPNSiteLinks(d.Get("link").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("device_model"); ok {
+		properties.DeviceProperties.DeviceModel = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("device_vendor"); ok {
+		properties.DeviceProperties.DeviceVendor = utils.String(v.(string))
+	}
+
+	parameters := network.VpnSite{
+		Location:          utils.String(location),
+		VpnSiteProperties: &properties,
+		Tags:              tags.Expand(t),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for VPN Site %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmVPNSiteRead(d, meta)
+}
+
+func resourceArmVPNSiteRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VpnSitesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseVpnSiteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] VPN Site %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving VPN Site %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.VpnSiteProperties; props != nil {
+		virtualWanId := ""
+		if props.VirtualWan != nil && props.VirtualWan.ID != nil {
+			virtualWanId = *props.VirtualWan.ID
+		}
+		d.Set("virtual_wan_id", virtualWanId)
+
+		var addressCidrs []interface{}
+		if space := props.AddressSpace; space != nil {
+			addressCidrs = utils.FlattenStringSlice(space.AddressPrefixes)
+		}
+		if err := d.Set("address_cidrs", addressCidrs); err != nil {
+			return fmt.Errorf("Error setting `address_cidrs`: %+v", err)
+		}
+
+		if device := props.DeviceProperties; device != nil {
+			d.Set("device_model", device.DeviceModel)
+			d.Set("device_vendor", device.DeviceVendor)
+		}
+
+		if err := d.Set("link", flattenArmVPNSiteLinks(props.VpnSiteLinks)); err != nil {
+			return fmt.Errorf("Error setting `link`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmVPNSiteDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VpnSitesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseVpnSiteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting VPN Site %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of VPN Site %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmVPNSiteLinks(input []interface{}) *[]network.VpnSiteLink {
+	links := make([]network.VpnSiteLink, 0)
+
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		link := v.(map[string]interface{})
+		properties := network.VpnSiteLinkProperties{
+			IPAddress: utils.String(link["ip_address"].(string)),
+			LinkProperties: &network.VpnLinkProviderProperties{
+				LinkSpeedInMbps: utils.Int32(int32(link["speed_in_mbps"].(int))),
+			},
+		}
+
+		if providerName := link["provider_name"].(string); providerName != "" {
+			properties.LinkProperties.LinkProviderName = utils.String(providerName)
+		}
+
+		if bgp := link["bgp"].([]interface{}); len(bgp) > 0 && bgp[0] != nil {
+			settings := bgp[0].(map[string]interface{})
+			properties.BgpProperties = &network.VpnLinkBgpSettings{
+				Asn:               utils.Int64(int64(settings["asn"].(int))),
+				BgpPeeringAddress: utils.String(settings["peering_address"].(string)),
+			}
+		}
+
+		links = append(links, network.VpnSiteLink{
+			Name:                  utils.String(link["name"].(string)),
+			VpnSiteLinkProperties: &properties,
+		})
+	}
+
+	return &links
+}
+
+func flattenArmVPNSiteLinks(input *[]network.VpnSiteLink) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, link := range *input {
+		id := ""
+		if link.ID != nil {
+			id = *link.ID
+		}
+
+		name := ""
+		if link.Name != nil {
+			name = *link.Name
+		}
+
+		ipAddress := ""
+		providerName := ""
+		speedInMbps := 0
+		bgp := make([]interface{}, 0)
+		if props := link.VpnSiteLinkProperties; props != nil {
+			if props.IPAddress != nil {
+				ipAddress = *props.IPAddress
+			}
+
+			if linkProps := props.LinkProperties; linkProps != nil {
+				if linkProps.LinkProviderName != nil {
+					providerName = *linkProps.LinkProviderName
+				}
+				if linkProps.LinkSpeedInMbps != nil {
+					speedInMbps = int(*linkProps.LinkSpeedInMbps)
+				}
+			}
+
+			if bgpProps := props.BgpProperties; bgpProps != nil {
+				asn := 0
+				if bgpProps.Asn != nil {
+					asn = int(*bgpProps.Asn)
+				}
+
+				peeringAddress := ""
+				if bgpProps.BgpPeeringAddress != nil {
+					peeringAddress = *bgpProps.BgpPeeringAddress
+				}
+
+				bgp = append(bgp, map[string]interface{}{
+					"asn":             asn,
+					"peering_address": peeringAddress,
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":            id,
+			"name":          name,
+			"ip_address":    ipAddress,
+			"provider_name": providerName,
+			"speed_in_mbps": speedInMbps,
+			"bgp":           bgp,
+		})
+	}
+
+	return results
+}
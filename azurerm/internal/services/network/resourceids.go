@@ -0,0 +1,31 @@
+package network
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=BastionHost -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/bastionHosts/bastion1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=ExpressRouteCircuitConnection -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/expressRouteCircuits/circuit1/peerings/AzurePrivatePeering/connections/connection1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=ExpressRouteCircuitPeering -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/expressRouteCircuits/circuit1/peerings/AzurePrivatePeering
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=ExpressRouteGateway -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/expressRouteGateways/gateway1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=ExpressRoutePort -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/expressRoutePorts/port1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=FirewallPolicy -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/firewallPolicies/policy1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=FirewallPolicyRuleGroup -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/firewallPolicies/policy1/ruleGroups/group1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=LoadBalancer -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=NatGateway -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/natGateways/gateway1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=NetworkInterface -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/nic1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=NetworkInterfaceTapConfiguration -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/nic1/tapConfigurations/config1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=NetworkSecurityGroup -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkSecurityGroups/group1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=PointToSiteVpnGateway -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/p2sVpnGateways/gateway1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=PrivateEndpoint -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateEndpoints/endpoint1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=PrivateLinkService -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateLinkServices/service1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=PublicIPAddress -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/publicIPAddresses/publicIP1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=PublicIPPrefix -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/publicIPPrefixes/prefix1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=RouteFilter -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/routeFilters/filter1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=RouteTable -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/routeTables/routeTable1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=ServiceEndpointPolicy -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/serviceEndpointPolicies/policy1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=ServiceEndpointPolicyDefinition -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/serviceEndpointPolicies/policy1/serviceEndpointPolicyDefinitions/definition1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=Subnet -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=VirtualHub -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/hub1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=VirtualHubConnection -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/hub1/hubVirtualNetworkConnections/connection1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=VirtualNetwork -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=VirtualNetworkTap -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkTaps/tap1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=VirtualWan -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualWans/wan1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=VpnGateway -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/vpnGateways/gateway1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=VpnSite -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/vpnSites/site1
@@ -0,0 +1,44 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+)
+
+func TestAccDataSourceAzureRMPrivateEndpoint_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_private_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePrivateEndpoint_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "subnet_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "private_service_connection.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "private_service_connection.0.status", "Approved"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "private_service_connection.0.private_ip_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePrivateEndpoint_basic(rInt int, location string) string {
+	config := testAccAzureRMPrivateEndpoint_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_endpoint" "test" {
+  name                = azurerm_private_endpoint.test.name
+  resource_group_name = azurerm_private_endpoint.test.resource_group_name
+}
+`, config)
+}
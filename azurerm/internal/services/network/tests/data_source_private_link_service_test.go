@@ -0,0 +1,44 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+)
+
+func TestAccDataSourceAzureRMPrivateLinkService_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_private_link_service.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePrivateLinkService_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "nat_ip_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "nat_ip_configuration.0.primary", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "load_balancer_frontend_ip_configuration_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "alias"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePrivateLinkService_basic(rInt int, location string) string {
+	config := testAccAzureRMPrivateLinkService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_link_service" "test" {
+  name                = azurerm_private_link_service.test.name
+  resource_group_name = azurerm_private_link_service.test.resource_group_name
+}
+`, config)
+}
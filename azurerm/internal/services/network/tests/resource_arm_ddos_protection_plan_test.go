@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -14,11 +16,11 @@ import (
 func testAccAzureRMDDoSProtectionPlan_basic(t *testing.T) {
 	resourceName := "azurerm_ddos_protection_plan.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMDDoSProtectionPlanDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -45,11 +47,11 @@ func testAccAzureRMDDoSProtectionPlan_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_ddos_protection_plan.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMDDoSProtectionPlanDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -60,7 +62,7 @@ func testAccAzureRMDDoSProtectionPlan_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMDDoSProtectionPlan_requiresImportConfig(ri, location),
-				ExpectError: testRequiresImportError("azurerm_ddos_protection_plan"),
+				ExpectError: acceptance.RequiresImportError("azurerm_ddos_protection_plan"),
 			},
 		},
 	})
@@ -69,11 +71,11 @@ func testAccAzureRMDDoSProtectionPlan_requiresImport(t *testing.T) {
 func testAccAzureRMDDoSProtectionPlan_withTags(t *testing.T) {
 	resourceName := "azurerm_ddos_protection_plan.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMDDoSProtectionPlanDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -105,11 +107,11 @@ func testAccAzureRMDDoSProtectionPlan_withTags(t *testing.T) {
 func testAccAzureRMDDoSProtectionPlan_disappears(t *testing.T) {
 	resourceName := "azurerm_ddos_protection_plan.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMDDoSProtectionPlanDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -138,8 +140,8 @@ func testCheckAzureRMDDoSProtectionPlanExists(resourceName string) resource.Test
 			return fmt.Errorf("Bad: no resource group found in state for DDoS Protection Plan: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).network.DDOSProtectionPlansClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.DDOSProtectionPlansClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 		resp, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if utils.ResponseWasNotFound(resp.Response) {
@@ -167,8 +169,8 @@ func testCheckAzureRMDDoSProtectionPlanDisappears(resourceName string) resource.
 			return fmt.Errorf("Bad: no resource group found in state for DDoS Protection Plan: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).network.DDOSProtectionPlansClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.DDOSProtectionPlansClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 		future, err := client.Delete(ctx, resourceGroup, name)
 		if err != nil {
 			return fmt.Errorf("Bad: Delete on ddosProtectionPlanClient: %+v", err)
@@ -183,8 +185,8 @@ func testCheckAzureRMDDoSProtectionPlanDisappears(resourceName string) resource.
 }
 
 func testCheckAzureRMDDoSProtectionPlanDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).network.DDOSProtectionPlansClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Network.DDOSProtectionPlansClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_ddos_protection_plan" {
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -17,12 +19,12 @@ func testAccAzureRMExpressRouteCircuitAuthorization_basic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMExpressRouteCircuitAuthorizationDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMExpressRouteCircuitAuthorization_basicConfig(ri, testLocation()),
+				Config: testAccAzureRMExpressRouteCircuitAuthorization_basicConfig(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMExpressRouteCircuitAuthorizationExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "authorization_key"),
@@ -46,11 +48,11 @@ func testAccAzureRMExpressRouteCircuitAuthorization_requiresImport(t *testing.T)
 	resourceName := "azurerm_express_route_circuit_authorization.test"
 	ri := tf.AccRandTimeInt()
 
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMExpressRouteCircuitAuthorizationDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -62,7 +64,7 @@ func testAccAzureRMExpressRouteCircuitAuthorization_requiresImport(t *testing.T)
 			},
 			{
 				Config:      testAccAzureRMExpressRouteCircuitAuthorization_requiresImportConfig(ri, location),
-				ExpectError: testRequiresImportError("azurerm_express_route_circuit_authorization"),
+				ExpectError: acceptance.RequiresImportError("azurerm_express_route_circuit_authorization"),
 			},
 		},
 	})
@@ -74,12 +76,12 @@ func testAccAzureRMExpressRouteCircuitAuthorization_multiple(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMExpressRouteCircuitAuthorizationDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMExpressRouteCircuitAuthorization_multipleConfig(ri, testLocation()),
+				Config: testAccAzureRMExpressRouteCircuitAuthorization_multipleConfig(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMExpressRouteCircuitAuthorizationExists(firstResourceName),
 					resource.TestCheckResourceAttrSet(firstResourceName, "authorization_key"),
@@ -105,8 +107,8 @@ func testCheckAzureRMExpressRouteCircuitAuthorizationExists(resourceName string)
 			return fmt.Errorf("Bad: no resource group found in state for Express Route Circuit Authorization: %s", expressRouteCircuitName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).network.ExpressRouteAuthsClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.ExpressRouteAuthsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, expressRouteCircuitName, authorizationName)
 		if err != nil {
@@ -122,8 +124,8 @@ func testCheckAzureRMExpressRouteCircuitAuthorizationExists(resourceName string)
 }
 
 func testCheckAzureRMExpressRouteCircuitAuthorizationDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).network.ExpressRouteAuthsClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Network.ExpressRouteAuthsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_express_route_circuit" {
@@ -0,0 +1,203 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func testAccAzureRMExpressRouteCircuitConnection_basic(t *testing.T) {
+	resourceName := "azurerm_express_route_circuit_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteCircuitConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRouteCircuitConnection_basicConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteCircuitConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "address_prefix", "192.169.8.0/29"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMExpressRouteCircuitConnection_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_express_route_circuit_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteCircuitConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRouteCircuitConnection_basicConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteCircuitConnectionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMExpressRouteCircuitConnection_requiresImportConfig(ri, location),
+				ExpectError: acceptance.RequiresImportError("azurerm_express_route_circuit_connection"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMExpressRouteCircuitConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("ExpressRoute Circuit Connection not found: %s", resourceName)
+		}
+
+		id, err := parse.ParseExpressRouteCircuitConnectionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.ExpressRouteCircuitConnectionsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.ExpressRouteCircuitName, id.PeeringName, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Connection %q (ExpressRoute Circuit %q / Peering %q / Resource Group %q) does not exist", id.Name, id.ExpressRouteCircuitName, id.PeeringName, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.ExpressRouteCircuitConnectionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMExpressRouteCircuitConnectionDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Network.ExpressRouteCircuitConnectionsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_express_route_circuit_connection" {
+			continue
+		}
+
+		id, err := parse.ParseExpressRouteCircuitConnectionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.ExpressRouteCircuitName, id.PeeringName, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.ExpressRouteCircuitConnectionsClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("Connection %q (ExpressRoute Circuit %q / Peering %q / Resource Group %q) still exists", id.Name, id.ExpressRouteCircuitName, id.PeeringName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMExpressRouteCircuitConnection_basicConfig(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_express_route_circuit" "test" {
+  name                  = "acctest-erc-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  service_provider_name = "Equinix"
+  peering_location      = "Silicon Valley"
+  bandwidth_in_mbps     = 50
+
+  sku {
+    tier   = "Premium"
+    family = "MeteredData"
+  }
+}
+
+resource "azurerm_express_route_circuit_peering" "test" {
+  peering_type                  = "AzurePrivatePeering"
+  express_route_circuit_name    = "${azurerm_express_route_circuit.test.name}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  shared_key                    = "SSSSsssssshhhhhItsASecret"
+  peer_asn                      = 100
+  primary_peer_address_prefix   = "192.168.1.0/30"
+  secondary_peer_address_prefix = "192.168.2.0/30"
+  vlan_id                       = 100
+}
+
+resource "azurerm_express_route_circuit" "peer" {
+  name                  = "acctest-erc-peer-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  service_provider_name = "Equinix"
+  peering_location      = "Washington DC"
+  bandwidth_in_mbps     = 50
+
+  sku {
+    tier   = "Premium"
+    family = "MeteredData"
+  }
+}
+
+resource "azurerm_express_route_circuit_peering" "peer" {
+  peering_type                  = "AzurePrivatePeering"
+  express_route_circuit_name    = "${azurerm_express_route_circuit.peer.name}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  shared_key                    = "SSSSsssssshhhhhItsASecret"
+  peer_asn                      = 100
+  primary_peer_address_prefix   = "192.168.3.0/30"
+  secondary_peer_address_prefix = "192.168.4.0/30"
+  vlan_id                       = 200
+}
+
+resource "azurerm_express_route_circuit_connection" "test" {
+  name            = "acctest-ercc-%d"
+  peering_id      = "${azurerm_express_route_circuit_peering.test.id}"
+  peer_peering_id = "${azurerm_express_route_circuit_peering.peer.id}"
+  address_prefix  = "192.169.8.0/29"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMExpressRouteCircuitConnection_requiresImportConfig(rInt int, location string) string {
+	template := testAccAzureRMExpressRouteCircuitConnection_basicConfig(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_circuit_connection" "import" {
+  name            = "${azurerm_express_route_circuit_connection.test.name}"
+  peering_id      = "${azurerm_express_route_circuit_connection.test.peering_id}"
+  peer_peering_id = "${azurerm_express_route_circuit_connection.test.peer_peering_id}"
+  address_prefix  = "${azurerm_express_route_circuit_connection.test.address_prefix}"
+}
+`, template)
+}
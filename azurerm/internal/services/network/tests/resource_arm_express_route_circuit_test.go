@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -26,6 +28,7 @@ func TestAccAzureRMExpressRouteCircuit(t *testing.T) {
 			"premiumUnlimited":             testAccAzureRMExpressRouteCircuit_premiumUnlimited,
 			"allowClassicOperationsUpdate": testAccAzureRMExpressRouteCircuit_allowClassicOperationsUpdate,
 			"requiresImport":               testAccAzureRMExpressRouteCircuit_requiresImport,
+			"withExpressRoutePort":         testAccAzureRMExpressRouteCircuit_withExpressRoutePort,
 			"data_basic":                   testAccDataSourceAzureRMExpressRoute_basicMetered,
 		},
 		"PrivatePeering": {
@@ -34,7 +37,12 @@ func TestAccAzureRMExpressRouteCircuit(t *testing.T) {
 			"requiresImport":                testAccAzureRMExpressRouteCircuitPeering_requiresImport,
 		},
 		"MicrosoftPeering": {
-			"microsoftPeering": testAccAzureRMExpressRouteCircuitPeering_microsoftPeering,
+			"microsoftPeering":                testAccAzureRMExpressRouteCircuitPeering_microsoftPeering,
+			"microsoftPeeringWithRouteFilter": testAccAzureRMExpressRouteCircuitPeering_microsoftPeeringWithRouteFilter,
+		},
+		"connection": {
+			"basic":          testAccAzureRMExpressRouteCircuitConnection_basic,
+			"requiresImport": testAccAzureRMExpressRouteCircuitConnection_requiresImport,
 		},
 		"authorization": {
 			"basic":          testAccAzureRMExpressRouteCircuitAuthorization_basic,
@@ -62,12 +70,12 @@ func testAccAzureRMExpressRouteCircuit_basicMetered(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMExpressRouteCircuitDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMExpressRouteCircuit_basicMeteredConfig(ri, testLocation()),
+				Config: testAccAzureRMExpressRouteCircuit_basicMeteredConfig(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMExpressRouteCircuitExists(resourceName, &erc),
 				),
@@ -91,11 +99,11 @@ func testAccAzureRMExpressRouteCircuit_requiresImport(t *testing.T) {
 	var erc network.ExpressRouteCircuit
 	ri := tf.AccRandTimeInt()
 
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMExpressRouteCircuitDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -106,7 +114,7 @@ func testAccAzureRMExpressRouteCircuit_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMExpressRouteCircuit_requiresImportConfig(ri, location),
-				ExpectError: testRequiresImportError("azurerm_express_route_circuit"),
+				ExpectError: acceptance.RequiresImportError("azurerm_express_route_circuit"),
 			},
 		},
 	})
@@ -118,12 +126,12 @@ func testAccAzureRMExpressRouteCircuit_basicUnlimited(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMExpressRouteCircuitDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMExpressRouteCircuit_basicUnlimitedConfig(ri, testLocation()),
+				Config: testAccAzureRMExpressRouteCircuit_basicUnlimitedConfig(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMExpressRouteCircuitExists(resourceName, &erc),
 				),
@@ -143,19 +151,19 @@ func testAccAzureRMExpressRouteCircuit_update(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMExpressRouteCircuitDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMExpressRouteCircuit_basicMeteredConfig(ri, testLocation()),
+				Config: testAccAzureRMExpressRouteCircuit_basicMeteredConfig(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMExpressRouteCircuitExists(resourceName, &erc),
 					resource.TestCheckResourceAttr(resourceName, "sku.0.family", "MeteredData"),
 				),
 			},
 			{
-				Config: testAccAzureRMExpressRouteCircuit_basicUnlimitedConfig(ri, testLocation()),
+				Config: testAccAzureRMExpressRouteCircuit_basicUnlimitedConfig(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMExpressRouteCircuitExists(resourceName, &erc),
 					resource.TestCheckResourceAttr(resourceName, "sku.0.family", "UnlimitedData"),
@@ -171,19 +179,19 @@ func testAccAzureRMExpressRouteCircuit_tierUpdate(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMExpressRouteCircuitDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMExpressRouteCircuit_sku(ri, testLocation(), "Standard", "MeteredData"),
+				Config: testAccAzureRMExpressRouteCircuit_sku(ri, acceptance.Location(), "Standard", "MeteredData"),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMExpressRouteCircuitExists("azurerm_express_route_circuit.test", &erc),
 					resource.TestCheckResourceAttr(resourceName, "sku.0.tier", "Standard"),
 				),
 			},
 			{
-				Config: testAccAzureRMExpressRouteCircuit_sku(ri, testLocation(), "Premium", "MeteredData"),
+				Config: testAccAzureRMExpressRouteCircuit_sku(ri, acceptance.Location(), "Premium", "MeteredData"),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMExpressRouteCircuitExists("azurerm_express_route_circuit.test", &erc),
 					resource.TestCheckResourceAttr(resourceName, "sku.0.tier", "Premium"),
@@ -199,12 +207,12 @@ func testAccAzureRMExpressRouteCircuit_premiumMetered(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMExpressRouteCircuitDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMExpressRouteCircuit_sku(ri, testLocation(), "Premium", "MeteredData"),
+				Config: testAccAzureRMExpressRouteCircuit_sku(ri, acceptance.Location(), "Premium", "MeteredData"),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMExpressRouteCircuitExists("azurerm_express_route_circuit.test", &erc),
 					resource.TestCheckResourceAttr(resourceName, "sku.0.tier", "Premium"),
@@ -226,12 +234,12 @@ func testAccAzureRMExpressRouteCircuit_premiumUnlimited(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMExpressRouteCircuitDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMExpressRouteCircuit_sku(ri, testLocation(), "Premium", "UnlimitedData"),
+				Config: testAccAzureRMExpressRouteCircuit_sku(ri, acceptance.Location(), "Premium", "UnlimitedData"),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMExpressRouteCircuitExists("azurerm_express_route_circuit.test", &erc),
 					resource.TestCheckResourceAttr(resourceName, "sku.0.tier", "Premium"),
@@ -253,19 +261,19 @@ func testAccAzureRMExpressRouteCircuit_allowClassicOperationsUpdate(t *testing.T
 	ri := tf.AccRandTimeInt()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMExpressRouteCircuitDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMExpressRouteCircuit_allowClassicOperations(ri, testLocation(), "false"),
+				Config: testAccAzureRMExpressRouteCircuit_allowClassicOperations(ri, acceptance.Location(), "false"),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMExpressRouteCircuitExists("azurerm_express_route_circuit.test", &erc),
 					resource.TestCheckResourceAttr(resourceName, "allow_classic_operations", "false"),
 				),
 			},
 			{
-				Config: testAccAzureRMExpressRouteCircuit_allowClassicOperations(ri, testLocation(), "true"),
+				Config: testAccAzureRMExpressRouteCircuit_allowClassicOperations(ri, acceptance.Location(), "true"),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMExpressRouteCircuitExists("azurerm_express_route_circuit.test", &erc),
 					resource.TestCheckResourceAttr(resourceName, "allow_classic_operations", "true"),
@@ -275,6 +283,39 @@ func testAccAzureRMExpressRouteCircuit_allowClassicOperationsUpdate(t *testing.T
 	})
 }
 
+func testAccAzureRMExpressRouteCircuit_withExpressRoutePort(t *testing.T) {
+	resourceName := "azurerm_express_route_circuit.test"
+	var erc network.ExpressRouteCircuit
+	ri := tf.AccRandTimeInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteCircuitDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRouteCircuit_withExpressRoutePortConfig(ri, acceptance.Location(), 5),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteCircuitExists(resourceName, &erc),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_in_gbps", "5"),
+				),
+			},
+			{
+				Config: testAccAzureRMExpressRouteCircuit_withExpressRoutePortConfig(ri, acceptance.Location(), 10),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteCircuitExists(resourceName, &erc),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_in_gbps", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMExpressRouteCircuitExists(resourceName string, erc *network.ExpressRouteCircuit) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -288,8 +329,8 @@ func testCheckAzureRMExpressRouteCircuitExists(resourceName string, erc *network
 			return fmt.Errorf("Bad: no resource group found in state for Express Route Circuit: %s", expressRouteCircuitName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).network.ExpressRouteCircuitsClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.ExpressRouteCircuitsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, expressRouteCircuitName)
 		if err != nil {
@@ -307,8 +348,8 @@ func testCheckAzureRMExpressRouteCircuitExists(resourceName string, erc *network
 }
 
 func testCheckAzureRMExpressRouteCircuitDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).network.ExpressRouteCircuitsClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Network.ExpressRouteCircuitsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_express_route_circuit" {
@@ -479,3 +520,34 @@ resource "azurerm_express_route_circuit" "test" {
 }
 `, rInt, location, rInt, allowClassicOperations)
 }
+
+func testAccAzureRMExpressRouteCircuit_withExpressRoutePortConfig(rInt int, location string, bandwidth int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctest-erp-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  peering_location    = "Airtel-Chennai2-CLS"
+  bandwidth_in_gbps   = 10
+  encapsulation       = "Dot1Q"
+}
+
+resource "azurerm_express_route_circuit" "test" {
+  name                  = "acctest-erc-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  express_route_port_id = "${azurerm_express_route_port.test.id}"
+  bandwidth_in_gbps     = %d
+
+  sku {
+    tier   = "Standard"
+    family = "MeteredData"
+  }
+}
+`, rInt, location, rInt, rInt, bandwidth)
+}
@@ -0,0 +1,231 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMExpressRoutePort_basic(t *testing.T) {
+	resourceName := "azurerm_express_route_port.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMExpressRoutePortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRoutePort_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRoutePortExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "encapsulation", "Dot1Q"),
+					resource.TestCheckResourceAttr(resourceName, "link1.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "link2.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "ethertype"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMExpressRoutePort_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_express_route_port.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMExpressRoutePortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRoutePort_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRoutePortExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMExpressRoutePort_requiresImport(ri, location),
+				ExpectError: acceptance.RequiresImportError("azurerm_express_route_port"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMExpressRoutePort_update(t *testing.T) {
+	resourceName := "azurerm_express_route_port.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMExpressRoutePortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRoutePort_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRoutePortExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "link1.0.admin_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMExpressRoutePort_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRoutePortExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "link1.0.admin_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "link2.0.admin_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMExpressRoutePort_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRoutePortExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMExpressRoutePortExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("ExpressRoute Port not found: %s", resourceName)
+		}
+
+		id, err := parse.ParseExpressRoutePortID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.ExpressRoutePortsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: ExpressRoute Port %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.ExpressRoutePortsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMExpressRoutePortDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Network.ExpressRoutePortsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_express_route_port" {
+			continue
+		}
+
+		id, err := parse.ParseExpressRoutePortID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.ExpressRoutePortsClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("ExpressRoute Port %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMExpressRoutePort_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctest-erp-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  peering_location    = "Airtel-Chennai2-CLS"
+  bandwidth_in_gbps   = 10
+  encapsulation       = "Dot1Q"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMExpressRoutePort_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMExpressRoutePort_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_port" "import" {
+  name                = azurerm_express_route_port.test.name
+  resource_group_name = azurerm_express_route_port.test.resource_group_name
+  location            = azurerm_express_route_port.test.location
+  peering_location    = azurerm_express_route_port.test.peering_location
+  bandwidth_in_gbps   = azurerm_express_route_port.test.bandwidth_in_gbps
+  encapsulation       = azurerm_express_route_port.test.encapsulation
+}
+`, template)
+}
+
+func testAccAzureRMExpressRoutePort_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctest-erp-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  peering_location    = "Airtel-Chennai2-CLS"
+  bandwidth_in_gbps   = 10
+  encapsulation       = "Dot1Q"
+
+  link1 {
+    admin_enabled = true
+  }
+
+  link2 {
+    admin_enabled = true
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, rInt, location, rInt)
+}
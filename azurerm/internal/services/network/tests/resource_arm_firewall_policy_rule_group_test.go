@@ -0,0 +1,259 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMFirewallPolicyRuleGroup_basic(t *testing.T) {
+	resourceName := "azurerm_firewall_policy_rule_group.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMFirewallPolicyRuleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallPolicyRuleGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallPolicyRuleGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "500"),
+					resource.TestCheckResourceAttr(resourceName, "filter_rule.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewallPolicyRuleGroup_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_firewall_policy_rule_group.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMFirewallPolicyRuleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallPolicyRuleGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallPolicyRuleGroupExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMFirewallPolicyRuleGroup_requiresImport(ri, location),
+				ExpectError: acceptance.RequiresImportError("azurerm_firewall_policy_rule_group"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewallPolicyRuleGroup_update(t *testing.T) {
+	resourceName := "azurerm_firewall_policy_rule_group.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMFirewallPolicyRuleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallPolicyRuleGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallPolicyRuleGroupExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewallPolicyRuleGroup_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallPolicyRuleGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "600"),
+					resource.TestCheckResourceAttr(resourceName, "filter_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "filter_rule.1.application_condition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "nat_rule.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMFirewallPolicyRuleGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Firewall Policy Rule Group not found: %s", resourceName)
+		}
+
+		id, err := parse.ParseFirewallPolicyRuleGroupID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.FirewallPolicyRuleGroupsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Rule Group %q (Firewall Policy %q / Resource Group %q) does not exist", id.Name, id.FirewallPolicyName, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.FirewallPolicyRuleGroupsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMFirewallPolicyRuleGroupDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Network.FirewallPolicyRuleGroupsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_firewall_policy_rule_group" {
+			continue
+		}
+
+		id, err := parse.ParseFirewallPolicyRuleGroupID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.FirewallPolicyRuleGroupsClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("Rule Group %q (Firewall Policy %q / Resource Group %q) still exists", id.Name, id.FirewallPolicyName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMFirewallPolicyRuleGroup_basic(rInt int, location string) string {
+	template := testAccAzureRMFirewallPolicy_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule_group" "test" {
+  name               = "acctest-fwpolicy-rg-%d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+
+  filter_rule {
+    name     = "network"
+    priority = 100
+    action   = "Allow"
+
+    network_condition {
+      name                  = "condition1"
+      protocols             = ["TCP"]
+      source_addresses      = ["10.0.0.0/16"]
+      destination_addresses = ["8.8.8.8"]
+      destination_ports     = ["53"]
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMFirewallPolicyRuleGroup_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMFirewallPolicyRuleGroup_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule_group" "import" {
+  name               = azurerm_firewall_policy_rule_group.test.name
+  firewall_policy_id = azurerm_firewall_policy_rule_group.test.firewall_policy_id
+  priority           = azurerm_firewall_policy_rule_group.test.priority
+}
+`, template)
+}
+
+func testAccAzureRMFirewallPolicyRuleGroup_complete(rInt int, location string) string {
+	template := testAccAzureRMFirewallPolicy_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule_group" "test" {
+  name               = "acctest-fwpolicy-rg-%d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 600
+
+  filter_rule {
+    name     = "network"
+    priority = 100
+    action   = "Allow"
+
+    network_condition {
+      name                  = "condition1"
+      description           = "Allow DNS"
+      protocols             = ["TCP", "UDP"]
+      source_addresses      = ["10.0.0.0/16"]
+      destination_addresses = ["8.8.8.8", "8.8.4.4"]
+      destination_ports     = ["53"]
+    }
+  }
+
+  filter_rule {
+    name     = "application"
+    priority = 200
+    action   = "Deny"
+
+    application_condition {
+      name             = "condition1"
+      source_addresses = ["10.0.0.0/16"]
+      target_fqdns     = ["*.example.com"]
+
+      protocol {
+        type = "Https"
+        port = 443
+      }
+    }
+  }
+
+  nat_rule {
+    name               = "nat"
+    priority           = 300
+    translated_address = "10.0.0.5"
+    translated_port    = "8080"
+
+    network_condition {
+      name                  = "condition1"
+      protocols             = ["TCP"]
+      source_addresses      = ["*"]
+      destination_addresses = ["1.2.3.4"]
+      destination_ports     = ["80"]
+    }
+  }
+}
+`, template, rInt)
+}
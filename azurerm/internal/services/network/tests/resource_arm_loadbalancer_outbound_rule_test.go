@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -10,7 +10,10 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 )
 
 func TestAccAzureRMLoadBalancerOutboundRule_basic(t *testing.T) {
@@ -24,12 +27,12 @@ func TestAccAzureRMLoadBalancerOutboundRule_basic(t *testing.T) {
 		subscriptionID, ri, ri, outboundRuleName)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMLoadBalancerOutboundRule_basic(ri, outboundRuleName, testLocation()),
+				Config: testAccAzureRMLoadBalancerOutboundRule_basic(ri, outboundRuleName, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
 					testCheckAzureRMLoadBalancerOutboundRuleExists(outboundRuleName, &lb),
@@ -57,7 +60,7 @@ func TestAccAzureRMLoadBalancerOutboundRule_requiresImport(t *testing.T) {
 	var lb network.LoadBalancer
 	ri := tf.AccRandTimeInt()
 	outboundRuleName := fmt.Sprintf("OutboundRule-%d", ri)
-	location := testLocation()
+	location := acceptance.Location()
 
 	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
 	outboundRuleId := fmt.Sprintf(
@@ -65,8 +68,8 @@ func TestAccAzureRMLoadBalancerOutboundRule_requiresImport(t *testing.T) {
 		subscriptionID, ri, ri, outboundRuleName)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -80,7 +83,7 @@ func TestAccAzureRMLoadBalancerOutboundRule_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMLoadBalancerOutboundRule_requiresImport(ri, outboundRuleName, location),
-				ExpectError: testRequiresImportError("azurerm_lb_outbound_rule"),
+				ExpectError: acceptance.RequiresImportError("azurerm_lb_outbound_rule"),
 			},
 		},
 	})
@@ -92,19 +95,19 @@ func TestAccAzureRMLoadBalancerOutboundRule_removal(t *testing.T) {
 	outboundRuleName := fmt.Sprintf("OutboundRule-%d", ri)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMLoadBalancerOutboundRule_basic(ri, outboundRuleName, testLocation()),
+				Config: testAccAzureRMLoadBalancerOutboundRule_basic(ri, outboundRuleName, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
 					testCheckAzureRMLoadBalancerOutboundRuleExists(outboundRuleName, &lb),
 				),
 			},
 			{
-				Config: testAccAzureRMLoadBalancerOutboundRule_removal(ri, testLocation()),
+				Config: testAccAzureRMLoadBalancerOutboundRule_removal(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
 					testCheckAzureRMLoadBalancerOutboundRuleNotExists(outboundRuleName, &lb),
@@ -121,12 +124,12 @@ func TestAccAzureRMLoadBalancerOutboundRule_update(t *testing.T) {
 	outboundRule2Name := fmt.Sprintf("OutboundRule-%d", tf.AccRandTimeInt())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMLoadBalancerOutboundRule_multipleRules(ri, outboundRuleName, outboundRule2Name, testLocation()),
+				Config: testAccAzureRMLoadBalancerOutboundRule_multipleRules(ri, outboundRuleName, outboundRule2Name, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
 					testCheckAzureRMLoadBalancerOutboundRuleExists(outboundRuleName, &lb),
@@ -135,7 +138,7 @@ func TestAccAzureRMLoadBalancerOutboundRule_update(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccAzureRMLoadBalancerOutboundRule_multipleRulesUpdate(ri, outboundRuleName, outboundRule2Name, testLocation()),
+				Config: testAccAzureRMLoadBalancerOutboundRule_multipleRulesUpdate(ri, outboundRuleName, outboundRule2Name, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
 					testCheckAzureRMLoadBalancerOutboundRuleExists(outboundRuleName, &lb),
@@ -158,12 +161,12 @@ func TestAccAzureRMLoadBalancerOutboundRule_withPublicIPPrefix(t *testing.T) {
 		subscriptionID, ri, ri, outboundRuleName)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMLoadBalancerOutboundRule_withPublicIPPrefix(ri, outboundRuleName, testLocation()),
+				Config: testAccAzureRMLoadBalancerOutboundRule_withPublicIPPrefix(ri, outboundRuleName, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
 					testCheckAzureRMLoadBalancerOutboundRuleExists(outboundRuleName, &lb),
@@ -188,12 +191,12 @@ func TestAccAzureRMLoadBalancerOutboundRule_disappears(t *testing.T) {
 	outboundRuleName := fmt.Sprintf("OutboundRule-%d", ri)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMLoadBalancerOutboundRule_basic(ri, outboundRuleName, testLocation()),
+				Config: testAccAzureRMLoadBalancerOutboundRule_basic(ri, outboundRuleName, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
 					testCheckAzureRMLoadBalancerOutboundRuleExists(outboundRuleName, &lb),
@@ -207,7 +210,7 @@ func TestAccAzureRMLoadBalancerOutboundRule_disappears(t *testing.T) {
 
 func testCheckAzureRMLoadBalancerOutboundRuleExists(outboundRuleName string, lb *network.LoadBalancer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if _, _, exists := findLoadBalancerOutboundRuleByName(lb, outboundRuleName); !exists {
+		if _, _, exists := networkSvc.FindLoadBalancerOutboundRuleByName(lb, outboundRuleName); !exists {
 			return fmt.Errorf("A Load Balancer Outbound Rule with name %q cannot be found.", outboundRuleName)
 		}
 
@@ -217,7 +220,7 @@ func testCheckAzureRMLoadBalancerOutboundRuleExists(outboundRuleName string, lb
 
 func testCheckAzureRMLoadBalancerOutboundRuleNotExists(outboundRuleName string, lb *network.LoadBalancer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if _, _, exists := findLoadBalancerOutboundRuleByName(lb, outboundRuleName); exists {
+		if _, _, exists := networkSvc.FindLoadBalancerOutboundRuleByName(lb, outboundRuleName); exists {
 			return fmt.Errorf("A Load Balancer Outbound Rule with name %q has been found.", outboundRuleName)
 		}
 
@@ -227,10 +230,10 @@ func testCheckAzureRMLoadBalancerOutboundRuleNotExists(outboundRuleName string,
 
 func testCheckAzureRMLoadBalancerOutboundRuleDisappears(ruleName string, lb *network.LoadBalancer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*ArmClient).network.LoadBalancersClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.LoadBalancersClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
-		_, i, exists := findLoadBalancerOutboundRuleByName(lb, ruleName)
+		_, i, exists := networkSvc.FindLoadBalancerOutboundRuleByName(lb, ruleName)
 		if !exists {
 			return fmt.Errorf("A Outbound Rule with name %q cannot be found.", ruleName)
 		}
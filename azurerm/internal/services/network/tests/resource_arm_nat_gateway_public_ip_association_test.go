@@ -0,0 +1,147 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMNatGatewayPublicIpAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_nat_gateway_public_ip_association.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		// intentional since this is a Virtual Resource
+		CheckDestroy: testCheckAzureRMNatGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNatGatewayPublicIpAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNatGatewayPublicIpAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNatGatewayPublicIpAssociation_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_nat_gateway_public_ip_association.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		// intentional since this is a Virtual Resource
+		CheckDestroy: testCheckAzureRMNatGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNatGatewayPublicIpAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNatGatewayPublicIpAssociationExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMNatGatewayPublicIpAssociation_requiresImport(ri, location),
+				ExpectError: acceptance.RequiresImportError("azurerm_nat_gateway_public_ip_association"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNatGatewayPublicIpAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parse.ParseNatGatewayID(rs.Primary.Attributes["nat_gateway_id"])
+		if err != nil {
+			return err
+		}
+		publicIpAddressId := rs.Primary.Attributes["public_ip_address_id"]
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.NatGatewayClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: NAT Gateway %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.NatGatewaysClient: %+v", err)
+		}
+
+		if props := resp.NatGatewayPropertiesFormat; props != nil && props.PublicIPAddresses != nil {
+			for _, publicIp := range *props.PublicIPAddresses {
+				if publicIp.ID != nil && strings.EqualFold(*publicIp.ID, publicIpAddressId) {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("Public IP %q is not associated with NAT Gateway %q (Resource Group %q)", publicIpAddressId, id.Name, id.ResourceGroup)
+	}
+}
+
+func testAccAzureRMNatGatewayPublicIpAssociation_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-nat-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctest-PIP-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_nat_gateway" "test" {
+  name                = "acctest-NatGateway-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_nat_gateway_public_ip_association" "test" {
+  nat_gateway_id       = azurerm_nat_gateway.test.id
+  public_ip_address_id = azurerm_public_ip.test.id
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMNatGatewayPublicIpAssociation_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMNatGatewayPublicIpAssociation_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_nat_gateway_public_ip_association" "import" {
+  nat_gateway_id       = azurerm_nat_gateway_public_ip_association.test.nat_gateway_id
+  public_ip_address_id = azurerm_nat_gateway_public_ip_association.test.public_ip_address_id
+}
+`, template)
+}
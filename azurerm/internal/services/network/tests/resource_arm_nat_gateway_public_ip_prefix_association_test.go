@@ -0,0 +1,146 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMNatGatewayPublicIpPrefixAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_nat_gateway_public_ip_prefix_association.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		// intentional since this is a Virtual Resource
+		CheckDestroy: testCheckAzureRMNatGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNatGatewayPublicIpPrefixAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNatGatewayPublicIpPrefixAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNatGatewayPublicIpPrefixAssociation_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_nat_gateway_public_ip_prefix_association.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		// intentional since this is a Virtual Resource
+		CheckDestroy: testCheckAzureRMNatGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNatGatewayPublicIpPrefixAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNatGatewayPublicIpPrefixAssociationExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMNatGatewayPublicIpPrefixAssociation_requiresImport(ri, location),
+				ExpectError: acceptance.RequiresImportError("azurerm_nat_gateway_public_ip_prefix_association"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNatGatewayPublicIpPrefixAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parse.ParseNatGatewayID(rs.Primary.Attributes["nat_gateway_id"])
+		if err != nil {
+			return err
+		}
+		publicIpPrefixId := rs.Primary.Attributes["public_ip_prefix_id"]
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.NatGatewayClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: NAT Gateway %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.NatGatewaysClient: %+v", err)
+		}
+
+		if props := resp.NatGatewayPropertiesFormat; props != nil && props.PublicIPPrefixes != nil {
+			for _, publicIpPrefix := range *props.PublicIPPrefixes {
+				if publicIpPrefix.ID != nil && strings.EqualFold(*publicIpPrefix.ID, publicIpPrefixId) {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("Public IP Prefix %q is not associated with NAT Gateway %q (Resource Group %q)", publicIpPrefixId, id.Name, id.ResourceGroup)
+	}
+}
+
+func testAccAzureRMNatGatewayPublicIpPrefixAssociation_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-nat-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip_prefix" "test" {
+  name                = "acctest-PIPP-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  prefix_length       = 30
+}
+
+resource "azurerm_nat_gateway" "test" {
+  name                = "acctest-NatGateway-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_nat_gateway_public_ip_prefix_association" "test" {
+  nat_gateway_id      = azurerm_nat_gateway.test.id
+  public_ip_prefix_id = azurerm_public_ip_prefix.test.id
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMNatGatewayPublicIpPrefixAssociation_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMNatGatewayPublicIpPrefixAssociation_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_nat_gateway_public_ip_prefix_association" "import" {
+  nat_gateway_id      = azurerm_nat_gateway_public_ip_prefix_association.test.nat_gateway_id
+  public_ip_prefix_id = azurerm_nat_gateway_public_ip_prefix_association.test.public_ip_prefix_id
+}
+`, template)
+}
@@ -0,0 +1,158 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMNetworkInterfaceTapAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_network_interface_tap_association.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMNetworkInterfaceTapAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkInterfaceTapAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkInterfaceTapAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNetworkInterfaceTapAssociation_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_network_interface_tap_association.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMNetworkInterfaceTapAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkInterfaceTapAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkInterfaceTapAssociationExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMNetworkInterfaceTapAssociation_requiresImport(ri, location),
+				ExpectError: acceptance.RequiresImportError("azurerm_network_interface_tap_association"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNetworkInterfaceTapAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Network Interface Tap Association not found: %s", resourceName)
+		}
+
+		id, err := parse.ParseNetworkInterfaceTapConfigurationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.InterfaceTapConfigurationsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Tap Configuration %q (Network Interface %q / Resource Group %q) does not exist", id.Name, id.NetworkInterfaceName, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.InterfaceTapConfigurationsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMNetworkInterfaceTapAssociationDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Network.InterfaceTapConfigurationsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_network_interface_tap_association" {
+			continue
+		}
+
+		id, err := parse.ParseNetworkInterfaceTapConfigurationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.InterfaceTapConfigurationsClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("Tap Configuration %q (Network Interface %q / Resource Group %q) still exists", id.Name, id.NetworkInterfaceName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMNetworkInterfaceTapAssociation_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualNetworkTap_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_network_interface_tap_association" "test" {
+  network_interface_id   = azurerm_network_interface.test.id
+  virtual_network_tap_id = azurerm_virtual_network_tap.test.id
+}
+`, template, rInt)
+}
+
+func testAccAzureRMNetworkInterfaceTapAssociation_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMNetworkInterfaceTapAssociation_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface_tap_association" "import" {
+  network_interface_id   = azurerm_network_interface_tap_association.test.network_interface_id
+  virtual_network_tap_id = azurerm_network_interface_tap_association.test.virtual_network_tap_id
+}
+`, template)
+}
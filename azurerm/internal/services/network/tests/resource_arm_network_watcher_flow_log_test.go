@@ -0,0 +1,344 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+)
+
+func testAccAzureRMNetworkWatcherFlowLog_basic(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(5)
+	location := acceptance.Location()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(5)
+	location := acceptance.Location()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMNetworkWatcherFlowLog_requiresImportConfig(ri, rs, location),
+				ExpectError: acceptance.RequiresImportError("azurerm_network_watcher_flow_log"),
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_trafficAnalytics(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(5)
+	location := acceptance.Location()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_trafficAnalyticsConfig(ri, rs, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.0.interval_in_minutes", "10"),
+					resource.TestCheckResourceAttrSet(resourceName, "traffic_analytics.0.workspace_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_trafficAnalyticsConfig(ri, rs, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_disabled(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(5)
+	location := acceptance.Location()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_disabledConfig(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNetworkWatcherFlowLogExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.WatcherClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, networkSecurityGroupId, err := network.ParseNetworkWatcherFlowLogID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		watcherName := id.Path["networkWatchers"]
+
+		resp, err := network.RetrieveNetworkWatcherFlowLog(ctx, client, resourceGroup, watcherName, networkSecurityGroupId)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on WatchersClient: %+v", err)
+		}
+
+		if resp == nil {
+			return fmt.Errorf("Bad: Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q) does not exist", networkSecurityGroupId, watcherName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMNetworkWatcherFlowLogDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Network.WatcherClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_network_watcher_flow_log" {
+			continue
+		}
+
+		id, networkSecurityGroupId, err := network.ParseNetworkWatcherFlowLogID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		watcherName := id.Path["networkWatchers"]
+
+		// when the Network Watcher has been removed, so has the Flow Log
+		watcher, err := client.Get(ctx, resourceGroup, watcherName)
+		if err != nil {
+			continue
+		}
+
+		resp, err := network.RetrieveNetworkWatcherFlowLog(ctx, client, resourceGroup, watcherName, networkSecurityGroupId)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on WatchersClient: %+v", err)
+		}
+
+		if resp != nil && resp.FlowLogProperties != nil && resp.FlowLogProperties.Enabled != nil && *resp.FlowLogProperties.Enabled {
+			return fmt.Errorf("Flow Log for Network Security Group %q (Network Watcher %q) is still enabled", networkSecurityGroupId, *watcher.Name)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-watcher-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestNSG-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_network_watcher" "test" {
+  name                = "acctest-NW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_kind             = "StorageV2"
+  account_replication_type = "LRS"
+}
+`, rInt, location, rInt, rInt, rString)
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_basicConfig(rInt int, rString string, location string) string {
+	template := testAccAzureRMNetworkWatcherFlowLog_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name = azurerm_network_watcher.test.name
+  resource_group_name  = azurerm_resource_group.test.name
+
+  network_security_group_id = azurerm_network_security_group.test.id
+  storage_account_id        = azurerm_storage_account.test.id
+  enabled                   = true
+
+  retention_policy {
+    enabled = false
+    days    = 0
+  }
+}
+`, template)
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_requiresImportConfig(rInt int, rString string, location string) string {
+	template := testAccAzureRMNetworkWatcherFlowLog_basicConfig(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_watcher_flow_log" "import" {
+  network_watcher_name = azurerm_network_watcher_flow_log.test.network_watcher_name
+  resource_group_name  = azurerm_network_watcher_flow_log.test.resource_group_name
+
+  network_security_group_id = azurerm_network_watcher_flow_log.test.network_security_group_id
+  storage_account_id        = azurerm_network_watcher_flow_log.test.storage_account_id
+  enabled                   = true
+
+  retention_policy {
+    enabled = false
+    days    = 0
+  }
+}
+`, template)
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_trafficAnalyticsConfig(rInt int, rString string, location string, enabled bool) string {
+	template := testAccAzureRMNetworkWatcherFlowLog_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name = azurerm_network_watcher.test.name
+  resource_group_name  = azurerm_resource_group.test.name
+
+  network_security_group_id = azurerm_network_security_group.test.id
+  storage_account_id        = azurerm_storage_account.test.id
+  enabled                   = true
+  version                   = 2
+
+  retention_policy {
+    enabled = true
+    days    = 7
+  }
+
+  traffic_analytics {
+    enabled               = %t
+    workspace_id          = azurerm_log_analytics_workspace.test.workspace_id
+    workspace_region      = azurerm_log_analytics_workspace.test.location
+    workspace_resource_id = azurerm_log_analytics_workspace.test.id
+    interval_in_minutes   = 10
+  }
+}
+`, template, rInt, enabled)
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_disabledConfig(rInt int, rString string, location string) string {
+	template := testAccAzureRMNetworkWatcherFlowLog_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name = azurerm_network_watcher.test.name
+  resource_group_name  = azurerm_resource_group.test.name
+
+  network_security_group_id = azurerm_network_security_group.test.id
+  storage_account_id        = azurerm_storage_account.test.id
+  enabled                   = false
+
+  retention_policy {
+    enabled = false
+    days    = 0
+  }
+}
+`, template)
+}
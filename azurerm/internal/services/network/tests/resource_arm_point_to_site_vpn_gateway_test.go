@@ -0,0 +1,254 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMPointToSiteVPNGateway_basic(t *testing.T) {
+	resourceName := "azurerm_point_to_site_vpn_gateway.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMPointToSiteVPNGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPointToSiteVPNGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPointToSiteVPNGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_unit", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpn_client_address_pool.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPointToSiteVPNGateway_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_point_to_site_vpn_gateway.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMPointToSiteVPNGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPointToSiteVPNGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPointToSiteVPNGatewayExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMPointToSiteVPNGateway_requiresImport(ri, location),
+				ExpectError: acceptance.RequiresImportError("azurerm_point_to_site_vpn_gateway"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPointToSiteVPNGateway_update(t *testing.T) {
+	resourceName := "azurerm_point_to_site_vpn_gateway.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMPointToSiteVPNGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPointToSiteVPNGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPointToSiteVPNGatewayExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMPointToSiteVPNGateway_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPointToSiteVPNGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_unit", "2"),
+					resource.TestCheckResourceAttr(resourceName, "vpn_client_address_pool.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_route_address_prefixes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPointToSiteVPNGatewayExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Point-to-Site VPN Gateway not found: %s", resourceName)
+		}
+
+		id, err := parse.ParsePointToSiteVpnGatewayID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.PointToSiteVpnGatewaysClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Point-to-Site VPN Gateway %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.P2sVpnGatewaysClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPointToSiteVPNGatewayDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Network.PointToSiteVpnGatewaysClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_point_to_site_vpn_gateway" {
+			continue
+		}
+
+		id, err := parse.ParsePointToSiteVpnGatewayID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.P2sVpnGatewaysClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("Point-to-Site VPN Gateway %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMPointToSiteVPNGateway_basic(rInt int, location string) string {
+	template := testAccAzureRMPointToSiteVPNGateway_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_point_to_site_vpn_gateway" "test" {
+  name                        = "acctestp2sVPNG-%d"
+  location                    = azurerm_resource_group.test.location
+  resource_group_name         = azurerm_resource_group.test.name
+  virtual_hub_id              = azurerm_virtual_hub.test.id
+  vpn_server_configuration_id = azurerm_template_deployment.test.outputs["id"]
+  scale_unit                  = 1
+  vpn_client_address_pool     = ["10.1.0.0/24"]
+}
+`, template, rInt)
+}
+
+func testAccAzureRMPointToSiteVPNGateway_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMPointToSiteVPNGateway_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_point_to_site_vpn_gateway" "import" {
+  name                        = azurerm_point_to_site_vpn_gateway.test.name
+  location                    = azurerm_point_to_site_vpn_gateway.test.location
+  resource_group_name         = azurerm_point_to_site_vpn_gateway.test.resource_group_name
+  virtual_hub_id              = azurerm_point_to_site_vpn_gateway.test.virtual_hub_id
+  vpn_server_configuration_id = azurerm_point_to_site_vpn_gateway.test.vpn_server_configuration_id
+  scale_unit                  = 1
+  vpn_client_address_pool     = ["10.1.0.0/24"]
+}
+`, template)
+}
+
+func testAccAzureRMPointToSiteVPNGateway_complete(rInt int, location string) string {
+	template := testAccAzureRMPointToSiteVPNGateway_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_point_to_site_vpn_gateway" "test" {
+  name                          = "acctestp2sVPNG-%d"
+  location                      = azurerm_resource_group.test.location
+  resource_group_name           = azurerm_resource_group.test.name
+  virtual_hub_id                = azurerm_virtual_hub.test.id
+  vpn_server_configuration_id   = azurerm_template_deployment.test.outputs["id"]
+  scale_unit                    = 2
+  vpn_client_address_pool       = ["10.1.0.0/24", "10.2.0.0/24"]
+  custom_route_address_prefixes = ["10.3.0.0/24"]
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMPointToSiteVPNGateway_template(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctestp2sconfig-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.Network/virtualWans/p2sVpnServerConfigurations",
+      "apiVersion": "2019-06-01",
+      "name": "${azurerm_virtual_wan.test.name}/acctestp2sconfig",
+      "properties": {
+        "vpnProtocols": ["IkeV2"],
+        "radiusServerAddress": "10.0.0.5",
+        "radiusServerSecret": "Th1sIsASecret!"
+      }
+    }
+  ],
+  "outputs": {
+    "id": {
+      "type": "string",
+      "value": "[resourceId('Microsoft.Network/virtualWans/p2sVpnServerConfigurations', '${azurerm_virtual_wan.test.name}', 'acctestp2sconfig')]"
+    }
+  }
+}
+DEPLOY
+}
+`, template, rInt)
+}
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 )
 
 func testCheckAzureRMPublicIPPrefixExists(resourceName string) resource.TestCheckFunc {
@@ -24,8 +26,8 @@ func testCheckAzureRMPublicIPPrefixExists(resourceName string) resource.TestChec
 			return fmt.Errorf("Bad: no resource group found in state for public ip prefix: %s", publicIpPrefixName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).network.PublicIPPrefixesClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.PublicIPPrefixesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, publicIpPrefixName, "")
 		if err != nil {
@@ -54,8 +56,8 @@ func testCheckAzureRMPublicIPPrefixDisappears(resourceName string) resource.Test
 			return fmt.Errorf("Bad: no resource group found in state for public ip prefix: %s", publicIpPrefixName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).network.PublicIPPrefixesClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.PublicIPPrefixesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 		future, err := client.Delete(ctx, resourceGroup, publicIpPrefixName)
 		if err != nil {
 			return fmt.Errorf("Error deleting Public IP Prefix %q (Resource Group %q): %+v", publicIpPrefixName, resourceGroup, err)
@@ -70,8 +72,8 @@ func testCheckAzureRMPublicIPPrefixDisappears(resourceName string) resource.Test
 }
 
 func testCheckAzureRMPublicIPPrefixDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).network.PublicIPPrefixesClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Network.PublicIPPrefixesClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_public_ip_prefix" {
@@ -98,11 +100,11 @@ func testCheckAzureRMPublicIPPrefixDestroy(s *terraform.State) error {
 func TestAccAzureRMPublicIpPrefix_basic(t *testing.T) {
 	resourceName := "azurerm_public_ip_prefix.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMPublicIPPrefix_basic(ri, testLocation())
+	config := testAccAzureRMPublicIPPrefix_basic(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMPublicIPPrefixDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -125,11 +127,11 @@ func TestAccAzureRMPublicIpPrefix_basic(t *testing.T) {
 func TestAccAzureRMPublicIpPrefix_prefixLength(t *testing.T) {
 	resourceName := "azurerm_public_ip_prefix.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMPublicIPPrefix_prefixLength(ri, testLocation())
+	config := testAccAzureRMPublicIPPrefix_prefixLength(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMPublicIPPrefixDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -152,13 +154,13 @@ func TestAccAzureRMPublicIpPrefix_prefixLength(t *testing.T) {
 func TestAccAzureRMPublicIpPrefix_update(t *testing.T) {
 	resourceName := "azurerm_public_ip_prefix.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	preConfig := testAccAzureRMPublicIPPrefix_withTags(ri, location)
 	postConfig := testAccAzureRMPublicIPPrefix_withTagsUpdate(ri, location)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMPublicIPPrefixDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -185,11 +187,11 @@ func TestAccAzureRMPublicIpPrefix_update(t *testing.T) {
 func TestAccAzureRMPublicIpPrefix_disappears(t *testing.T) {
 	resourceName := "azurerm_public_ip_prefix.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMPublicIPPrefix_basic(ri, testLocation())
+	config := testAccAzureRMPublicIPPrefix_basic(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMPublicIPPrefixDestroy,
 		Steps: []resource.TestStep{
 			{
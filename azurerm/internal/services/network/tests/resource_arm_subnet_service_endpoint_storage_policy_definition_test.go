@@ -0,0 +1,226 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSubnetServiceEndpointStoragePolicyDefinition_basic(t *testing.T) {
+	resourceName := "azurerm_subnet_service_endpoint_storage_policy_definition.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(6)
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMSubnetServiceEndpointStoragePolicyDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetServiceEndpointStoragePolicyDefinition_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetServiceEndpointStoragePolicyDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "service_resources.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubnetServiceEndpointStoragePolicyDefinition_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_subnet_service_endpoint_storage_policy_definition.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(6)
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMSubnetServiceEndpointStoragePolicyDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetServiceEndpointStoragePolicyDefinition_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetServiceEndpointStoragePolicyDefinitionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMSubnetServiceEndpointStoragePolicyDefinition_requiresImport(ri, rs, location),
+				ExpectError: acceptance.RequiresImportError("azurerm_subnet_service_endpoint_storage_policy_definition"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubnetServiceEndpointStoragePolicyDefinition_update(t *testing.T) {
+	resourceName := "azurerm_subnet_service_endpoint_storage_policy_definition.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(6)
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMSubnetServiceEndpointStoragePolicyDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetServiceEndpointStoragePolicyDefinition_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetServiceEndpointStoragePolicyDefinitionExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMSubnetServiceEndpointStoragePolicyDefinition_complete(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetServiceEndpointStoragePolicyDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Allow access to the test Storage Accounts"),
+					resource.TestCheckResourceAttr(resourceName, "service_resources.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSubnetServiceEndpointStoragePolicyDefinitionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Service Endpoint Storage Policy Definition not found: %s", resourceName)
+		}
+
+		id, err := parse.ParseServiceEndpointPolicyDefinitionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.ServiceEndpointPolicyDefinitionsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.ServiceEndpointPolicyName, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Definition %q (Service Endpoint Storage Policy %q / Resource Group %q) does not exist", id.Name, id.ServiceEndpointPolicyName, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.ServiceEndpointPolicyDefinitionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSubnetServiceEndpointStoragePolicyDefinitionDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Network.ServiceEndpointPolicyDefinitionsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_subnet_service_endpoint_storage_policy_definition" {
+			continue
+		}
+
+		id, err := parse.ParseServiceEndpointPolicyDefinitionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.ServiceEndpointPolicyName, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.ServiceEndpointPolicyDefinitionsClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("Definition %q (Service Endpoint Storage Policy %q / Resource Group %q) still exists", id.Name, id.ServiceEndpointPolicyName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSubnetServiceEndpointStoragePolicyDefinition_template(rInt int, rString string, location string) string {
+	template := testAccAzureRMSubnetServiceEndpointStoragePolicy_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_account" "other" {
+  name                     = "acctestsa2%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, template, rString, rString)
+}
+
+func testAccAzureRMSubnetServiceEndpointStoragePolicyDefinition_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMSubnetServiceEndpointStoragePolicyDefinition_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet_service_endpoint_storage_policy_definition" "test" {
+  name                       = "acctestSEPD-%d"
+  service_endpoint_policy_id = azurerm_subnet_service_endpoint_storage_policy.test.id
+  service_resources          = [azurerm_storage_account.test.id]
+}
+`, template, rInt)
+}
+
+func testAccAzureRMSubnetServiceEndpointStoragePolicyDefinition_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMSubnetServiceEndpointStoragePolicyDefinition_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet_service_endpoint_storage_policy_definition" "import" {
+  name                       = azurerm_subnet_service_endpoint_storage_policy_definition.test.name
+  service_endpoint_policy_id = azurerm_subnet_service_endpoint_storage_policy_definition.test.service_endpoint_policy_id
+  service_resources          = azurerm_subnet_service_endpoint_storage_policy_definition.test.service_resources
+}
+`, template)
+}
+
+func testAccAzureRMSubnetServiceEndpointStoragePolicyDefinition_complete(rInt int, rString string, location string) string {
+	template := testAccAzureRMSubnetServiceEndpointStoragePolicyDefinition_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet_service_endpoint_storage_policy_definition" "test" {
+  name                       = "acctestSEPD-%d"
+  service_endpoint_policy_id = azurerm_subnet_service_endpoint_storage_policy.test.id
+  description                = "Allow access to the test Storage Accounts"
+  service_resources = [
+    azurerm_storage_account.test.id,
+    azurerm_storage_account.other.id,
+  ]
+}
+`, template, rInt)
+}
@@ -0,0 +1,203 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSubnetServiceEndpointStoragePolicy_basic(t *testing.T) {
+	resourceName := "azurerm_subnet_service_endpoint_storage_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMSubnetServiceEndpointStoragePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetServiceEndpointStoragePolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetServiceEndpointStoragePolicyExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "resource_guid"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubnetServiceEndpointStoragePolicy_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_subnet_service_endpoint_storage_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMSubnetServiceEndpointStoragePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetServiceEndpointStoragePolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetServiceEndpointStoragePolicyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMSubnetServiceEndpointStoragePolicy_requiresImport(ri, location),
+				ExpectError: acceptance.RequiresImportError("azurerm_subnet_service_endpoint_storage_policy"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubnetServiceEndpointStoragePolicy_update(t *testing.T) {
+	resourceName := "azurerm_subnet_service_endpoint_storage_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMSubnetServiceEndpointStoragePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetServiceEndpointStoragePolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetServiceEndpointStoragePolicyExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMSubnetServiceEndpointStoragePolicy_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetServiceEndpointStoragePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.env", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSubnetServiceEndpointStoragePolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Service Endpoint Storage Policy not found: %s", resourceName)
+		}
+
+		id, err := parse.ParseServiceEndpointPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.ServiceEndpointPoliciesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name, ""); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Service Endpoint Storage Policy %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.ServiceEndpointPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSubnetServiceEndpointStoragePolicyDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Network.ServiceEndpointPoliciesClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_subnet_service_endpoint_storage_policy" {
+			continue
+		}
+
+		id, err := parse.ParseServiceEndpointPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name, ""); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.ServiceEndpointPoliciesClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("Service Endpoint Storage Policy %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSubnetServiceEndpointStoragePolicy_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_subnet_service_endpoint_storage_policy" "test" {
+  name                = "acctestSEP-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMSubnetServiceEndpointStoragePolicy_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMSubnetServiceEndpointStoragePolicy_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet_service_endpoint_storage_policy" "import" {
+  name                = azurerm_subnet_service_endpoint_storage_policy.test.name
+  resource_group_name = azurerm_subnet_service_endpoint_storage_policy.test.resource_group_name
+  location            = azurerm_subnet_service_endpoint_storage_policy.test.location
+}
+`, template)
+}
+
+func testAccAzureRMSubnetServiceEndpointStoragePolicy_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_subnet_service_endpoint_storage_policy" "test" {
+  name                = "acctestSEP-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  tags = {
+    env = "test"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,213 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualHubConnection_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_hub_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHubConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "internet_security_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualHubConnection_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_virtual_hub_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHubConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualHubConnection_requiresImport(ri, location),
+				ExpectError: acceptance.RequiresImportError("azurerm_virtual_hub_connection"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualHubConnection_update(t *testing.T) {
+	resourceName := "azurerm_virtual_hub_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHubConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualHubConnection_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hub_to_virtual_network_traffic_allowed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "virtual_network_to_hub_gateways_traffic_allowed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "internet_security_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualHubConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Virtual Hub Connection not found: %s", resourceName)
+		}
+
+		id, err := parse.ParseVirtualHubConnectionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.HubVirtualNetworkConnectionClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Connection %q for Virtual Hub %q (Resource Group %q) does not exist", id.Name, id.VirtualHubName, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.HubVirtualNetworkConnectionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualHubConnectionDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Network.HubVirtualNetworkConnectionClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_hub_connection" {
+			continue
+		}
+
+		id, err := parse.ParseVirtualHubConnectionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.HubVirtualNetworkConnectionsClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("Connection %q for Virtual Hub %q (Resource Group %q) still exists", id.Name, id.VirtualHubName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualHubConnection_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualHubConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                      = "acctestvhubconn-%d"
+  virtual_hub_id            = azurerm_virtual_hub.test.id
+  remote_virtual_network_id = azurerm_virtual_network.test.id
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualHubConnection_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMVirtualHubConnection_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_connection" "import" {
+  name                      = azurerm_virtual_hub_connection.test.name
+  virtual_hub_id            = azurerm_virtual_hub_connection.test.virtual_hub_id
+  remote_virtual_network_id = azurerm_virtual_hub_connection.test.remote_virtual_network_id
+}
+`, template)
+}
+
+func testAccAzureRMVirtualHubConnection_complete(rInt int, location string) string {
+	template := testAccAzureRMVirtualHubConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                                            = "acctestvhubconn-%d"
+  virtual_hub_id                                  = azurerm_virtual_hub.test.id
+  remote_virtual_network_id                       = azurerm_virtual_network.test.id
+  hub_to_virtual_network_traffic_allowed          = true
+  virtual_network_to_hub_gateways_traffic_allowed = true
+  internet_security_enabled                       = true
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualHubConnection_template(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.5.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, template, rInt)
+}
@@ -0,0 +1,259 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualNetworkTap_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_network_tap.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkTapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualNetworkTap_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkTapExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "destination_network_interface_ip_configuration_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "destination_port"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualNetworkTap_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_virtual_network_tap.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkTapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualNetworkTap_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkTapExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualNetworkTap_requiresImport(ri, location),
+				ExpectError: acceptance.RequiresImportError("azurerm_virtual_network_tap"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualNetworkTap_update(t *testing.T) {
+	resourceName := "azurerm_virtual_network_tap.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkTapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualNetworkTap_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkTapExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualNetworkTap_loadBalancer(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkTapExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_network_interface_ip_configuration_id", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "destination_load_balancer_frontend_ip_configuration_id"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port", "4790"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMVirtualNetworkTap_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkTapExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualNetworkTapExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Virtual Network Tap not found: %s", resourceName)
+		}
+
+		id, err := parse.ParseVirtualNetworkTapID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.VirtualNetworkTapsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Virtual Network Tap %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.VirtualNetworkTapsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualNetworkTapDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Network.VirtualNetworkTapsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_network_tap" {
+			continue
+		}
+
+		id, err := parse.ParseVirtualNetworkTapID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.VirtualNetworkTapsClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("Virtual Network Tap %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualNetworkTap_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_network_interface" "collector" {
+  name                = "acctestni-collector-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "collector"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_lb" "test" {
+  name                = "acctestlb-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  frontend_ip_configuration {
+    name                          = "collector"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMVirtualNetworkTap_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualNetworkTap_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network_tap" "test" {
+  name                                              = "acctestvtap-%d"
+  location                                          = azurerm_resource_group.test.location
+  resource_group_name                               = azurerm_resource_group.test.name
+  destination_network_interface_ip_configuration_id = "${azurerm_network_interface.collector.id}/ipConfigurations/collector"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualNetworkTap_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMVirtualNetworkTap_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network_tap" "import" {
+  name                                              = azurerm_virtual_network_tap.test.name
+  location                                          = azurerm_virtual_network_tap.test.location
+  resource_group_name                               = azurerm_virtual_network_tap.test.resource_group_name
+  destination_network_interface_ip_configuration_id = azurerm_virtual_network_tap.test.destination_network_interface_ip_configuration_id
+}
+`, template)
+}
+
+func testAccAzureRMVirtualNetworkTap_loadBalancer(rInt int, location string) string {
+	template := testAccAzureRMVirtualNetworkTap_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network_tap" "test" {
+  name                                                   = "acctestvtap-%d"
+  location                                               = azurerm_resource_group.test.location
+  resource_group_name                                    = azurerm_resource_group.test.name
+  destination_load_balancer_frontend_ip_configuration_id = azurerm_lb.test.frontend_ip_configuration.0.id
+  destination_port                                       = 4790
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, template, rInt)
+}
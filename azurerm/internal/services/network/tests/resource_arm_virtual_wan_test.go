@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -15,11 +17,11 @@ import (
 func TestAccAzureRMVirtualWan_basic(t *testing.T) {
 	resourceName := "azurerm_virtual_wan.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMVirtualWanDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -49,12 +51,12 @@ func TestAccAzureRMVirtualWan_requiresImport(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMVirtualWanDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMVirtualWan_basic(ri, testLocation()),
+				Config: testAccAzureRMVirtualWan_basic(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMVirtualWanExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "disable_vpn_encryption"),
@@ -65,8 +67,8 @@ func TestAccAzureRMVirtualWan_requiresImport(t *testing.T) {
 				),
 			},
 			{
-				Config:      testAccAzureRMVirtualWan_requiresImport(ri, testLocation()),
-				ExpectError: testRequiresImportError("azurerm_virtual_wan"),
+				Config:      testAccAzureRMVirtualWan_requiresImport(ri, acceptance.Location()),
+				ExpectError: acceptance.RequiresImportError("azurerm_virtual_wan"),
 			},
 		},
 	})
@@ -77,12 +79,12 @@ func TestAccAzureRMVirtualWan_complete(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMVirtualWanDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMVirtualWan_complete(ri, testLocation()),
+				Config: testAccAzureRMVirtualWan_complete(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMVirtualWanExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "disable_vpn_encryption", "false"),
@@ -105,8 +107,8 @@ func TestAccAzureRMVirtualWan_complete(t *testing.T) {
 }
 
 func testCheckAzureRMVirtualWanDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).network.VirtualWanClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Network.VirtualWanClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_virtual_wan" {
@@ -146,8 +148,8 @@ func testCheckAzureRMVirtualWanExists(resourceName string) resource.TestCheckFun
 			return fmt.Errorf("Bad: no resource group found in state for Virtual WAN: %s", virtualWanName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).network.VirtualWanClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.VirtualWanClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, virtualWanName)
 		if err != nil {
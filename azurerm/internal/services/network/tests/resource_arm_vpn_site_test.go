@@ -0,0 +1,239 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVPNSite_basic(t *testing.T) {
+	resourceName := "azurerm_vpn_site.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMVPNSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVPNSite_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVPNSiteExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "link.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "link.0.id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVPNSite_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_vpn_site.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMVPNSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVPNSite_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVPNSiteExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVPNSite_requiresImport(ri, location),
+				ExpectError: acceptance.RequiresImportError("azurerm_vpn_site"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVPNSite_update(t *testing.T) {
+	resourceName := "azurerm_vpn_site.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMVPNSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVPNSite_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVPNSiteExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMVPNSite_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVPNSiteExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "address_cidrs.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "device_vendor", "Cisco"),
+					resource.TestCheckResourceAttr(resourceName, "link.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "link.1.bgp.0.asn", "12345"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVPNSiteExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("VPN Site not found: %s", resourceName)
+		}
+
+		id, err := parse.ParseVpnSiteID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.VpnSitesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: VPN Site %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.VpnSitesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVPNSiteDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Network.VpnSitesClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_vpn_site" {
+			continue
+		}
+
+		id, err := parse.ParseVpnSiteID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.VpnSitesClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("VPN Site %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVPNSite_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "test" {
+  name                = "acctestVPNSite-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  virtual_wan_id      = azurerm_virtual_wan.test.id
+  address_cidrs       = ["10.0.0.0/24"]
+
+  link {
+    name       = "link1"
+    ip_address = "10.0.0.1"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVPNSite_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMVPNSite_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "import" {
+  name                = azurerm_vpn_site.test.name
+  location            = azurerm_vpn_site.test.location
+  resource_group_name = azurerm_vpn_site.test.resource_group_name
+  virtual_wan_id      = azurerm_vpn_site.test.virtual_wan_id
+  address_cidrs       = ["10.0.0.0/24"]
+
+  link {
+    name       = "link1"
+    ip_address = "10.0.0.1"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMVPNSite_complete(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "test" {
+  name                = "acctestVPNSite-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  virtual_wan_id      = azurerm_virtual_wan.test.id
+  address_cidrs       = ["10.0.0.0/24", "10.0.1.0/24"]
+  device_model        = "VPNDevice"
+  device_vendor       = "Cisco"
+
+  link {
+    name          = "link1"
+    ip_address    = "10.0.0.1"
+    provider_name = "Verizon"
+    speed_in_mbps = 50
+  }
+
+  link {
+    name       = "link2"
+    ip_address = "10.0.1.1"
+
+    bgp {
+      asn             = 12345
+      peering_address = "10.0.1.5"
+    }
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
@@ -1,4 +1,4 @@
-package notificationhub
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
@@ -1,4 +1,4 @@
-package policy
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/policy"
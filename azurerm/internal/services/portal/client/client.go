@@ -1,4 +1,4 @@
-package portal
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/portal/mgmt/2019-01-01-preview/portal"
@@ -1,4 +1,4 @@
-package azurerm
+package portal
 
 import (
 	"encoding/json"
@@ -9,7 +9,9 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -37,15 +39,16 @@ func resourceArmDashboard() *schema.Resource {
 				Type:      schema.TypeString,
 				Optional:  true,
 				Computed:  true,
-				StateFunc: normalizeJson,
+				StateFunc: utils.NormalizeJson,
 			},
 		},
 	}
 }
 
 func resourceArmDashboardCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).portal.DashboardsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Portal.DashboardsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	t := d.Get("tags").(map[string]interface{})
 	name := d.Get("name").(string)
@@ -82,8 +85,9 @@ func resourceArmDashboardCreateUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmDashboardRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).portal.DashboardsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Portal.DashboardsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, parseErr := azure.ParseAzureResourceID(d.Id())
 	if parseErr != nil {
@@ -117,8 +121,9 @@ func resourceArmDashboardRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmDashboardDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).portal.DashboardsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Portal.DashboardsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, parseErr := azure.ParseAzureResourceID(d.Id())
 	if parseErr != nil {
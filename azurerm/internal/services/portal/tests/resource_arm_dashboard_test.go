@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -8,16 +8,18 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 )
 
 func TestAccResourceArmDashboard_basic(t *testing.T) {
 	resourceName := "azurerm_dashboard.test"
 	ri := tf.AccRandTimeInt()
-	config := testResourceArmDashboard_basic(ri, testLocation())
+	config := testResourceArmDashboard_basic(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMDashboardDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -49,8 +51,8 @@ func testCheckAzureRMDashboardExists(resourceName string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for Dashboard: %s", dashboardName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).portal.DashboardsClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Portal.DashboardsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, dashboardName)
 		if err != nil {
@@ -66,8 +68,8 @@ func testCheckAzureRMDashboardExists(resourceName string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMDashboardDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).portal.DashboardsClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Portal.DashboardsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_dashboard" {
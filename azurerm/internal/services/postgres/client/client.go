@@ -1,4 +1,4 @@
-package postgres
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
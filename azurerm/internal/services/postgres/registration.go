@@ -0,0 +1,28 @@
+package postgres
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "PostgreSQL"
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azurerm_postgresql_configuration":        resourceArmPostgreSQLConfiguration(),
+		"azurerm_postgresql_database":             resourceArmPostgreSQLDatabase(),
+		"azurerm_postgresql_firewall_rule":        resourceArmPostgreSQLFirewallRule(),
+		"azurerm_postgresql_server":               resourceArmPostgreSQLServer(),
+		"azurerm_postgresql_virtual_network_rule": resourceArmPostgreSQLVirtualNetworkRule(),
+	}
+}
@@ -1,4 +1,4 @@
-package privatedns
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
@@ -0,0 +1,27 @@
+package privatedns
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Private DNS Zone"
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azurerm_private_dns_a_record":                  resourceArmPrivateDnsARecord(),
+		"azurerm_private_dns_cname_record":              resourceArmPrivateDnsCNameRecord(),
+		"azurerm_private_dns_zone":                      resourceArmPrivateDnsZone(),
+		"azurerm_private_dns_zone_virtual_network_link": resourceArmPrivateDnsZoneVirtualNetworkLink(),
+	}
+}
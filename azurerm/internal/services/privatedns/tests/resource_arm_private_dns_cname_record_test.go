@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -9,17 +9,19 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
 func TestAccAzureRMPrivateDnsCNameRecord_basic(t *testing.T) {
 	resourceName := "azurerm_private_dns_cname_record.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMPrivateDnsCNameRecord_basic(ri, testLocation())
+	config := testAccAzureRMPrivateDnsCNameRecord_basic(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMPrivateDnsCNameRecordDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -45,11 +47,11 @@ func TestAccAzureRMPrivateDnsCNameRecord_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_private_dns_cname_record.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMPrivateDnsCNameRecordDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -60,7 +62,7 @@ func TestAccAzureRMPrivateDnsCNameRecord_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMPrivateDnsCNameRecord_requiresImport(ri, location),
-				ExpectError: testRequiresImportError("azurerm_private_dns_cname_record"),
+				ExpectError: acceptance.RequiresImportError("azurerm_private_dns_cname_record"),
 			},
 		},
 	})
@@ -69,11 +71,11 @@ func TestAccAzureRMPrivateDnsCNameRecord_requiresImport(t *testing.T) {
 func TestAccAzureRMPrivateDnsCNameRecord_subdomain(t *testing.T) {
 	resourceName := "azurerm_private_dns_cname_record.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMPrivateDnsCNameRecord_subdomain(ri, testLocation())
+	config := testAccAzureRMPrivateDnsCNameRecord_subdomain(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMPrivateDnsCNameRecordDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -95,13 +97,13 @@ func TestAccAzureRMPrivateDnsCNameRecord_subdomain(t *testing.T) {
 func TestAccAzureRMPrivateDnsCNameRecord_updateRecords(t *testing.T) {
 	resourceName := "azurerm_private_dns_cname_record.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	preConfig := testAccAzureRMPrivateDnsCNameRecord_basic(ri, location)
 	postConfig := testAccAzureRMPrivateDnsCNameRecord_updateRecords(ri, location)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMPrivateDnsCNameRecordDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -123,13 +125,13 @@ func TestAccAzureRMPrivateDnsCNameRecord_updateRecords(t *testing.T) {
 func TestAccAzureRMPrivateDnsCNameRecord_withTags(t *testing.T) {
 	resourceName := "azurerm_private_dns_cname_record.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	preConfig := testAccAzureRMPrivateDnsCNameRecord_withTags(ri, location)
 	postConfig := testAccAzureRMPrivateDnsCNameRecord_withTagsUpdate(ri, location)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMPrivateDnsCNameRecordDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -170,8 +172,8 @@ func testCheckAzureRMPrivateDnsCNameRecordExists(resourceName string) resource.T
 			return fmt.Errorf("Bad: no resource group found in state for Private DNS CNAME record: %s", aName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).privateDns.RecordSetsClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		conn := acceptance.AzureProvider.Meta().(*clients.Client).PrivateDns.RecordSetsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 		resp, err := conn.Get(ctx, resourceGroup, zoneName, privatedns.CNAME, aName)
 		if err != nil {
 			return fmt.Errorf("Bad: Get CNAME RecordSet: %+v", err)
@@ -186,8 +188,8 @@ func testCheckAzureRMPrivateDnsCNameRecordExists(resourceName string) resource.T
 }
 
 func testCheckAzureRMPrivateDnsCNameRecordDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).privateDns.RecordSetsClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	conn := acceptance.AzureProvider.Meta().(*clients.Client).PrivateDns.RecordSetsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_private_dns_cname_record" {
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -8,17 +8,19 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
 func TestAccAzureRMPrivateDnsZone_basic(t *testing.T) {
 	resourceName := "azurerm_private_dns_zone.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMPrivateDnsZone_basic(ri, testLocation())
+	config := testAccAzureRMPrivateDnsZone_basic(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMPrivateDnsZoneDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -44,11 +46,11 @@ func TestAccAzureRMPrivateDnsZone_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_private_dns_zone.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMPrivateDnsZoneDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -59,7 +61,7 @@ func TestAccAzureRMPrivateDnsZone_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMPrivateDnsZone_requiresImport(ri, location),
-				ExpectError: testRequiresImportError("azurerm_private_dns_zone"),
+				ExpectError: acceptance.RequiresImportError("azurerm_private_dns_zone"),
 			},
 		},
 	})
@@ -68,13 +70,13 @@ func TestAccAzureRMPrivateDnsZone_requiresImport(t *testing.T) {
 func TestAccAzureRMPrivateDnsZone_withTags(t *testing.T) {
 	resourceName := "azurerm_private_dns_zone.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	preConfig := testAccAzureRMPrivateDnsZone_withTags(ri, location)
 	postConfig := testAccAzureRMPrivateDnsZone_withTagsUpdate(ri, location)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMPrivateDnsZoneDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -114,8 +116,8 @@ func testCheckAzureRMPrivateDnsZoneExists(resourceName string) resource.TestChec
 			return fmt.Errorf("Bad: no resource group found in state for Private DNS zone: %s", zoneName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).privateDns.PrivateZonesClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).PrivateDns.PrivateZonesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 		resp, err := client.Get(ctx, resourceGroup, zoneName)
 		if err != nil {
 			return fmt.Errorf("Bad: Get Private DNS zone: %+v", err)
@@ -130,8 +132,8 @@ func testCheckAzureRMPrivateDnsZoneExists(resourceName string) resource.TestChec
 }
 
 func testCheckAzureRMPrivateDnsZoneDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).privateDns.PrivateZonesClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	conn := acceptance.AzureProvider.Meta().(*clients.Client).PrivateDns.PrivateZonesClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_private_dns_zone" {
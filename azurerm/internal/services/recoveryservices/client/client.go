@@ -1,4 +1,4 @@
-package recoveryservices
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2016-06-01/recoveryservices"
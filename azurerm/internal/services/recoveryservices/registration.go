@@ -0,0 +1,35 @@
+package recoveryservices
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Recovery Services"
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azurerm_recovery_services_protection_policy_vm": dataSourceArmRecoveryServicesProtectionPolicyVm(),
+		"azurerm_recovery_services_vault":                dataSourceArmRecoveryServicesVault(),
+	}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azurerm_recovery_network_mapping":                       resourceArmRecoveryServicesNetworkMapping(),
+		"azurerm_recovery_replicated_vm":                         resourceArmRecoveryServicesReplicatedVm(),
+		"azurerm_recovery_services_fabric":                       resourceArmRecoveryServicesFabric(),
+		"azurerm_recovery_services_protected_vm":                 resourceArmRecoveryServicesProtectedVm(),
+		"azurerm_recovery_services_protection_container":         resourceArmRecoveryServicesProtectionContainer(),
+		"azurerm_recovery_services_protection_container_mapping": resourceArmRecoveryServicesProtectionContainerMapping(),
+		"azurerm_recovery_services_protection_policy_vm":         resourceArmRecoveryServicesProtectionPolicyVm(),
+		"azurerm_recovery_services_replication_policy":           resourceArmRecoveryServicesReplicationPolicy(),
+		"azurerm_recovery_services_vault":                        resourceArmRecoveryServicesVault(),
+	}
+}
@@ -1,4 +1,4 @@
-package azurerm
+package recoveryservices
 
 import (
 	"fmt"
@@ -8,7 +8,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -48,10 +49,11 @@ func resourceArmRecoveryServicesFabricCreate(d *schema.ResourceData, meta interf
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	name := d.Get("name").(string)
 
-	client := meta.(*ArmClient).recoveryServices.FabricClient(resGroup, vaultName)
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).RecoveryServices.FabricClient(resGroup, vaultName)
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -101,8 +103,9 @@ func resourceArmRecoveryServicesFabricRead(d *schema.ResourceData, meta interfac
 	vaultName := id.Path["vaults"]
 	name := id.Path["replicationFabrics"]
 
-	client := meta.(*ArmClient).recoveryServices.FabricClient(resGroup, vaultName)
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).RecoveryServices.FabricClient(resGroup, vaultName)
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	resp, err := client.Get(ctx, name)
 	if err != nil {
@@ -134,8 +137,9 @@ func resourceArmRecoveryServicesFabricDelete(d *schema.ResourceData, meta interf
 	vaultName := id.Path["vaults"]
 	name := id.Path["replicationFabrics"]
 
-	client := meta.(*ArmClient).recoveryServices.FabricClient(resGroup, vaultName)
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).RecoveryServices.FabricClient(resGroup, vaultName)
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	future, err := client.Delete(ctx, name)
 	if err != nil {
@@ -1,4 +1,4 @@
-package azurerm
+package recoveryservices
 
 import (
 	"fmt"
@@ -9,7 +9,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -76,8 +77,9 @@ func resourceArmRecoveryNetworkMappingCreate(d *schema.ResourceData, meta interf
 	targetNetworkId := d.Get("target_network_id").(string)
 	name := d.Get("name").(string)
 
-	client := meta.(*ArmClient).recoveryServices.NetworkMappingClient(resGroup, vaultName)
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).RecoveryServices.NetworkMappingClient(resGroup, vaultName)
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	//get network name from id
 	parsedSourceNetworkId, err := azure.ParseAzureResourceID(sourceNetworkId)
@@ -92,7 +94,7 @@ func resourceArmRecoveryNetworkMappingCreate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, fabricName, sourceNetworkName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -144,8 +146,9 @@ func resourceArmRecoveryNetworkMappingRead(d *schema.ResourceData, meta interfac
 	networkName := id.Path["replicationNetworks"]
 	name := id.Path["replicationNetworkMappings"]
 
-	client := meta.(*ArmClient).recoveryServices.NetworkMappingClient(resGroup, vaultName)
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).RecoveryServices.NetworkMappingClient(resGroup, vaultName)
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	resp, err := client.Get(ctx, fabricName, networkName, name)
 	if err != nil {
@@ -186,8 +189,9 @@ func resourceArmRecoveryNetworkMappingDelete(d *schema.ResourceData, meta interf
 	networkName := id.Path["replicationNetworks"]
 	name := id.Path["replicationNetworkMappings"]
 
-	client := meta.(*ArmClient).recoveryServices.NetworkMappingClient(resGroup, vaultName)
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).RecoveryServices.NetworkMappingClient(resGroup, vaultName)
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	future, err := client.Delete(ctx, fabricName, networkName, name)
 	if err != nil {
@@ -1,4 +1,4 @@
-package redis
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -9,113 +9,22 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
-func TestAccAzureRMRedisCacheFamily_validation(t *testing.T) {
-	cases := []struct {
-		Value    string
-		ErrCount int
-	}{
-		{
-			Value:    "C",
-			ErrCount: 0,
-		},
-		{
-			Value:    "P",
-			ErrCount: 0,
-		},
-		{
-			Value:    "c",
-			ErrCount: 0,
-		},
-		{
-			Value:    "p",
-			ErrCount: 0,
-		},
-		{
-			Value:    "a",
-			ErrCount: 1,
-		},
-		{
-			Value:    "b",
-			ErrCount: 1,
-		},
-		{
-			Value:    "D",
-			ErrCount: 1,
-		},
-	}
-
-	for _, tc := range cases {
-		_, errors := validateRedisFamily(tc.Value, "azurerm_redis_cache")
-
-		if len(errors) != tc.ErrCount {
-			t.Fatalf("Expected the Azure RM Redis Cache Family to trigger a validation error")
-		}
-	}
-}
-
-func TestAccAzureRMRedisCacheMaxMemoryPolicy_validation(t *testing.T) {
-	cases := []struct {
-		Value    string
-		ErrCount int
-	}{
-		{Value: "noeviction", ErrCount: 0},
-		{Value: "allkeys-lru", ErrCount: 0},
-		{Value: "volatile-lru", ErrCount: 0},
-		{Value: "allkeys-random", ErrCount: 0},
-		{Value: "volatile-random", ErrCount: 0},
-		{Value: "volatile-ttl", ErrCount: 0},
-		{Value: "something-else", ErrCount: 1},
-	}
-
-	for _, tc := range cases {
-		_, errors := validateRedisMaxMemoryPolicy(tc.Value, "azurerm_redis_cache")
-
-		if len(errors) != tc.ErrCount {
-			t.Fatalf("Expected the Azure RM Redis Cache Max Memory Policy to trigger a validation error")
-		}
-	}
-}
-
-func TestAccAzureRMRedisCacheBackupFrequency_validation(t *testing.T) {
-	cases := []struct {
-		Value    int
-		ErrCount int
-	}{
-		{Value: 1, ErrCount: 1},
-		{Value: 15, ErrCount: 0},
-		{Value: 30, ErrCount: 0},
-		{Value: 45, ErrCount: 1},
-		{Value: 60, ErrCount: 0},
-		{Value: 120, ErrCount: 1},
-		{Value: 240, ErrCount: 1},
-		{Value: 360, ErrCount: 0},
-		{Value: 720, ErrCount: 0},
-		{Value: 1440, ErrCount: 0},
-	}
-
-	for _, tc := range cases {
-		_, errors := validateRedisBackupFrequency(tc.Value, "azurerm_redis_cache")
-
-		if len(errors) != tc.ErrCount {
-			t.Fatalf("Expected the AzureRM Redis Cache Backup Frequency to trigger a validation error for '%d'", tc.Value)
-		}
-	}
-}
-
 func TestAccAzureRMRedisCache_basic(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMRedisCache_basic(ri, testLocation()),
+				Config: testAccAzureRMRedisCache_basic(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMRedisCacheExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "minimum_tls_version"),
@@ -140,19 +49,19 @@ func TestAccAzureRMRedisCache_requiresImport(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMRedisCache_basic(ri, testLocation()),
+				Config: testAccAzureRMRedisCache_basic(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMRedisCacheExists(resourceName),
 				),
 			},
 			{
-				Config:      testAccAzureRMRedisCache_requiresImport(ri, testLocation()),
-				ExpectError: testRequiresImportError("azurerm_redis_cache"),
+				Config:      testAccAzureRMRedisCache_requiresImport(ri, acceptance.Location()),
+				ExpectError: acceptance.RequiresImportError("azurerm_redis_cache"),
 			},
 		},
 	})
@@ -163,12 +72,12 @@ func TestAccAzureRMRedisCache_standard(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMRedisCache_standard(ri, testLocation()),
+				Config: testAccAzureRMRedisCache_standard(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMRedisCacheExists(resourceName),
 				),
@@ -187,12 +96,12 @@ func TestAccAzureRMRedisCache_premium(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMRedisCache_premium(ri, testLocation()),
+				Config: testAccAzureRMRedisCache_premium(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMRedisCacheExists(resourceName),
 				),
@@ -211,12 +120,12 @@ func TestAccAzureRMRedisCache_premiumSharded(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMRedisCache_premiumSharded(ri, testLocation()),
+				Config: testAccAzureRMRedisCache_premiumSharded(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMRedisCacheExists(resourceName),
 				),
@@ -233,12 +142,12 @@ func TestAccAzureRMRedisCache_premiumSharded(t *testing.T) {
 func TestAccAzureRMRedisCache_premiumShardedScaling(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMRedisCache_premiumSharded(ri, testLocation())
-	config_scaled := testAccAzureRMRedisCache_premiumShardedScaled(ri, testLocation())
+	config := testAccAzureRMRedisCache_premiumSharded(ri, acceptance.Location())
+	config_scaled := testAccAzureRMRedisCache_premiumShardedScaled(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -260,11 +169,11 @@ func TestAccAzureRMRedisCache_premiumShardedScaling(t *testing.T) {
 func TestAccAzureRMRedisCache_NonStandardCasing(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMRedisCacheNonStandardCasing(ri, testLocation())
+	config := testAccAzureRMRedisCacheNonStandardCasing(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -286,11 +195,11 @@ func TestAccAzureRMRedisCache_NonStandardCasing(t *testing.T) {
 func TestAccAzureRMRedisCache_BackupDisabled(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMRedisCacheBackupDisabled(ri, testLocation())
+	config := testAccAzureRMRedisCacheBackupDisabled(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -307,11 +216,11 @@ func TestAccAzureRMRedisCache_BackupEnabled(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
 	rs := acctest.RandString(4)
-	config := testAccAzureRMRedisCacheBackupEnabled(ri, rs, testLocation())
+	config := testAccAzureRMRedisCacheBackupEnabled(ri, rs, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -339,13 +248,13 @@ func TestAccAzureRMRedisCache_BackupEnabledDisabled(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
 	rs := acctest.RandString(4)
-	location := testLocation()
+	location := acceptance.Location()
 	config := testAccAzureRMRedisCacheBackupEnabled(ri, rs, location)
 	updatedConfig := testAccAzureRMRedisCacheBackupDisabled(ri, location)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -378,11 +287,11 @@ func TestAccAzureRMRedisCache_AOFBackupEnabled(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
 	rs := acctest.RandString(4)
-	config := testAccAzureRMRedisCacheAOFBackupEnabled(ri, rs, testLocation())
+	config := testAccAzureRMRedisCacheAOFBackupEnabled(ri, rs, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -406,13 +315,13 @@ func TestAccAzureRMRedisCache_AOFBackupEnabledDisabled(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
 	rs := acctest.RandString(4)
-	location := testLocation()
+	location := acceptance.Location()
 	config := testAccAzureRMRedisCacheAOFBackupEnabled(ri, rs, location)
 	updatedConfig := testAccAzureRMRedisCacheAOFBackupDisabled(ri, location)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -432,15 +341,16 @@ func TestAccAzureRMRedisCache_AOFBackupEnabledDisabled(t *testing.T) {
 		},
 	})
 }
+
 func TestAccAzureRMRedisCache_PatchSchedule(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	config := testAccAzureRMRedisCachePatchSchedule(ri, location)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -461,13 +371,13 @@ func TestAccAzureRMRedisCache_PatchSchedule(t *testing.T) {
 func TestAccAzureRMRedisCache_PatchScheduleUpdated(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	config := testAccAzureRMRedisCachePatchSchedule(ri, location)
 	updatedConfig := testAccAzureRMRedisCache_premium(ri, location)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -489,11 +399,11 @@ func TestAccAzureRMRedisCache_PatchScheduleUpdated(t *testing.T) {
 func TestAccAzureRMRedisCache_InternalSubnet(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMRedisCache_internalSubnet(ri, testLocation())
+	config := testAccAzureRMRedisCache_internalSubnet(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -514,11 +424,11 @@ func TestAccAzureRMRedisCache_InternalSubnet(t *testing.T) {
 func TestAccAzureRMRedisCache_InternalSubnetStaticIP(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMRedisCache_internalSubnetStaticIP(ri, testLocation())
+	config := testAccAzureRMRedisCache_internalSubnetStaticIP(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -540,11 +450,11 @@ func TestAccAzureRMRedisCache_InternalSubnet_withZone(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
 
-	config := testAccAzureRMRedisCache_internalSubnet_withZone(ri, testLocation())
+	config := testAccAzureRMRedisCache_internalSubnet_withZone(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -578,8 +488,8 @@ func testCheckAzureRMRedisCacheExists(resourceName string) resource.TestCheckFun
 			return fmt.Errorf("Bad: no resource group found in state for Redis Instance: %s", redisName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).redis.Client
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		conn := acceptance.AzureProvider.Meta().(*clients.Client).Redis.Client
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, redisName)
 		if err != nil {
@@ -595,8 +505,8 @@ func testCheckAzureRMRedisCacheExists(resourceName string) resource.TestCheckFun
 }
 
 func testCheckAzureRMRedisCacheDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).redis.Client
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	conn := acceptance.AzureProvider.Meta().(*clients.Client).Redis.Client
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_redis_cache" {
@@ -624,11 +534,11 @@ func TestAccAzureRMRedisCache_SubscribeAllEvents(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
 	rs := acctest.RandString(4)
-	config := testAccAzureRMRedisCacheSubscribeAllEvents(ri, rs, testLocation())
+	config := testAccAzureRMRedisCacheSubscribeAllEvents(ri, rs, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -644,11 +554,11 @@ func TestAccAzureRMRedisCache_SubscribeAllEvents(t *testing.T) {
 func TestAccAzureRMRedisCache_WithoutAuth(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMRedisCacheWithoutAuth(ri, testLocation())
+	config := testAccAzureRMRedisCacheWithoutAuth(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -1011,6 +921,7 @@ resource "azurerm_redis_cache" "test" {
 }
 `, rInt, location, rString, rInt)
 }
+
 func testAccAzureRMRedisCache_internalSubnet(ri int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -1,4 +1,4 @@
-package relay
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/relay/mgmt/2017-04-01/relay"
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
@@ -17,12 +19,12 @@ func TestAccAzureRMRelayNamespace_basic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRelayNamespaceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMRelayNamespace_basic(ri, testLocation()),
+				Config: testAccAzureRMRelayNamespace_basic(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMRelayNamespaceExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "metric_id"),
@@ -48,12 +50,12 @@ func TestAccAzureRMRelayNamespace_basicClassic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRelayNamespaceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMRelayNamespace_basicClassic(ri, testLocation()),
+				Config: testAccAzureRMRelayNamespace_basicClassic(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMRelayNamespaceExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "metric_id"),
@@ -78,12 +80,12 @@ func TestAccAzureRMRelayNamespace_basicNotDefined(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRelayNamespaceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccAzureRMRelayNamespace_basicNotDefined(ri, testLocation()),
+				Config:      testAccAzureRMRelayNamespace_basicNotDefined(ri, acceptance.Location()),
 				ExpectError: regexp.MustCompile("either 'sku_name' or 'sku' must be defined in the configuration file"),
 			},
 		},
@@ -100,12 +102,12 @@ func TestAccAzureRMRelayNamespace_requiresImport(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRelayNamespaceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMRelayNamespace_basic(ri, testLocation()),
+				Config: testAccAzureRMRelayNamespace_basic(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMRelayNamespaceExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "metric_id"),
@@ -116,8 +118,8 @@ func TestAccAzureRMRelayNamespace_requiresImport(t *testing.T) {
 				),
 			},
 			{
-				Config:      testAccAzureRMRelayNamespace_requiresImport(ri, testLocation()),
-				ExpectError: testRequiresImportError("azurerm_relay_namespace"),
+				Config:      testAccAzureRMRelayNamespace_requiresImport(ri, acceptance.Location()),
+				ExpectError: acceptance.RequiresImportError("azurerm_relay_namespace"),
 			},
 		},
 	})
@@ -128,12 +130,12 @@ func TestAccAzureRMRelayNamespace_complete(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMRelayNamespaceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMRelayNamespace_complete(ri, testLocation()),
+				Config: testAccAzureRMRelayNamespace_complete(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMRelayNamespaceExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "metric_id"),
@@ -164,8 +166,8 @@ func testCheckAzureRMRelayNamespaceExists(resourceName string) resource.TestChec
 		name := rs.Primary.Attributes["name"]
 
 		// Ensure resource group exists in API
-		client := testAccProvider.Meta().(*ArmClient).relay.NamespacesClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Relay.NamespacesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
@@ -181,8 +183,8 @@ func testCheckAzureRMRelayNamespaceExists(resourceName string) resource.TestChec
 }
 
 func testCheckAzureRMRelayNamespaceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).relay.NamespacesClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Relay.NamespacesClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_relay_namespace" {
@@ -1,4 +1,4 @@
-package resource
+package client
 
 import (
 	providers "github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
@@ -0,0 +1,70 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ResourceGroupID is a parsed Resource Group ID
+type ResourceGroupID struct {
+	SubscriptionId string
+	Name           string
+}
+
+// NewResourceGroupID returns a new ResourceGroupID for the specified values
+func NewResourceGroupID(subscriptionId, name string) ResourceGroupID {
+	return ResourceGroupID{
+		SubscriptionId: subscriptionId,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource Group ID
+func (id ResourceGroupID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.Name)
+}
+
+// ParseResourceGroupID parses a Resource Group ID into a ResourceGroupID struct
+func ParseResourceGroupID(input string) (*ResourceGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Resource Group ID %q: %+v", input, err)
+	}
+
+	resourceId := ResourceGroupID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.Name = id.ResourceGroup; resourceId.Name == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if id.Provider != "" {
+		return nil, fmt.Errorf("ID %q was expected to be for a Resource Group but contained the Provider %q", input, id.Provider)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateResourceGroupID validates that the specified value is a Resource Group ID
+func ValidateResourceGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseResourceGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Resource Group ID: %+v", k, err))
+	}
+
+	return
+}
@@ -1,4 +1,4 @@
-package azurerm
+package resource
 
 import (
 	"context"
@@ -16,7 +16,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -27,6 +28,13 @@ func resourceArmTemplateDeployment() *schema.Resource {
 		Update: resourceArmTemplateDeploymentCreateUpdate,
 		Delete: resourceArmTemplateDeploymentDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Hour),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(3 * time.Hour),
+			Delete: schema.DefaultTimeout(3 * time.Hour),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -40,7 +48,7 @@ func resourceArmTemplateDeployment() *schema.Resource {
 				Type:      schema.TypeString,
 				Optional:  true,
 				Computed:  true,
-				StateFunc: normalizeJson,
+				StateFunc: utils.NormalizeJson,
 			},
 
 			"parameters": {
@@ -55,7 +63,7 @@ func resourceArmTemplateDeployment() *schema.Resource {
 			"parameters_body": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				StateFunc:     normalizeJson,
+				StateFunc:     utils.NormalizeJson,
 				ConflictsWith: []string{"parameters"},
 			},
 
@@ -81,15 +89,16 @@ func resourceArmTemplateDeployment() *schema.Resource {
 }
 
 func resourceArmTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	deployClient := client.resource.DeploymentsClient
-	ctx := client.StopContext
+	client := meta.(*clients.Client)
+	deployClient := client.Resource.DeploymentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(client.StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 	deploymentMode := d.Get("deployment_mode").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
 		existing, err := deployClient.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -167,9 +176,10 @@ func resourceArmTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	deployClient := client.resource.DeploymentsClient
-	ctx := client.StopContext
+	client := meta.(*clients.Client)
+	deployClient := client.Resource.DeploymentsClient
+	ctx, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -233,9 +243,10 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	deployClient := client.resource.DeploymentsClient
-	ctx := client.StopContext
+	client := meta.(*clients.Client)
+	deployClient := client.Resource.DeploymentsClient
+	ctx, cancel := timeouts.ForDelete(client.StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -252,7 +263,7 @@ func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	return waitForTemplateDeploymentToBeDeleted(ctx, deployClient, resourceGroup, name)
+	return WaitForTemplateDeploymentToBeDeleted(ctx, deployClient, resourceGroup, name)
 }
 
 // TODO: move this out into the new `helpers` structure
@@ -272,20 +283,7 @@ func expandTemplateBody(template string) (map[string]interface{}, error) {
 	return templateBody, nil
 }
 
-func normalizeJson(jsonString interface{}) string {
-	if jsonString == nil || jsonString == "" {
-		return ""
-	}
-	var j interface{}
-
-	if err := json.Unmarshal([]byte(jsonString.(string)), &j); err != nil {
-		return fmt.Sprintf("Error parsing JSON: %+v", err)
-	}
-	b, _ := json.Marshal(j)
-	return string(b[:])
-}
-
-func waitForTemplateDeploymentToBeDeleted(ctx context.Context, client *resources.DeploymentsClient, resourceGroup, name string) error {
+func WaitForTemplateDeploymentToBeDeleted(ctx context.Context, client *resources.DeploymentsClient, resourceGroup, name string) error {
 	// we can't use the Waiter here since the API returns a 200 once it's deleted which is considered a polling status code..
 	log.Printf("[DEBUG] Waiting for Template Deployment (%q in Resource Group %q) to be deleted", name, resourceGroup)
 	stateConf := &resource.StateChangeConf{
@@ -0,0 +1,3 @@
+package resource
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./parse -name=ResourceGroup -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1
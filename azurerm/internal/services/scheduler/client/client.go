@@ -1,4 +1,4 @@
-package scheduler
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/scheduler/mgmt/2016-03-01/scheduler"
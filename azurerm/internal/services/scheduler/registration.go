@@ -0,0 +1,27 @@
+package scheduler
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Scheduler"
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azurerm_scheduler_job_collection": dataSourceArmSchedulerJobCollection(),
+	}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azurerm_scheduler_job":            resourceArmSchedulerJob(),
+		"azurerm_scheduler_job_collection": resourceArmSchedulerJobCollection(),
+	}
+}
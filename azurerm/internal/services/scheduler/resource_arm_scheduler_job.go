@@ -1,6 +1,6 @@
 // nolint: megacheck
 // entire automation SDK has been depreciated in v21.3 in favor of logic apps, an entirely different service.
-package azurerm
+package scheduler
 
 import (
 	"bytes"
@@ -12,7 +12,9 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 
 	"github.com/Azure/azure-sdk-for-go/services/scheduler/mgmt/2016-03-01/scheduler"
 	"github.com/Azure/go-autorest/autorest/date"
@@ -418,14 +420,14 @@ func resourceArmSchedulerJobActionStorageSchema() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				DiffSuppressFunc: suppress.CaseDifference,
-				ValidateFunc:     validateArmStorageAccountName,
+				ValidateFunc:     storage.ValidateArmStorageAccountName,
 			},
 
 			"storage_queue_name": {
 				Type:             schema.TypeString,
 				Required:         true,
 				DiffSuppressFunc: suppress.CaseDifference,
-				ValidateFunc:     validateArmStorageQueueName,
+				ValidateFunc:     storage.ValidateArmStorageQueueName,
 			},
 
 			"sas_token": {
@@ -465,14 +467,15 @@ func resourceArmSchedulerJobCustomizeDiff(diff *schema.ResourceDiff, _ interface
 }
 
 func resourceArmSchedulerJobCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).Scheduler.JobsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Scheduler.JobsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 	jobCollection := d.Get("job_collection_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, jobCollection, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -522,8 +525,9 @@ func resourceArmSchedulerJobCreateUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceArmSchedulerJobRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).Scheduler.JobsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Scheduler.JobsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -615,8 +619,9 @@ func resourceArmSchedulerJobRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmSchedulerJobDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).Scheduler.JobsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Scheduler.JobsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -734,7 +739,7 @@ func expandAzureArmSchedulerJobActionRequest(b interface{}, meta interface{}) (*
 		if v, ok := b["audience"].(string); ok {
 			oauth.Audience = utils.String(v)
 		} else {
-			oauth.Audience = utils.String(meta.(*ArmClient).environment.ServiceManagementEndpoint)
+			oauth.Audience = utils.String(meta.(*clients.Client).Account.Environment.ServiceManagementEndpoint)
 		}
 
 		request.Authentication = oauth
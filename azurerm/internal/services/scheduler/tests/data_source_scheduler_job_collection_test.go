@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 )
 
 func TestAccDataSourceAzureRMSchedulerJobCollection_basic(t *testing.T) {
@@ -13,11 +14,11 @@ func TestAccDataSourceAzureRMSchedulerJobCollection_basic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDataSourceSchedulerJobCollection_basic(ri, testLocation()),
+				Config: testAccDataSourceSchedulerJobCollection_basic(ri, acceptance.Location()),
 				Check:  checkAccAzureRMSchedulerJobCollection_basic(dataSourceName),
 			},
 		},
@@ -29,11 +30,11 @@ func TestAccDataSourceAzureRMSchedulerJobCollection_complete(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDataSourceSchedulerJobCollection_complete(ri, testLocation()),
+				Config: testAccDataSourceSchedulerJobCollection_complete(ri, acceptance.Location()),
 				Check:  checkAccAzureRMSchedulerJobCollection_complete(dataSourceName),
 			},
 		},
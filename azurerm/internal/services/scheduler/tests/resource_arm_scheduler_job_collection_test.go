@@ -1,16 +1,18 @@
 // nolint: megacheck
 // entire automation SDK has been depreciated in v21.3 in favor of logic apps, an entirely different service.
-package azurerm
+package tests
 
 import (
 	"fmt"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/scheduler/mgmt/2016-03-01/scheduler"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -20,12 +22,12 @@ func TestAccAzureRMSchedulerJobCollection_basic(t *testing.T) {
 	resourceName := "azurerm_scheduler_job_collection.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSchedulerJobCollectionDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMSchedulerJobCollection_basic(ri, testLocation(), ""),
+				Config: testAccAzureRMSchedulerJobCollection_basic(ri, acceptance.Location(), ""),
 				Check:  checkAccAzureRMSchedulerJobCollection_basic(resourceName),
 			},
 			{
@@ -47,17 +49,17 @@ func TestAccAzureRMSchedulerJobCollection_requiresImport(t *testing.T) {
 	resourceName := "azurerm_scheduler_job_collection.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSchedulerJobCollectionDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMSchedulerJobCollection_basic(ri, testLocation(), ""),
+				Config: testAccAzureRMSchedulerJobCollection_basic(ri, acceptance.Location(), ""),
 				Check:  checkAccAzureRMSchedulerJobCollection_basic(resourceName),
 			},
 			{
-				Config:      testAccAzureRMSchedulerJobCollection_requiresImport(ri, testLocation(), ""),
-				ExpectError: testRequiresImportError("azurerm_scheduler_job_collection"),
+				Config:      testAccAzureRMSchedulerJobCollection_requiresImport(ri, acceptance.Location(), ""),
+				ExpectError: acceptance.RequiresImportError("azurerm_scheduler_job_collection"),
 			},
 		},
 	})
@@ -68,16 +70,16 @@ func TestAccAzureRMSchedulerJobCollection_complete(t *testing.T) {
 	resourceName := "azurerm_scheduler_job_collection.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSchedulerJobCollectionDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMSchedulerJobCollection_basic(ri, testLocation(), ""),
+				Config: testAccAzureRMSchedulerJobCollection_basic(ri, acceptance.Location(), ""),
 				Check:  checkAccAzureRMSchedulerJobCollection_basic(resourceName),
 			},
 			{
-				Config: testAccAzureRMSchedulerJobCollection_complete(ri, testLocation()),
+				Config: testAccAzureRMSchedulerJobCollection_complete(ri, acceptance.Location()),
 				Check:  checkAccAzureRMSchedulerJobCollection_complete(resourceName),
 			},
 			{
@@ -98,8 +100,8 @@ func testCheckAzureRMSchedulerJobCollectionDestroy(s *terraform.State) error {
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).Scheduler.JobCollectionsClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Scheduler.JobCollectionsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
 
@@ -131,8 +133,8 @@ func testCheckAzureRMSchedulerJobCollectionExists(resourceName string) resource.
 			return fmt.Errorf("Bad: no resource group found in state for Scheduler Job Collection: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).Scheduler.JobCollectionsClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Scheduler.JobCollectionsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 		resp, err := client.Get(ctx, resourceGroup, name)
 
 		if err != nil {
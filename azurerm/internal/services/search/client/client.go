@@ -1,4 +1,4 @@
-package search
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/search/mgmt/2015-08-19/search"
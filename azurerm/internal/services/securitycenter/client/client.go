@@ -1,4 +1,4 @@
-package securitycenter
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v1.0/security"
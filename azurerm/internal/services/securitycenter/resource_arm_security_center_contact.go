@@ -1,11 +1,12 @@
-package azurerm
+package securitycenter
 
 import (
 	"fmt"
 	"log"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v1.0/security"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -17,7 +18,7 @@ import (
 // Invalid security contact name was provided - only 'defaultX' is allowed where X is an index
 // Invalid security contact name 'default0' was provided. Expected 'default1'
 // Message="Invalid security contact name 'default2' was provided. Expected 'default1'"
-const securityCenterContactName = "default1"
+const SecurityCenterContactName = "default1"
 
 func resourceArmSecurityCenterContact() *schema.Resource {
 	return &schema.Resource{
@@ -57,12 +58,13 @@ func resourceArmSecurityCenterContact() *schema.Resource {
 }
 
 func resourceArmSecurityCenterContactCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).SecurityCenter.ContactsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).SecurityCenter.ContactsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
-	name := securityCenterContactName
+	name := SecurityCenterContactName
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -118,10 +120,11 @@ func resourceArmSecurityCenterContactCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceArmSecurityCenterContactRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).SecurityCenter.ContactsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).SecurityCenter.ContactsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
-	name := securityCenterContactName
+	name := SecurityCenterContactName
 
 	resp, err := client.Get(ctx, name)
 	if err != nil {
@@ -144,11 +147,12 @@ func resourceArmSecurityCenterContactRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceArmSecurityCenterContactDelete(_ *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).SecurityCenter.ContactsClient
-	ctx := meta.(*ArmClient).StopContext
+func resourceArmSecurityCenterContactDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SecurityCenter.ContactsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
-	name := securityCenterContactName
+	name := SecurityCenterContactName
 
 	resp, err := client.Delete(ctx, name)
 	if err != nil {
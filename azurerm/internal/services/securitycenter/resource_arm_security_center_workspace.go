@@ -1,4 +1,4 @@
-package azurerm
+package securitycenter
 
 import (
 	"fmt"
@@ -11,13 +11,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 //only valid name is default
 // Message="Invalid workspace settings name 'kttest' , only default is allowed "
-const securityCenterWorkspaceName = "default"
+const SecurityCenterWorkspaceName = "default"
 
 func resourceArmSecurityCenterWorkspace() *schema.Resource {
 	return &schema.Resource{
@@ -47,13 +48,14 @@ func resourceArmSecurityCenterWorkspace() *schema.Resource {
 }
 
 func resourceArmSecurityCenterWorkspaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	priceClient := meta.(*ArmClient).SecurityCenter.PricingClient
-	client := meta.(*ArmClient).SecurityCenter.WorkspaceClient
-	ctx := meta.(*ArmClient).StopContext
+	priceClient := meta.(*clients.Client).SecurityCenter.PricingClient
+	client := meta.(*clients.Client).SecurityCenter.WorkspaceClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
-	name := securityCenterWorkspaceName
+	name := SecurityCenterWorkspaceName
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*clients.Client).Features.Resources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -132,10 +134,11 @@ func resourceArmSecurityCenterWorkspaceCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceArmSecurityCenterWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).SecurityCenter.WorkspaceClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).SecurityCenter.WorkspaceClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
-	resp, err := client.Get(ctx, securityCenterWorkspaceName)
+	resp, err := client.Get(ctx, SecurityCenterWorkspaceName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[DEBUG] Security Center Subscription Workspace was not found: %v", err)
@@ -154,11 +157,12 @@ func resourceArmSecurityCenterWorkspaceRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceArmSecurityCenterWorkspaceDelete(_ *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).SecurityCenter.WorkspaceClient
-	ctx := meta.(*ArmClient).StopContext
+func resourceArmSecurityCenterWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SecurityCenter.WorkspaceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
-	resp, err := client.Delete(ctx, securityCenterWorkspaceName)
+	resp, err := client.Delete(ctx, SecurityCenterWorkspaceName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp) {
 			log.Printf("[DEBUG] Security Center Subscription Workspace was not found: %v", err)
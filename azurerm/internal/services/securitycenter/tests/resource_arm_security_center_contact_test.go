@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -6,7 +6,10 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/securitycenter"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -38,8 +41,8 @@ func testAccAzureRMSecurityCenterContact_basic(t *testing.T) {
 	resourceName := "azurerm_security_center_contact.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSecurityCenterContactDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -70,8 +73,8 @@ func testAccAzureRMSecurityCenterContact_requiresImport(t *testing.T) {
 	resourceName := "azurerm_security_center_contact.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSecurityCenterContactDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -86,7 +89,7 @@ func testAccAzureRMSecurityCenterContact_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMSecurityCenterContact_requiresImportCfg("email1@example.com", "+1-555-555-5555", true, true),
-				ExpectError: testRequiresImportError("azurerm_security_center_contact"),
+				ExpectError: acceptance.RequiresImportError("azurerm_security_center_contact"),
 			},
 		},
 	})
@@ -96,8 +99,8 @@ func testAccAzureRMSecurityCenterContact_update(t *testing.T) {
 	resourceName := "azurerm_security_center_contact.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSecurityCenterContactDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -133,8 +136,8 @@ func testAccAzureRMSecurityCenterContact_phoneOptional(t *testing.T) {
 	resourceName := "azurerm_security_center_contact.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSecurityCenterContactDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -158,8 +161,8 @@ func testAccAzureRMSecurityCenterContact_phoneOptional(t *testing.T) {
 
 func testCheckAzureRMSecurityCenterContactExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*ArmClient).SecurityCenter.ContactsClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).SecurityCenter.ContactsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -182,13 +185,13 @@ func testCheckAzureRMSecurityCenterContactExists(resourceName string) resource.T
 }
 
 func testCheckAzureRMSecurityCenterContactDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).SecurityCenter.ContactsClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).SecurityCenter.ContactsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 	for _, res := range s.RootModule().Resources {
 		if res.Type != "azurerm_security_center_contact" {
 			continue
 		}
-		resp, err := client.Get(ctx, securityCenterContactName)
+		resp, err := client.Get(ctx, securitycenter.SecurityCenterContactName)
 		if err != nil {
 			if utils.ResponseWasNotFound(resp.Response) {
 				return nil
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -8,7 +8,10 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/securitycenter"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -19,12 +22,12 @@ func testAccAzureRMSecurityCenterWorkspace_basic(t *testing.T) {
 	scope := fmt.Sprintf("/subscriptions/%s", os.Getenv("ARM_SUBSCRIPTION_ID"))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSecurityCenterWorkspaceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMSecurityCenterWorkspace_basicCfg(ri, testLocation(), scope),
+				Config: testAccAzureRMSecurityCenterWorkspace_basicCfg(ri, acceptance.Location(), scope),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMSecurityCenterWorkspaceExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "scope", scope),
@@ -55,20 +58,20 @@ func testAccAzureRMSecurityCenterWorkspace_requiresImport(t *testing.T) {
 	scope := fmt.Sprintf("/subscriptions/%s", os.Getenv("ARM_SUBSCRIPTION_ID"))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSecurityCenterWorkspaceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMSecurityCenterWorkspace_basicCfg(ri, testLocation(), scope),
+				Config: testAccAzureRMSecurityCenterWorkspace_basicCfg(ri, acceptance.Location(), scope),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMSecurityCenterWorkspaceExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "scope", scope),
 				),
 			},
 			{
-				Config:      testAccAzureRMSecurityCenterWorkspace_requiresImportCfg(ri, testLocation(), scope),
-				ExpectError: testRequiresImportError("azurerm_security_center_workspace"),
+				Config:      testAccAzureRMSecurityCenterWorkspace_requiresImportCfg(ri, acceptance.Location(), scope),
+				ExpectError: acceptance.RequiresImportError("azurerm_security_center_workspace"),
 			},
 			{
 				//reset pricing to free
@@ -85,18 +88,18 @@ func testAccAzureRMSecurityCenterWorkspace_update(t *testing.T) {
 	scope := fmt.Sprintf("/subscriptions/%s", os.Getenv("ARM_SUBSCRIPTION_ID"))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMSecurityCenterWorkspace_basicCfg(ri, testLocation(), scope),
+				Config: testAccAzureRMSecurityCenterWorkspace_basicCfg(ri, acceptance.Location(), scope),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMSecurityCenterWorkspaceExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "scope", scope),
 				),
 			},
 			{
-				Config: testAccAzureRMSecurityCenterWorkspace_differentWorkspaceCfg(ri, testLocation(), scope),
+				Config: testAccAzureRMSecurityCenterWorkspace_differentWorkspaceCfg(ri, acceptance.Location(), scope),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMSecurityCenterWorkspaceExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "scope", scope),
@@ -117,8 +120,8 @@ func testAccAzureRMSecurityCenterWorkspace_update(t *testing.T) {
 
 func testCheckAzureRMSecurityCenterWorkspaceExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*ArmClient).SecurityCenter.WorkspaceClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).SecurityCenter.WorkspaceClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -141,15 +144,15 @@ func testCheckAzureRMSecurityCenterWorkspaceExists(resourceName string) resource
 }
 
 func testCheckAzureRMSecurityCenterWorkspaceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).SecurityCenter.WorkspaceClient
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).SecurityCenter.WorkspaceClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, res := range s.RootModule().Resources {
 		if res.Type != "azurerm_security_center_workspace" {
 			continue
 		}
 
-		resp, err := client.Get(ctx, securityCenterWorkspaceName)
+		resp, err := client.Get(ctx, securitycenter.SecurityCenterWorkspaceName)
 		if err != nil {
 			if utils.ResponseWasNotFound(resp.Response) {
 				return nil
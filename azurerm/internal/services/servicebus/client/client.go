@@ -1,4 +1,4 @@
-package servicebus
+package client
 
 import (
 	servicebusPreview "github.com/Azure/azure-sdk-for-go/services/preview/servicebus/mgmt/2018-01-01-preview/servicebus"
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -9,17 +9,19 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
 func TestAccAzureRMServiceBusNamespace_basic(t *testing.T) {
 	resourceName := "azurerm_servicebus_namespace.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMServiceBusNamespace_basic(ri, testLocation())
+	config := testAccAzureRMServiceBusNamespace_basic(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMServiceBusNamespaceDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -45,19 +47,19 @@ func TestAccAzureRMServiceBusNamespace_requiresImport(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMServiceBusNamespaceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMServiceBusNamespace_basic(ri, testLocation()),
+				Config: testAccAzureRMServiceBusNamespace_basic(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMServiceBusNamespaceExists(resourceName),
 				),
 			},
 			{
-				Config:      testAccAzureRMServiceBusNamespace_requiresImport(ri, testLocation()),
-				ExpectError: testRequiresImportError("azurerm_servicebus_namespace"),
+				Config:      testAccAzureRMServiceBusNamespace_requiresImport(ri, acceptance.Location()),
+				ExpectError: acceptance.RequiresImportError("azurerm_servicebus_namespace"),
 			},
 		},
 	})
@@ -66,11 +68,11 @@ func TestAccAzureRMServiceBusNamespace_requiresImport(t *testing.T) {
 func TestAccAzureRMServiceBusNamespace_readDefaultKeys(t *testing.T) {
 	resourceName := "azurerm_servicebus_namespace.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMServiceBusNamespace_basic(ri, testLocation())
+	config := testAccAzureRMServiceBusNamespace_basic(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMServiceBusNamespaceDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -95,11 +97,11 @@ func TestAccAzureRMServiceBusNamespace_NonStandardCasing(t *testing.T) {
 	resourceName := "azurerm_servicebus_namespace.test"
 
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMServiceBusNamespaceNonStandardCasing(ri, testLocation())
+	config := testAccAzureRMServiceBusNamespaceNonStandardCasing(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMServiceBusNamespaceDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -125,11 +127,11 @@ func TestAccAzureRMServiceBusNamespace_NonStandardCasing(t *testing.T) {
 func TestAccAzureRMServiceBusNamespace_premium(t *testing.T) {
 	resourceName := "azurerm_servicebus_namespace.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMServiceBusNamespace_premium(ri, testLocation())
+	config := testAccAzureRMServiceBusNamespace_premium(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMServiceBusNamespaceDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -149,11 +151,11 @@ func TestAccAzureRMServiceBusNamespace_premium(t *testing.T) {
 
 func TestAccAzureRMServiceBusNamespace_basicCapacity(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMServiceBusNamespace_basicCapacity(ri, testLocation())
+	config := testAccAzureRMServiceBusNamespace_basicCapacity(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMServiceBusNamespaceDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -166,11 +168,11 @@ func TestAccAzureRMServiceBusNamespace_basicCapacity(t *testing.T) {
 
 func TestAccAzureRMServiceBusNamespace_premiumCapacity(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMServiceBusNamespace_premiumCapacity(ri, testLocation())
+	config := testAccAzureRMServiceBusNamespace_premiumCapacity(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMServiceBusNamespaceDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -184,11 +186,11 @@ func TestAccAzureRMServiceBusNamespace_premiumCapacity(t *testing.T) {
 func TestAccAzureRMServiceBusNamespace_zoneRedundant(t *testing.T) {
 	resourceName := "azurerm_servicebus_namespace.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMServiceBusNamespace_zoneRedundant(ri, testLocation())
+	config := testAccAzureRMServiceBusNamespace_zoneRedundant(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMServiceBusNamespaceDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -208,8 +210,8 @@ func TestAccAzureRMServiceBusNamespace_zoneRedundant(t *testing.T) {
 }
 
 func testCheckAzureRMServiceBusNamespaceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).ServiceBus.NamespacesClientPreview
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	client := acceptance.AzureProvider.Meta().(*clients.Client).ServiceBus.NamespacesClientPreview
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_servicebus_namespace" {
@@ -247,8 +249,8 @@ func testCheckAzureRMServiceBusNamespaceExists(resourceName string) resource.Tes
 			return fmt.Errorf("Bad: no resource group found in state for Service Bus Namespace: %s", namespaceName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).ServiceBus.NamespacesClientPreview
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).ServiceBus.NamespacesClientPreview
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, namespaceName)
 		if err != nil {
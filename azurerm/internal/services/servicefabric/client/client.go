@@ -1,4 +1,4 @@
-package servicefabric
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/servicefabric/mgmt/2018-02-01/servicefabric"
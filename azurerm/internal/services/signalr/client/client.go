@@ -1,4 +1,4 @@
-package signalr
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/signalr/mgmt/2018-03-01-preview/signalr"
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
@@ -16,12 +18,12 @@ func TestAccAzureRMSignalRService_basic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSignalRServiceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMSignalRService_basic(ri, testLocation()),
+				Config: testAccAzureRMSignalRService_basic(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMSignalRServiceExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "sku.0.name", "Free_F1"),
@@ -53,12 +55,12 @@ func TestAccAzureRMSignalRService_requiresImport(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSignalRServiceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMSignalRService_basic(ri, testLocation()),
+				Config: testAccAzureRMSignalRService_basic(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMSignalRServiceExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "sku.0.name", "Free_F1"),
@@ -74,8 +76,8 @@ func TestAccAzureRMSignalRService_requiresImport(t *testing.T) {
 				),
 			},
 			{
-				Config:      testAccAzureRMSignalRService_requiresImport(ri, testLocation()),
-				ExpectError: testRequiresImportError("azurerm_signalr_service"),
+				Config:      testAccAzureRMSignalRService_requiresImport(ri, acceptance.Location()),
+				ExpectError: acceptance.RequiresImportError("azurerm_signalr_service"),
 			},
 		},
 	})
@@ -86,12 +88,12 @@ func TestAccAzureRMSignalRService_standard(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSignalRServiceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMSignalRService_standardWithCapacity(ri, testLocation(), 1),
+				Config: testAccAzureRMSignalRService_standardWithCapacity(ri, acceptance.Location(), 1),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMSignalRServiceExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "sku.0.name", "Standard_S1"),
@@ -120,12 +122,12 @@ func TestAccAzureRMSignalRService_standardWithCap2(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSignalRServiceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMSignalRService_standardWithCapacity(ri, testLocation(), 2),
+				Config: testAccAzureRMSignalRService_standardWithCapacity(ri, acceptance.Location(), 2),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMSignalRServiceExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "sku.0.name", "Standard_S1"),
@@ -152,14 +154,14 @@ func TestAccAzureRMSignalRService_standardWithCap2(t *testing.T) {
 func TestAccAzureRMSignalRService_skuUpdate(t *testing.T) {
 	resourceName := "azurerm_signalr_service.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	freeConfig := testAccAzureRMSignalRService_basic(ri, location)
 	standardConfig := testAccAzureRMSignalRService_standardWithCapacity(ri, location, 1)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testCheckAzureRMMonitorMetricAlertDestroy,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMSignalRServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: freeConfig,
@@ -216,14 +218,14 @@ func TestAccAzureRMSignalRService_skuUpdate(t *testing.T) {
 func TestAccAzureRMSignalRService_capacityUpdate(t *testing.T) {
 	resourceName := "azurerm_signalr_service.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	standardConfig := testAccAzureRMSignalRService_standardWithCapacity(ri, location, 1)
 	standardCap5Config := testAccAzureRMSignalRService_standardWithCapacity(ri, location, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testCheckAzureRMMonitorMetricAlertDestroy,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMSignalRServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: standardConfig,
@@ -280,14 +282,14 @@ func TestAccAzureRMSignalRService_capacityUpdate(t *testing.T) {
 func TestAccAzureRMSignalRService_skuAndCapacityUpdate(t *testing.T) {
 	resourceName := "azurerm_signalr_service.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 	freeConfig := testAccAzureRMSignalRService_basic(ri, location)
 	standardConfig := testAccAzureRMSignalRService_standardWithCapacity(ri, location, 2)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testCheckAzureRMMonitorMetricAlertDestroy,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMSignalRServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: freeConfig,
@@ -399,8 +401,8 @@ resource "azurerm_signalr_service" "test" {
 }
 
 func testCheckAzureRMSignalRServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).SignalR.Client
-	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+	conn := acceptance.AzureProvider.Meta().(*clients.Client).SignalR.Client
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_signalr_service" {
 			continue
@@ -434,8 +436,8 @@ func testCheckAzureRMSignalRServiceExists(resourceName string) resource.TestChec
 			return fmt.Errorf("Bad: no resource group found in state for SignalR service: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).SignalR.Client
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		conn := acceptance.AzureProvider.Meta().(*clients.Client).SignalR.Client
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, name)
 		if err != nil {
@@ -1,15 +1,16 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -19,17 +20,18 @@ import (
 	Test a basic SQL virtual network rule configuration setup and update scenario, and
 	validate that new property is set correctly.
 */
+
 func TestAccAzureRMSqlVirtualNetworkRule_basic(t *testing.T) {
 	resourceName := "azurerm_sql_virtual_network_rule.test"
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSqlVirtualNetworkRuleDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMSqlVirtualNetworkRule_basic(ri, testLocation()),
+				Config: testAccAzureRMSqlVirtualNetworkRule_basic(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMSqlVirtualNetworkRuleExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "ignore_missing_vnet_service_endpoint", "false"),
@@ -41,7 +43,7 @@ func TestAccAzureRMSqlVirtualNetworkRule_basic(t *testing.T) {
 				ImportStateVerify: true,
 			},
 			{
-				Config: testAccAzureRMSqlVirtualNetworkRule_withUpdates(ri, testLocation()),
+				Config: testAccAzureRMSqlVirtualNetworkRule_withUpdates(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMSqlVirtualNetworkRuleExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "ignore_missing_vnet_service_endpoint", "true"),
@@ -60,20 +62,20 @@ func TestAccAzureRMSqlVirtualNetworkRule_requiresImport(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSqlVirtualNetworkRuleDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMSqlVirtualNetworkRule_basic(ri, testLocation()),
+				Config: testAccAzureRMSqlVirtualNetworkRule_basic(ri, acceptance.Location()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMSqlVirtualNetworkRuleExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "ignore_missing_vnet_service_endpoint", "false"),
 				),
 			},
 			{
-				Config:      testAccAzureRMSqlVirtualNetworkRule_requiresImport(ri, testLocation()),
-				ExpectError: testRequiresImportError("azurerm_sql_virtual_network_rule"),
+				Config:      testAccAzureRMSqlVirtualNetworkRule_requiresImport(ri, acceptance.Location()),
+				ExpectError: acceptance.RequiresImportError("azurerm_sql_virtual_network_rule"),
 			},
 		},
 	})
@@ -84,20 +86,21 @@ func TestAccAzureRMSqlVirtualNetworkRule_requiresImport(t *testing.T) {
 	Test an update to the SQL Virtual Network Rule to connect to a different subnet, and
 	validate that new subnet is set correctly.
 */
+
 func TestAccAzureRMSqlVirtualNetworkRule_switchSubnets(t *testing.T) {
 	resourceName := "azurerm_sql_virtual_network_rule.test"
 	ri := tf.AccRandTimeInt()
 
-	preConfig := testAccAzureRMSqlVirtualNetworkRule_subnetSwitchPre(ri, testLocation())
-	postConfig := testAccAzureRMSqlVirtualNetworkRule_subnetSwitchPost(ri, testLocation())
+	preConfig := testAccAzureRMSqlVirtualNetworkRule_subnetSwitchPre(ri, acceptance.Location())
+	postConfig := testAccAzureRMSqlVirtualNetworkRule_subnetSwitchPost(ri, acceptance.Location())
 
 	// Create regex strings that will ensure that one subnet name exists, but not the other
 	preConfigRegex := regexp.MustCompile(fmt.Sprintf("(subnet1%d)$|(subnet[^2]%d)$", ri, ri))  //subnet 1 but not 2
 	postConfigRegex := regexp.MustCompile(fmt.Sprintf("(subnet2%d)$|(subnet[^1]%d)$", ri, ri)) //subnet 2 but not 1
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSqlVirtualNetworkRuleDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -121,14 +124,15 @@ func TestAccAzureRMSqlVirtualNetworkRule_switchSubnets(t *testing.T) {
 /*
 	---Testing for Success---
 */
+
 func TestAccAzureRMSqlVirtualNetworkRule_disappears(t *testing.T) {
 	resourceName := "azurerm_sql_virtual_network_rule.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMSqlVirtualNetworkRule_basic(ri, testLocation())
+	config := testAccAzureRMSqlVirtualNetworkRule_basic(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSqlVirtualNetworkRuleDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -148,14 +152,15 @@ func TestAccAzureRMSqlVirtualNetworkRule_disappears(t *testing.T) {
 	Test if we are able to create a vnet without the SQL endpoint, but SQL rule
 	is still applied since the endpoint validation will be set to false.
 */
+
 func TestAccAzureRMSqlVirtualNetworkRule_IgnoreEndpointValid(t *testing.T) {
 	resourceName := "azurerm_sql_virtual_network_rule.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMSqlVirtualNetworkRule_ignoreEndpointValid(ri, testLocation())
+	config := testAccAzureRMSqlVirtualNetworkRule_ignoreEndpointValid(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSqlVirtualNetworkRuleDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -173,13 +178,14 @@ func TestAccAzureRMSqlVirtualNetworkRule_IgnoreEndpointValid(t *testing.T) {
 	Test if we are able to create a vnet with out the SQL endpoint, but SQL rule
 	is still applied since the endpoint validation will be set to false.
 */
+
 func TestAccAzureRMSqlVirtualNetworkRule_IgnoreEndpointInvalid(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMSqlVirtualNetworkRule_ignoreEndpointInvalid(ri, testLocation())
+	config := testAccAzureRMSqlVirtualNetworkRule_ignoreEndpointInvalid(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSqlVirtualNetworkRuleDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -195,16 +201,17 @@ func TestAccAzureRMSqlVirtualNetworkRule_IgnoreEndpointInvalid(t *testing.T) {
 	Test if we are able to create multiple subnets and connect multiple subnets to the
 	SQL server.
 */
+
 func TestAccAzureRMSqlVirtualNetworkRule_multipleSubnets(t *testing.T) {
 	resourceName1 := "azurerm_sql_virtual_network_rule.rule1"
 	resourceName2 := "azurerm_sql_virtual_network_rule.rule2"
 	resourceName3 := "azurerm_sql_virtual_network_rule.rule3"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMSqlVirtualNetworkRule_multipleSubnets(ri, testLocation())
+	config := testAccAzureRMSqlVirtualNetworkRule_multipleSubnets(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMSqlVirtualNetworkRuleDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -223,127 +230,6 @@ func TestAccAzureRMSqlVirtualNetworkRule_multipleSubnets(t *testing.T) {
 	--Testing for Failure--
 	Validation Function Tests - Invalid Name Validations
 */
-func TestResourceAzureRMSqlVirtualNetworkRule_invalidNameValidation(t *testing.T) {
-	cases := []struct {
-		Value    string
-		ErrCount int
-	}{
-		// Must only contain alphanumeric characters or hyphens (4 cases)
-		{
-			Value:    "test!Rule",
-			ErrCount: 1,
-		},
-		{
-			Value:    "test_Rule",
-			ErrCount: 1,
-		},
-		{
-			Value:    "test:Rule",
-			ErrCount: 1,
-		},
-		{
-			Value:    "test'Rule",
-			ErrCount: 1,
-		},
-		// Cannot be more than 128 characters (1 case - ensure starts with a letter)
-		{
-			Value:    fmt.Sprintf("v%s", acctest.RandString(128)),
-			ErrCount: 1,
-		},
-		// Cannot be empty (1 case)
-		{
-			Value:    "",
-			ErrCount: 1,
-		},
-		// Cannot end in a hyphen (1 case)
-		{
-			Value:    "testRule-",
-			ErrCount: 1,
-		},
-		// Cannot start with a number or hyphen (2 cases)
-		{
-			Value:    "7testRule",
-			ErrCount: 1,
-		},
-		{
-			Value:    "-testRule",
-			ErrCount: 1,
-		},
-	}
-
-	for _, tc := range cases {
-		_, errors := validateSqlVirtualNetworkRuleName(tc.Value, "azurerm_sql_virtual_network_rule")
-
-		if len(errors) != tc.ErrCount {
-			t.Fatalf("Bad: Expected the Azure RM SQL Virtual Network Rule Name to trigger a validation error.")
-		}
-	}
-}
-
-/*
-	--Testing for Success--
-	Validation Function Tests - (Barely) Valid Name Validations
-*/
-func TestResourceAzureRMSqlVirtualNetworkRule_validNameValidation(t *testing.T) {
-	cases := []struct {
-		Value    string
-		ErrCount int
-	}{
-		// Test all lowercase
-		{
-			Value:    "thisisarule",
-			ErrCount: 0,
-		},
-		// Test all uppercase
-		{
-			Value:    "THISISARULE",
-			ErrCount: 0,
-		},
-		// Test alternating cases
-		{
-			Value:    "tHiSiSaRuLe",
-			ErrCount: 0,
-		},
-		// Test hyphens
-		{
-			Value:    "this-is-a-rule",
-			ErrCount: 0,
-		},
-		// Test multiple hyphens in a row
-		{
-			Value:    "this----1s----a----ru1e",
-			ErrCount: 0,
-		},
-		// Test numbers (except for first character)
-		{
-			Value:    "v1108501298509850810258091285091820-5",
-			ErrCount: 0,
-		},
-		// Test a lot of hyphens and numbers
-		{
-			Value:    "x-5-4-1-2-5-2-6-1-5-2-5-1-2-5-6-2-2",
-			ErrCount: 0,
-		},
-		// Test exactly 128 characters
-		{
-			Value:    fmt.Sprintf("v%s", acctest.RandString(127)),
-			ErrCount: 0,
-		},
-		// Test short, 1-letter name
-		{
-			Value:    "V",
-			ErrCount: 0,
-		},
-	}
-
-	for _, tc := range cases {
-		_, errors := validateSqlVirtualNetworkRuleName(tc.Value, "azurerm_sql_virtual_network_rule")
-
-		if len(errors) != tc.ErrCount {
-			t.Fatalf("Bad: Expected the Azure RM SQL Virtual Network Rule Name pass name validation successfully but triggered a validation error.")
-		}
-	}
-}
 
 /*
 	Test Check function to assert if a rule exists or not.
@@ -359,8 +245,8 @@ func testCheckAzureRMSqlVirtualNetworkRuleExists(resourceName string) resource.T
 		serverName := rs.Primary.Attributes["server_name"]
 		ruleName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).Sql.VirtualNetworkRulesClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Sql.VirtualNetworkRulesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, ruleName)
 		if err != nil {
@@ -378,6 +264,7 @@ func testCheckAzureRMSqlVirtualNetworkRuleExists(resourceName string) resource.T
 /*
 	Test Check function to delete a rule.
 */
+
 func testCheckAzureRMSqlVirtualNetworkRuleDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_sql_virtual_network_rule" {
@@ -388,8 +275,8 @@ func testCheckAzureRMSqlVirtualNetworkRuleDestroy(s *terraform.State) error {
 		serverName := rs.Primary.Attributes["server_name"]
 		ruleName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).Sql.VirtualNetworkRulesClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Sql.VirtualNetworkRulesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, ruleName)
 		if err != nil {
@@ -409,6 +296,7 @@ func testCheckAzureRMSqlVirtualNetworkRuleDestroy(s *terraform.State) error {
 /*
 	Test Check function to assert if that a rule gets deleted.
 */
+
 func testCheckAzureRMSqlVirtualNetworkRuleDisappears(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -421,8 +309,8 @@ func testCheckAzureRMSqlVirtualNetworkRuleDisappears(resourceName string) resour
 		serverName := rs.Primary.Attributes["server_name"]
 		ruleName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).Sql.VirtualNetworkRulesClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Sql.VirtualNetworkRulesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		future, err := client.Delete(ctx, resourceGroup, serverName, ruleName)
 		if err != nil {
@@ -452,6 +340,7 @@ func testCheckAzureRMSqlVirtualNetworkRuleDisappears(resourceName string) resour
 	(This test configuration is intended to succeed.)
 	Basic Provisioning Configuration
 */
+
 func testAccAzureRMSqlVirtualNetworkRule_basic(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -492,6 +381,7 @@ resource "azurerm_sql_virtual_network_rule" "test" {
 }
 `, rInt, location, rInt, rInt, rInt, rInt, rInt)
 }
+
 func testAccAzureRMSqlVirtualNetworkRule_requiresImport(rInt int, location string) string {
 	return fmt.Sprintf(`
 %s
@@ -511,6 +401,7 @@ resource "azurerm_sql_virtual_network_rule" "import" {
 	Basic Provisioning Update Configuration (all other properties would recreate the rule)
 	ignore_missing_vnet_service_endpoint (false ==> true)
 */
+
 func testAccAzureRMSqlVirtualNetworkRule_withUpdates(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -557,6 +448,7 @@ resource "azurerm_sql_virtual_network_rule" "test" {
 	This test is designed to set up a scenario where a user would want to update the subnet
 	on a given SQL virtual network rule. This configuration sets up the resources initially.
 */
+
 func testAccAzureRMSqlVirtualNetworkRule_subnetSwitchPre(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -611,6 +503,7 @@ resource "azurerm_sql_virtual_network_rule" "test" {
 	on a given SQL virtual network rule. This configuration contains the update from
 	azurerm_subnet.test1 to azurerm_subnet.test2.
 */
+
 func testAccAzureRMSqlVirtualNetworkRule_subnetSwitchPost(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -665,6 +558,7 @@ resource "azurerm_sql_virtual_network_rule" "test" {
     virtual network rule is set to *not* validate that the service_endpoint includes that value.
     The endpoint is purposefully set to Microsoft.Storage.
 */
+
 func testAccAzureRMSqlVirtualNetworkRule_ignoreEndpointValid(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -712,6 +606,7 @@ resource "azurerm_sql_virtual_network_rule" "test" {
     virtual network rule is set to validate that the service_endpoint includes that value.
     The endpoint is purposefully set to Microsoft.Storage.
 */
+
 func testAccAzureRMSqlVirtualNetworkRule_ignoreEndpointInvalid(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -758,6 +653,7 @@ resource "azurerm_sql_virtual_network_rule" "test" {
 	This configuration sets up 3 subnets in 2 different virtual networks, and adds
 	SQL virtual network rules for all 3 subnets to the SQL server.
 */
+
 func testAccAzureRMSqlVirtualNetworkRule_multipleSubnets(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// accountKeysCacheTTL is how long an Account Key is cached for, after which it's looked up again
+	// to account for the Storage Account Keys being rotated outside of Terraform
+	accountKeysCacheTTL = 10 * time.Minute
+
+	// resourceGroupNamesCacheTTL is how long the Resource Group for a Storage Account is cached for
+	resourceGroupNamesCacheTTL = time.Hour
+)
+
+type cacheEntry struct {
+	value     string
+	expiresAt time.Time
+}
+
+// ttlCache is a thread-safe cache where each entry expires after a fixed duration
+type ttlCache struct {
+	entries map[string]cacheEntry
+	lock    sync.RWMutex
+	ttl     time.Duration
+
+	// now is overridden in the tests
+	now func() time.Time
+}
+
+func newTTLCache(ttl time.Duration) *ttlCache {
+	return &ttlCache{
+		entries: make(map[string]cacheEntry),
+		ttl:     ttl,
+		now:     time.Now,
+	}
+}
+
+// get returns the cached value for the specified key, if it exists and hasn't expired
+func (c *ttlCache) get(key string) (string, bool) {
+	c.lock.RLock()
+	entry, ok := c.entries[key]
+	c.lock.RUnlock()
+
+	if !ok || !c.now().Before(entry.expiresAt) {
+		return "", false
+	}
+
+	return entry.value, true
+}
+
+// set caches the specified value until the TTL expires
+func (c *ttlCache) set(key, value string) {
+	c.lock.Lock()
+	c.entries[key] = cacheEntry{
+		value:     value,
+		expiresAt: c.now().Add(c.ttl),
+	}
+	c.lock.Unlock()
+}
+
+// remove removes the specified key from the cache, if it exists
+func (c *ttlCache) remove(key string) {
+	c.lock.Lock()
+	delete(c.entries, key)
+	c.lock.Unlock()
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTTLCache(t *testing.T) {
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	cache := newTTLCache(time.Minute)
+	cache.now = func() time.Time {
+		return now
+	}
+
+	if _, ok := cache.get("example"); ok {
+		t.Fatalf("Expected a cache miss for a key which hasn't been set")
+	}
+
+	cache.set("example", "value")
+	if v, ok := cache.get("example"); !ok || v != "value" {
+		t.Fatalf("Expected a cache hit with %q but got %q (ok: %t)", "value", v, ok)
+	}
+
+	now = now.Add(59 * time.Second)
+	if _, ok := cache.get("example"); !ok {
+		t.Fatalf("Expected a cache hit before the TTL expired")
+	}
+
+	now = now.Add(time.Second)
+	if _, ok := cache.get("example"); ok {
+		t.Fatalf("Expected a cache miss once the TTL expired")
+	}
+
+	cache.set("example", "updated")
+	cache.remove("example")
+	if _, ok := cache.get("example"); ok {
+		t.Fatalf("Expected a cache miss once the key was removed")
+	}
+}
+
+func TestTTLCache_Concurrent(t *testing.T) {
+	cache := newTTLCache(time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			key := fmt.Sprintf("key-%d", i%5)
+			cache.set(key, "value")
+			cache.get(key)
+			if i%10 == 0 {
+				cache.remove(key)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -1,10 +1,12 @@
-package storage
+package client
 
 import (
 	"context"
 	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/Azure/go-autorest/autorest"
 	az "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/authorizers"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
@@ -20,22 +22,41 @@ import (
 type Client struct {
 	AccountsClient storage.AccountsClient
 
-	environment az.Environment
+	environment     az.Environment
+	resourcesClient resources.Client
+	storageAdAuth   *autorest.Authorizer
 }
 
 func BuildClient(options *common.ClientOptions) *Client {
 	accountsClient := storage.NewAccountsClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&accountsClient.Client, options.ResourceManagerAuthorizer)
 
+	resourcesClient := resources.NewClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&resourcesClient.Client, options.ResourceManagerAuthorizer)
+
 	// TODO: switch Storage Containers to using the storage.BlobContainersClient
 	// (which should fix #2977) when the storage clients have been moved in here
-	return &Client{
-		AccountsClient: accountsClient,
-		environment:    options.Environment,
+	client := Client{
+		AccountsClient:  accountsClient,
+		environment:     options.Environment,
+		resourcesClient: resourcesClient,
+	}
+
+	if options.StorageUseAzureAD {
+		client.storageAdAuth = &options.StorageAuthorizer
 	}
+
+	return &client
 }
 
 func (client Client) BlobsClient(ctx context.Context, resourceGroup, accountName string) (*blobs.Client, error) {
+	// Azure AD authentication is only supported for Blobs and Queues at this time
+	if client.storageAdAuth != nil {
+		blobsClient := blobs.NewWithEnvironment(client.environment)
+		blobsClient.Client.Authorizer = *client.storageAdAuth
+		return &blobsClient, nil
+	}
+
 	accountKey, err := client.findAccountKey(ctx, resourceGroup, accountName)
 	if err != nil {
 		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
@@ -44,10 +65,18 @@ func (client Client) BlobsClient(ctx context.Context, resourceGroup, accountName
 	storageAuth := authorizers.NewSharedKeyAuthorizer(accountName, *accountKey)
 	blobsClient := blobs.NewWithEnvironment(client.environment)
 	blobsClient.Client.Authorizer = storageAuth
+	blobsClient.Client.ResponseInspector = invalidateAccountKeyOnAuthFailure(resourceGroup, accountName)
 	return &blobsClient, nil
 }
 
 func (client Client) ContainersClient(ctx context.Context, resourceGroup, accountName string) (*containers.Client, error) {
+	// Azure AD authentication is only supported for Blobs and Queues at this time
+	if client.storageAdAuth != nil {
+		containersClient := containers.NewWithEnvironment(client.environment)
+		containersClient.Client.Authorizer = *client.storageAdAuth
+		return &containersClient, nil
+	}
+
 	accountKey, err := client.findAccountKey(ctx, resourceGroup, accountName)
 	if err != nil {
 		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
@@ -56,6 +85,7 @@ func (client Client) ContainersClient(ctx context.Context, resourceGroup, accoun
 	storageAuth := authorizers.NewSharedKeyAuthorizer(accountName, *accountKey)
 	containersClient := containers.NewWithEnvironment(client.environment)
 	containersClient.Client.Authorizer = storageAuth
+	containersClient.Client.ResponseInspector = invalidateAccountKeyOnAuthFailure(resourceGroup, accountName)
 	return &containersClient, nil
 }
 
@@ -68,6 +98,7 @@ func (client Client) FileShareDirectoriesClient(ctx context.Context, resourceGro
 	storageAuth := authorizers.NewSharedKeyLiteAuthorizer(accountName, *accountKey)
 	directoriesClient := directories.NewWithEnvironment(client.environment)
 	directoriesClient.Client.Authorizer = storageAuth
+	directoriesClient.Client.ResponseInspector = invalidateAccountKeyOnAuthFailure(resourceGroup, accountName)
 	return &directoriesClient, nil
 }
 
@@ -80,10 +111,18 @@ func (client Client) FileSharesClient(ctx context.Context, resourceGroup, accoun
 	storageAuth := authorizers.NewSharedKeyLiteAuthorizer(accountName, *accountKey)
 	directoriesClient := shares.NewWithEnvironment(client.environment)
 	directoriesClient.Client.Authorizer = storageAuth
+	directoriesClient.Client.ResponseInspector = invalidateAccountKeyOnAuthFailure(resourceGroup, accountName)
 	return &directoriesClient, nil
 }
 
 func (client Client) QueuesClient(ctx context.Context, resourceGroup, accountName string) (*queues.Client, error) {
+	// Azure AD authentication is only supported for Blobs and Queues at this time
+	if client.storageAdAuth != nil {
+		queuesClient := queues.NewWithEnvironment(client.environment)
+		queuesClient.Client.Authorizer = *client.storageAdAuth
+		return &queuesClient, nil
+	}
+
 	accountKey, err := client.findAccountKey(ctx, resourceGroup, accountName)
 	if err != nil {
 		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
@@ -92,6 +131,7 @@ func (client Client) QueuesClient(ctx context.Context, resourceGroup, accountNam
 	storageAuth := authorizers.NewSharedKeyLiteAuthorizer(accountName, *accountKey)
 	queuesClient := queues.NewWithEnvironment(client.environment)
 	queuesClient.Client.Authorizer = storageAuth
+	queuesClient.Client.ResponseInspector = invalidateAccountKeyOnAuthFailure(resourceGroup, accountName)
 	return &queuesClient, nil
 }
 
@@ -104,6 +144,7 @@ func (client Client) TableEntityClient(ctx context.Context, resourceGroup, accou
 	storageAuth := authorizers.NewSharedKeyLiteTableAuthorizer(accountName, *accountKey)
 	entitiesClient := entities.NewWithEnvironment(client.environment)
 	entitiesClient.Client.Authorizer = storageAuth
+	entitiesClient.Client.ResponseInspector = invalidateAccountKeyOnAuthFailure(resourceGroup, accountName)
 	return &entitiesClient, nil
 }
 
@@ -116,5 +157,6 @@ func (client Client) TablesClient(ctx context.Context, resourceGroup, accountNam
 	storageAuth := authorizers.NewSharedKeyLiteTableAuthorizer(accountName, *accountKey)
 	tablesClient := tables.NewWithEnvironment(client.environment)
 	tablesClient.Client.Authorizer = storageAuth
+	tablesClient.Client.ResponseInspector = invalidateAccountKeyOnAuthFailure(resourceGroup, accountName)
 	return &tablesClient, nil
 }
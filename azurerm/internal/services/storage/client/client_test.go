@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+func TestBuildClient_StorageUseAzureAD(t *testing.T) {
+	options := &common.ClientOptions{
+		ResourceManagerEndpoint: azure.PublicCloud.ResourceManagerEndpoint,
+		StorageAuthorizer:       autorest.NullAuthorizer{},
+		StorageUseAzureAD:       true,
+		Environment:             azure.PublicCloud,
+	}
+	client := BuildClient(options)
+	ctx := context.TODO()
+
+	// when using Azure AD the Account Key shouldn't be looked up, so these shouldn't make any API calls
+	blobsClient, err := client.BlobsClient(ctx, "resGroup", "account1")
+	if err != nil {
+		t.Fatalf("Error building Blobs Client: %+v", err)
+	}
+	if _, ok := blobsClient.Client.Authorizer.(autorest.NullAuthorizer); !ok {
+		t.Fatalf("Expected the Blobs Client to use the Storage Authorizer but got %T", blobsClient.Client.Authorizer)
+	}
+
+	containersClient, err := client.ContainersClient(ctx, "resGroup", "account1")
+	if err != nil {
+		t.Fatalf("Error building Containers Client: %+v", err)
+	}
+	if _, ok := containersClient.Client.Authorizer.(autorest.NullAuthorizer); !ok {
+		t.Fatalf("Expected the Containers Client to use the Storage Authorizer but got %T", containersClient.Client.Authorizer)
+	}
+
+	queuesClient, err := client.QueuesClient(ctx, "resGroup", "account1")
+	if err != nil {
+		t.Fatalf("Error building Queues Client: %+v", err)
+	}
+	if _, ok := queuesClient.Client.Authorizer.(autorest.NullAuthorizer); !ok {
+		t.Fatalf("Expected the Queues Client to use the Storage Authorizer but got %T", queuesClient.Client.Authorizer)
+	}
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+var (
+	accountKeysCache        = newTTLCache(accountKeysCacheTTL)
+	resourceGroupNamesCache = newTTLCache(resourceGroupNamesCacheTTL)
+)
+
+func accountKeysCacheKey(resourceGroup, accountName string) string {
+	return fmt.Sprintf("%s-%s", resourceGroup, accountName)
+}
+
+func (client Client) ClearFromCache(resourceGroup, accountName string) {
+	log.Printf("[DEBUG] Removing Account %q (Resource Group %q) from the cache", accountName, resourceGroup)
+	accountKeysCache.remove(accountKeysCacheKey(resourceGroup, accountName))
+	resourceGroupNamesCache.remove(accountName)
+	log.Printf("[DEBUG] Removed Account %q (Resource Group %q) from the cache", accountName, resourceGroup)
+}
+
+func (client Client) FindResourceGroup(ctx context.Context, accountName string) (*string, error) {
+	cacheKey := accountName
+	if v, ok := resourceGroupNamesCache.get(cacheKey); ok {
+		return &v, nil
+	}
+
+	log.Printf("[DEBUG] Cache Miss - looking up the resource group for storage account %q..", accountName)
+
+	// rather than listing every Storage Account in the Subscription, filter the Resources to just the
+	// Storage Account we're looking for - the `name` filter is case-insensitive
+	filter := fmt.Sprintf("resourceType eq 'Microsoft.Storage/storageAccounts' and name eq '%s'", accountName)
+	resources, err := client.resourcesClient.ListComplete(ctx, filter, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Storage Accounts (to find Resource Group for %q): %s", accountName, err)
+	}
+
+	var resourceGroup *string
+	for resources.NotDone() {
+		account := resources.Value()
+		if account.Name != nil && account.ID != nil && strings.EqualFold(accountName, *account.Name) {
+			id, err := azure.ParseAzureResourceID(*account.ID)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing ID for Storage Account %q: %s", accountName, err)
+			}
+
+			resourceGroup = &id.ResourceGroup
+			break
+		}
+
+		if err := resources.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("Error listing Storage Accounts (to find Resource Group for %q): %s", accountName, err)
+		}
+	}
+
+	if resourceGroup != nil {
+		resourceGroupNamesCache.set(cacheKey, *resourceGroup)
+	}
+
+	return resourceGroup, nil
+}
+
+func (client Client) findAccountKey(ctx context.Context, resourceGroup, accountName string) (*string, error) {
+	cacheKey := accountKeysCacheKey(resourceGroup, accountName)
+	if v, ok := accountKeysCache.get(cacheKey); ok {
+		return &v, nil
+	}
+
+	log.Printf("[DEBUG] Cache Miss - looking up the account key for storage account %q..", accountName)
+	props, err := client.AccountsClient.ListKeys(ctx, resourceGroup, accountName)
+	if err != nil {
+		return nil, fmt.Errorf("Error Listing Keys for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	if props.Keys == nil || len(*props.Keys) == 0 || (*props.Keys)[0].Value == nil {
+		return nil, fmt.Errorf("Keys were nil for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	keys := *props.Keys
+	firstKey := keys[0].Value
+
+	accountKeysCache.set(cacheKey, *firstKey)
+
+	return firstKey, nil
+}
+
+// invalidateAccountKeyOnAuthFailure returns a RespondDecorator which removes the cached Account Key
+// for this Storage Account when the Data Plane API rejects it, since this means the Account Keys
+// have been rotated - meaning the new key is looked up the next time a client is built
+func invalidateAccountKeyOnAuthFailure(resourceGroup, accountName string) autorest.RespondDecorator {
+	return func(r autorest.Responder) autorest.Responder {
+		return autorest.ResponderFunc(func(resp *http.Response) error {
+			if resp != nil && resp.StatusCode == http.StatusForbidden {
+				log.Printf("[DEBUG] The Account Key for Storage Account %q (Resource Group %q) was rejected - removing it from the cache", accountName, resourceGroup)
+				accountKeysCache.remove(accountKeysCacheKey(resourceGroup, accountName))
+			}
+
+			return r.Respond(resp)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package storage
+
+import "testing"
+
+func TestAccDataSourceArmStorageAccountBlobContainerSas_permissionsString(t *testing.T) {
+	testCases := []struct {
+		input    map[string]interface{}
+		expected string
+	}{
+		{map[string]interface{}{"read": true}, "r"},
+		{map[string]interface{}{"add": true}, "a"},
+		{map[string]interface{}{"create": true}, "c"},
+		{map[string]interface{}{"write": true}, "w"},
+		{map[string]interface{}{"delete": true}, "d"},
+		{map[string]interface{}{"list": true}, "l"},
+		{map[string]interface{}{"add": true, "write": true, "read": true, "delete": true}, "rawd"},
+	}
+
+	for _, test := range testCases {
+		result := buildContainerPermissionsString(test.input)
+		if test.expected != result {
+			t.Fatalf("Failed to build resource type string: expected: %s, result: %s", test.expected, result)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package storage
+
+import "testing"
+
+func TestAccDataSourceArmStorageAccountSas_resourceTypesString(t *testing.T) {
+	testCases := []struct {
+		input    map[string]interface{}
+		expected string
+	}{
+		{map[string]interface{}{"service": true}, "s"},
+		{map[string]interface{}{"container": true}, "c"},
+		{map[string]interface{}{"object": true}, "o"},
+		{map[string]interface{}{"service": true, "container": true, "object": true}, "sco"},
+	}
+
+	for _, test := range testCases {
+		result := buildResourceTypesString(test.input)
+		if test.expected != result {
+			t.Fatalf("Failed to build resource type string: expected: %s, result: %s", test.expected, result)
+		}
+	}
+}
+
+func TestAccDataSourceArmStorageAccountSas_servicesString(t *testing.T) {
+	testCases := []struct {
+		input    map[string]interface{}
+		expected string
+	}{
+		{map[string]interface{}{"blob": true}, "b"},
+		{map[string]interface{}{"queue": true}, "q"},
+		{map[string]interface{}{"table": true}, "t"},
+		{map[string]interface{}{"file": true}, "f"},
+		{map[string]interface{}{"blob": true, "queue": true, "table": true, "file": true}, "bqtf"},
+	}
+
+	for _, test := range testCases {
+		result := buildServicesString(test.input)
+		if test.expected != result {
+			t.Fatalf("Failed to build resource type string: expected: %s, result: %s", test.expected, result)
+		}
+	}
+}
+
+func TestAccDataSourceArmStorageAccountSas_permissionsString(t *testing.T) {
+	testCases := []struct {
+		input    map[string]interface{}
+		expected string
+	}{
+		{map[string]interface{}{"read": true}, "r"},
+		{map[string]interface{}{"write": true}, "w"},
+		{map[string]interface{}{"delete": true}, "d"},
+		{map[string]interface{}{"list": true}, "l"},
+		{map[string]interface{}{"add": true}, "a"},
+		{map[string]interface{}{"create": true}, "c"},
+		{map[string]interface{}{"update": true}, "u"},
+		{map[string]interface{}{"process": true}, "p"},
+		{map[string]interface{}{"read": true, "write": true, "add": true, "create": true}, "rwac"},
+	}
+
+	for _, test := range testCases {
+		result := buildPermissionsString(test.input)
+		if test.expected != result {
+			t.Fatalf("Failed to build resource type string: expected: %s, result: %s", test.expected, result)
+		}
+	}
+}
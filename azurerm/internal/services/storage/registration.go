@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Storage"
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azurerm_storage_account":                    dataSourceArmStorageAccount(),
+		"azurerm_storage_account_blob_container_sas": dataSourceArmStorageAccountBlobContainerSharedAccessSignature(),
+		"azurerm_storage_account_sas":                dataSourceArmStorageAccountSharedAccessSignature(),
+	}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azurerm_storage_account":         resourceArmStorageAccount(),
+		"azurerm_storage_blob":            resourceArmStorageBlob(),
+		"azurerm_storage_container":       resourceArmStorageContainer(),
+		"azurerm_storage_queue":           resourceArmStorageQueue(),
+		"azurerm_storage_share":           resourceArmStorageShare(),
+		"azurerm_storage_share_directory": resourceArmStorageShareDirectory(),
+		"azurerm_storage_table":           resourceArmStorageTable(),
+		"azurerm_storage_table_entity":    resourceArmStorageTableEntity(),
+	}
+}
@@ -0,0 +1,43 @@
+package storage
+
+import "testing"
+
+func TestValidateArmStorageAccountType(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"standard_lrs", false},
+		{"invalid", true},
+	}
+
+	for _, test := range testCases {
+		_, es := validateArmStorageAccountType(test.input, "account_type")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating account_type %q to fail", test.input)
+		}
+	}
+}
+
+func TestValidateArmStorageAccountName(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"ab", true},
+		{"ABC", true},
+		{"abc", false},
+		{"123456789012345678901234", false},
+		{"1234567890123456789012345", true},
+		{"abc12345", false},
+	}
+
+	for _, test := range testCases {
+		_, es := ValidateArmStorageAccountName(test.input, "name")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating name %q to fail", test.input)
+		}
+	}
+}
@@ -1,13 +1,14 @@
-package azurerm
+package storage
 
 import (
 	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 )
 
-func resourceStorageBlobMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+func ResourceStorageBlobMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
 	switch v {
 	case 0:
 		log.Println("[INFO] Found AzureRM Storage Blob State v0; migrating to v1")
@@ -25,7 +26,7 @@ func migrateStorageBlobStateV0toV1(is *terraform.InstanceState, meta interface{}
 
 	log.Printf("[DEBUG] ARM Storage Blob Attributes before Migration: %#v", is.Attributes)
 
-	environment := meta.(*ArmClient).environment
+	environment := meta.(*clients.Client).Account.Environment
 
 	blobName := is.Attributes["name"]
 	containerName := is.Attributes["storage_container_name"]
@@ -1,4 +1,4 @@
-package azurerm
+package storage
 
 import (
 	"fmt"
@@ -9,8 +9,8 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/blob/containers"
 )
@@ -21,7 +21,7 @@ func resourceArmStorageContainer() *schema.Resource {
 		Read:          resourceArmStorageContainerRead,
 		Delete:        resourceArmStorageContainerDelete,
 		Update:        resourceArmStorageContainerUpdate,
-		MigrateState:  resourceStorageContainerMigrateState,
+		MigrateState:  ResourceStorageContainerMigrateState,
 		SchemaVersion: 1,
 
 		Importer: &schema.ResourceImporter{
@@ -40,7 +40,7 @@ func resourceArmStorageContainer() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArmStorageAccountName,
+				ValidateFunc: ValidateArmStorageAccountName,
 			},
 
 			"container_access_type": {
@@ -54,7 +54,7 @@ func resourceArmStorageContainer() *schema.Resource {
 				}, false),
 			},
 
-			"metadata": storage.MetaDataComputedSchema(),
+			"metadata": MetaDataComputedSchema(),
 
 			// TODO: support for ACL's, Legal Holds and Immutability Policies
 			"has_immutability_policy": {
@@ -82,8 +82,9 @@ func resourceArmStorageContainer() *schema.Resource {
 }
 
 func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*ArmClient).Storage
-	ctx := meta.(*ArmClient).StopContext
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	containerName := d.Get("name").(string)
 	accountName := d.Get("storage_account_name").(string)
@@ -91,7 +92,7 @@ func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{})
 	accessLevel := expandStorageContainerAccessLevel(accessLevelRaw)
 
 	metaDataRaw := d.Get("metadata").(map[string]interface{})
-	metaData := storage.ExpandMetaData(metaDataRaw)
+	metaData := ExpandMetaData(metaDataRaw)
 
 	resourceGroup, err := storageClient.FindResourceGroup(ctx, accountName)
 	if err != nil {
@@ -107,7 +108,7 @@ func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	id := client.GetResourceID(accountName, containerName)
-	if features.ShouldResourcesBeImported() {
+	if meta.(*clients.Client).Features.Resources.RequireImport {
 		existing, err := client.GetProperties(ctx, accountName, containerName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -134,8 +135,9 @@ func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmStorageContainerUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
 
 	id, err := containers.ParseResourceID(d.Id())
 	if err != nil {
@@ -171,7 +173,7 @@ func resourceArmStorageContainerUpdate(d *schema.ResourceData, meta interface{})
 	if d.HasChange("metadata") {
 		log.Printf("[DEBUG] Updating the MetaData for Container %q (Storage Account %q / Resource Group %q)..", id.ContainerName, id.AccountName, *resourceGroup)
 		metaDataRaw := d.Get("metadata").(map[string]interface{})
-		metaData := storage.ExpandMetaData(metaDataRaw)
+		metaData := ExpandMetaData(metaDataRaw)
 
 		if _, err := client.SetMetaData(ctx, id.AccountName, id.ContainerName, metaData); err != nil {
 			return fmt.Errorf("Error updating the MetaData for Container %q (Storage Account %q / Resource Group %q): %s", id.ContainerName, id.AccountName, *resourceGroup, err)
@@ -183,8 +185,9 @@ func resourceArmStorageContainerUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
 
 	id, err := containers.ParseResourceID(d.Id())
 	if err != nil {
@@ -223,7 +226,7 @@ func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 
 	d.Set("container_access_type", flattenStorageContainerAccessLevel(props.AccessLevel))
 
-	if err := d.Set("metadata", storage.FlattenMetaData(props.MetaData)); err != nil {
+	if err := d.Set("metadata", FlattenMetaData(props.MetaData)); err != nil {
 		return fmt.Errorf("Error setting `metadata`: %+v", err)
 	}
 
@@ -238,8 +241,9 @@ func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmStorageContainerDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
 
 	id, err := containers.ParseResourceID(d.Id())
 	if err != nil {
@@ -1,13 +1,14 @@
-package azurerm
+package storage
 
 import (
 	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 )
 
-func resourceStorageContainerMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+func ResourceStorageContainerMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
 	switch v {
 	case 0:
 		log.Println("[INFO] Found AzureRM Storage Container State v0; migrating to v1")
@@ -25,7 +26,7 @@ func migrateStorageContainerStateV0toV1(is *terraform.InstanceState, meta interf
 
 	log.Printf("[DEBUG] ARM Storage Container Attributes before Migration: %#v", is.Attributes)
 
-	environment := meta.(*ArmClient).environment
+	environment := meta.(*clients.Client).Account.Environment
 
 	containerName := is.Attributes["name"]
 	storageAccountName := is.Attributes["storage_account_name"]
@@ -1,13 +1,14 @@
-package azurerm
+package storage
 
 import (
 	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 )
 
-func resourceStorageQueueMigrateState(
+func ResourceStorageQueueMigrateState(
 	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
 	switch v {
 	case 0:
@@ -26,7 +27,7 @@ func migrateStorageQueueStateV0toV1(is *terraform.InstanceState, meta interface{
 
 	log.Printf("[DEBUG] ARM Storage Queue Attributes before Migration: %#v", is.Attributes)
 
-	environment := meta.(*ArmClient).environment
+	environment := meta.(*clients.Client).Account.Environment
 
 	queueName := is.Attributes["name"]
 	storageAccountName := is.Attributes["storage_account_name"]
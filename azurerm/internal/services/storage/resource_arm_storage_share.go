@@ -1,4 +1,4 @@
-package azurerm
+package storage
 
 import (
 	"fmt"
@@ -10,8 +10,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/file/shares"
 )
@@ -63,7 +63,7 @@ func resourceArmStorageShare() *schema.Resource {
 				ValidateFunc: validation.IntBetween(1, 102400),
 			},
 
-			"metadata": storage.MetaDataSchema(),
+			"metadata": MetaDataSchema(),
 
 			"acl": {
 				Type:     schema.TypeSet,
@@ -110,15 +110,16 @@ func resourceArmStorageShare() *schema.Resource {
 	}
 }
 func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
 
 	accountName := d.Get("storage_account_name").(string)
 	shareName := d.Get("name").(string)
 	quota := d.Get("quota").(int)
 
 	metaDataRaw := d.Get("metadata").(map[string]interface{})
-	metaData := storage.ExpandMetaData(metaDataRaw)
+	metaData := ExpandMetaData(metaDataRaw)
 
 	aclsRaw := d.Get("acl").(*schema.Set).List()
 	acls := expandStorageShareACLs(aclsRaw)
@@ -138,7 +139,7 @@ func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) err
 
 	id := client.GetResourceID(accountName, shareName)
 
-	if features.ShouldResourcesBeImported() {
+	if meta.(*clients.Client).Features.Resources.RequireImport {
 		existing, err := client.GetProperties(ctx, accountName, shareName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -169,8 +170,9 @@ func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
 
 	id, err := shares.ParseResourceID(d.Id())
 	if err != nil {
@@ -213,7 +215,7 @@ func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("url", client.GetResourceID(id.AccountName, id.ShareName))
 	d.Set("quota", props.ShareQuota)
 
-	if err := d.Set("metadata", storage.FlattenMetaData(props.MetaData)); err != nil {
+	if err := d.Set("metadata", FlattenMetaData(props.MetaData)); err != nil {
 		return fmt.Errorf("Error flattening `metadata`: %+v", err)
 	}
 
@@ -228,8 +230,9 @@ func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmStorageShareUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
 
 	id, err := shares.ParseResourceID(d.Id())
 	if err != nil {
@@ -265,7 +268,7 @@ func resourceArmStorageShareUpdate(d *schema.ResourceData, meta interface{}) err
 		log.Printf("[DEBUG] Updating the MetaData for File Share %q (Storage Account %q)", id.ShareName, id.AccountName)
 
 		metaDataRaw := d.Get("metadata").(map[string]interface{})
-		metaData := storage.ExpandMetaData(metaDataRaw)
+		metaData := ExpandMetaData(metaDataRaw)
 
 		if _, err := client.SetMetaData(ctx, id.AccountName, id.ShareName, metaData); err != nil {
 			return fmt.Errorf("Error updating MetaData for File Share %q (Storage Account %q): %s", id.ShareName, id.AccountName, err)
@@ -291,8 +294,9 @@ func resourceArmStorageShareUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmStorageShareDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
 
 	id, err := shares.ParseResourceID(d.Id())
 	if err != nil {
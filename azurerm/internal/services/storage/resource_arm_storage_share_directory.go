@@ -1,4 +1,4 @@
-package azurerm
+package storage
 
 import (
 	"context"
@@ -11,8 +11,8 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/file/directories"
 )
@@ -47,21 +47,22 @@ func resourceArmStorageShareDirectory() *schema.Resource {
 				ValidateFunc: validate.NoEmptyStrings,
 			},
 
-			"metadata": storage.MetaDataSchema(),
+			"metadata": MetaDataSchema(),
 		},
 	}
 }
 
 func resourceArmStorageShareDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
 
 	accountName := d.Get("storage_account_name").(string)
 	shareName := d.Get("share_name").(string)
 	directoryName := d.Get("name").(string)
 
 	metaDataRaw := d.Get("metadata").(map[string]interface{})
-	metaData := storage.ExpandMetaData(metaDataRaw)
+	metaData := ExpandMetaData(metaDataRaw)
 
 	resourceGroup, err := storageClient.FindResourceGroup(ctx, accountName)
 	if err != nil {
@@ -76,7 +77,7 @@ func resourceArmStorageShareDirectoryCreate(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("Error building File Share Client: %s", err)
 	}
 
-	if features.ShouldResourcesBeImported() {
+	if meta.(*clients.Client).Features.Resources.RequireImport {
 		existing, err := client.Get(ctx, accountName, shareName, directoryName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -116,8 +117,9 @@ func resourceArmStorageShareDirectoryCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceArmStorageShareDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
 
 	id, err := directories.ParseResourceID(d.Id())
 	if err != nil {
@@ -125,7 +127,7 @@ func resourceArmStorageShareDirectoryUpdate(d *schema.ResourceData, meta interfa
 	}
 
 	metaDataRaw := d.Get("metadata").(map[string]interface{})
-	metaData := storage.ExpandMetaData(metaDataRaw)
+	metaData := ExpandMetaData(metaDataRaw)
 
 	resourceGroup, err := storageClient.FindResourceGroup(ctx, id.AccountName)
 	if err != nil {
@@ -150,8 +152,9 @@ func resourceArmStorageShareDirectoryUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceArmStorageShareDirectoryRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
 
 	id, err := directories.ParseResourceID(d.Id())
 	if err != nil {
@@ -182,7 +185,7 @@ func resourceArmStorageShareDirectoryRead(d *schema.ResourceData, meta interface
 	d.Set("share_name", id.ShareName)
 	d.Set("storage_account_name", id.AccountName)
 
-	if err := d.Set("metadata", storage.FlattenMetaData(props.MetaData)); err != nil {
+	if err := d.Set("metadata", FlattenMetaData(props.MetaData)); err != nil {
 		return fmt.Errorf("Error setting `metadata`: %s", err)
 	}
 
@@ -190,8 +193,9 @@ func resourceArmStorageShareDirectoryRead(d *schema.ResourceData, meta interface
 }
 
 func resourceArmStorageShareDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
 
 	id, err := directories.ParseResourceID(d.Id())
 	if err != nil {
@@ -1,4 +1,4 @@
-package azurerm
+package storage
 
 import (
 	"fmt"
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 )
 
 func TestAzureRMStorageShareMigrateStateV0ToV1(t *testing.T) {
@@ -26,8 +27,10 @@ func TestAzureRMStorageShareMigrateStateV0ToV1(t *testing.T) {
 			"storage_account_name": "account1",
 			"quota":                5120,
 		}
-		meta := &ArmClient{
-			environment: cloud,
+		meta := &clients.Client{
+			Account: &clients.ResourceManagerAccount{
+				Environment: cloud,
+			},
 		}
 		expected := map[string]interface{}{
 			"id":                   "share1/group1/account1",
@@ -68,8 +71,10 @@ func TestAzureRMStorageShareMigrateStateV1ToV2(t *testing.T) {
 			"storage_account_name": "account1",
 			"quota":                5120,
 		}
-		meta := &ArmClient{
-			environment: cloud,
+		meta := &clients.Client{
+			Account: &clients.ResourceManagerAccount{
+				Environment: cloud,
+			},
 		}
 		expected := map[string]interface{}{
 			"id":                   fmt.Sprintf("https://account1.file.%s/share1", cloud.StorageEndpointSuffix),
@@ -1,4 +1,4 @@
-package azurerm
+package storage
 
 import (
 	"fmt"
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 )
 
 func TestAzureRMStorageTableMigrateStateV0ToV1(t *testing.T) {
@@ -24,10 +25,12 @@ func TestAzureRMStorageTableMigrateStateV0ToV1(t *testing.T) {
 			"name":                 "table1",
 			"storage_account_name": "account1",
 		}
-		meta := &ArmClient{
-			environment: cloud,
+		meta := &clients.Client{
+			Account: &clients.ResourceManagerAccount{
+				Environment: cloud,
+			},
 		}
-		suffix := meta.environment.StorageEndpointSuffix
+		suffix := meta.Account.Environment.StorageEndpointSuffix
 
 		expected := map[string]interface{}{
 			"id":                   fmt.Sprintf("https://account1.table.%s/table1", suffix),
@@ -59,10 +62,12 @@ func TestAzureRMStorageTableMigrateStateV1ToV2(t *testing.T) {
 	for _, cloud := range clouds {
 		t.Logf("[DEBUG] Testing with Cloud %q", cloud.Name)
 
-		meta := &ArmClient{
-			environment: cloud,
+		meta := &clients.Client{
+			Account: &clients.ResourceManagerAccount{
+				Environment: cloud,
+			},
 		}
-		suffix := meta.environment.StorageEndpointSuffix
+		suffix := meta.Account.Environment.StorageEndpointSuffix
 
 		input := map[string]interface{}{
 			"id":                   fmt.Sprintf("https://account1.table.%s/table1", suffix),
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -8,20 +8,21 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 )
 
 func TestAccDataSourceArmStorageAccountBlobContainerSas_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_storage_account_blob_container_sas.test"
 	rInt := tf.AccRandTimeInt()
 	rString := acctest.RandString(4)
-	location := testLocation()
+	location := acceptance.Location()
 	utcNow := time.Now().UTC()
 	startDate := utcNow.Format(time.RFC3339)
 	endDate := utcNow.Add(time.Hour * 24).Format(time.RFC3339)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceAzureRMStorageAccountBlobContainerSas_basic(rInt, rString, location, startDate, endDate),
@@ -99,25 +100,3 @@ data "azurerm_storage_account_blob_container_sas" "test" {
 }
 `, rInt, location, rString, startDate, endDate)
 }
-
-func TestAccDataSourceArmStorageAccountBlobContainerSas_permissionsString(t *testing.T) {
-	testCases := []struct {
-		input    map[string]interface{}
-		expected string
-	}{
-		{map[string]interface{}{"read": true}, "r"},
-		{map[string]interface{}{"add": true}, "a"},
-		{map[string]interface{}{"create": true}, "c"},
-		{map[string]interface{}{"write": true}, "w"},
-		{map[string]interface{}{"delete": true}, "d"},
-		{map[string]interface{}{"list": true}, "l"},
-		{map[string]interface{}{"add": true, "write": true, "read": true, "delete": true}, "rawd"},
-	}
-
-	for _, test := range testCases {
-		result := buildContainerPermissionsString(test.input)
-		if test.expected != result {
-			t.Fatalf("Failed to build resource type string: expected: %s, result: %s", test.expected, result)
-		}
-	}
-}
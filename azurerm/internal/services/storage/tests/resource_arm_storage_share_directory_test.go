@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -10,18 +10,20 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
 func TestAccAzureRMStorageShareDirectory_basic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(5))
-	location := testLocation()
+	location := acceptance.Location()
 	resourceName := "azurerm_storage_share_directory.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMStorageShareDirectoryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -42,12 +44,12 @@ func TestAccAzureRMStorageShareDirectory_basic(t *testing.T) {
 func TestAccAzureRMStorageShareDirectory_uppercase(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(5))
-	location := testLocation()
+	location := acceptance.Location()
 	resourceName := "azurerm_storage_share_directory.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMStorageShareDirectoryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -73,12 +75,12 @@ func TestAccAzureRMStorageShareDirectory_requiresImport(t *testing.T) {
 
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(5))
-	location := testLocation()
+	location := acceptance.Location()
 	resourceName := "azurerm_storage_share_directory.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMStorageShareDirectoryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -89,7 +91,7 @@ func TestAccAzureRMStorageShareDirectory_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMStorageShareDirectory_requiresImport(ri, rs, location),
-				ExpectError: testRequiresImportError("azurerm_storage_share_directory"),
+				ExpectError: acceptance.RequiresImportError("azurerm_storage_share_directory"),
 			},
 		},
 	})
@@ -98,12 +100,12 @@ func TestAccAzureRMStorageShareDirectory_requiresImport(t *testing.T) {
 func TestAccAzureRMStorageShareDirectory_complete(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(5))
-	location := testLocation()
+	location := acceptance.Location()
 	resourceName := "azurerm_storage_share_directory.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMStorageShareDirectoryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -124,12 +126,12 @@ func TestAccAzureRMStorageShareDirectory_complete(t *testing.T) {
 func TestAccAzureRMStorageShareDirectory_update(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(5))
-	location := testLocation()
+	location := acceptance.Location()
 	resourceName := "azurerm_storage_share_directory.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMStorageShareDirectoryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -160,11 +162,11 @@ func TestAccAzureRMStorageShareDirectory_update(t *testing.T) {
 func TestAccAzureRMStorageShareDirectory_nested(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(5))
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMStorageShareDirectoryDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -190,8 +192,8 @@ func testCheckAzureRMStorageShareDirectoryExists(resourceName string) resource.T
 		shareName := rs.Primary.Attributes["share_name"]
 		accountName := rs.Primary.Attributes["storage_account_name"]
 
-		storageClient := testAccProvider.Meta().(*ArmClient).Storage
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		storageClient := acceptance.AzureProvider.Meta().(*clients.Client).Storage
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resourceGroup, err := storageClient.FindResourceGroup(ctx, accountName)
 		if err != nil {
@@ -229,8 +231,8 @@ func testCheckAzureRMStorageShareDirectoryDestroy(s *terraform.State) error {
 		shareName := rs.Primary.Attributes["share_name"]
 		accountName := rs.Primary.Attributes["storage_account_name"]
 
-		storageClient := testAccProvider.Meta().(*ArmClient).Storage
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		storageClient := acceptance.AzureProvider.Meta().(*clients.Client).Storage
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resourceGroup, err := storageClient.FindResourceGroup(ctx, accountName)
 		if err != nil {
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -9,18 +9,20 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
 func TestAccAzureRMStorageShare_basic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(11))
-	location := testLocation()
+	location := acceptance.Location()
 	resourceName := "azurerm_storage_share.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMStorageShareDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -46,12 +48,12 @@ func TestAccAzureRMStorageShare_requiresImport(t *testing.T) {
 
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(11))
-	location := testLocation()
+	location := acceptance.Location()
 	resourceName := "azurerm_storage_share.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMStorageShareDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -62,7 +64,7 @@ func TestAccAzureRMStorageShare_requiresImport(t *testing.T) {
 			},
 			{
 				Config:      testAccAzureRMStorageShare_requiresImport(ri, rs, location),
-				ExpectError: testRequiresImportError("azurerm_storage_share"),
+				ExpectError: acceptance.RequiresImportError("azurerm_storage_share"),
 			},
 		},
 	})
@@ -71,12 +73,12 @@ func TestAccAzureRMStorageShare_requiresImport(t *testing.T) {
 func TestAccAzureRMStorageShare_disappears(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(11))
-	location := testLocation()
+	location := acceptance.Location()
 	resourceName := "azurerm_storage_share.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMStorageShareDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -94,12 +96,12 @@ func TestAccAzureRMStorageShare_disappears(t *testing.T) {
 func TestAccAzureRMStorageShare_metaData(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(11))
-	location := testLocation()
+	location := acceptance.Location()
 	resourceName := "azurerm_storage_share.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMStorageShareDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -131,12 +133,12 @@ func TestAccAzureRMStorageShare_metaData(t *testing.T) {
 func TestAccAzureRMStorageShare_acl(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(11))
-	location := testLocation()
+	location := acceptance.Location()
 	resourceName := "azurerm_storage_share.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMStorageShareDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -168,12 +170,12 @@ func TestAccAzureRMStorageShare_acl(t *testing.T) {
 func TestAccAzureRMStorageShare_updateQuota(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(11))
-	location := testLocation()
+	location := acceptance.Location()
 	resourceName := "azurerm_storage_share.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMStorageShareDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -203,8 +205,8 @@ func testCheckAzureRMStorageShareExists(resourceName string) resource.TestCheckF
 		shareName := rs.Primary.Attributes["name"]
 		accountName := rs.Primary.Attributes["storage_account_name"]
 
-		storageClient := testAccProvider.Meta().(*ArmClient).Storage
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		storageClient := acceptance.AzureProvider.Meta().(*clients.Client).Storage
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resourceGroup, err := storageClient.FindResourceGroup(ctx, accountName)
 		if err != nil {
@@ -237,8 +239,8 @@ func testCheckAzureRMStorageShareDisappears(resourceName string) resource.TestCh
 		shareName := rs.Primary.Attributes["name"]
 		accountName := rs.Primary.Attributes["storage_account_name"]
 
-		storageClient := testAccProvider.Meta().(*ArmClient).Storage
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		storageClient := acceptance.AzureProvider.Meta().(*clients.Client).Storage
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resourceGroup, err := storageClient.FindResourceGroup(ctx, accountName)
 		if err != nil {
@@ -270,8 +272,8 @@ func testCheckAzureRMStorageShareDestroy(s *terraform.State) error {
 		shareName := rs.Primary.Attributes["name"]
 		accountName := rs.Primary.Attributes["storage_account_name"]
 
-		storageClient := testAccProvider.Meta().(*ArmClient).Storage
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		storageClient := acceptance.AzureProvider.Meta().(*clients.Client).Storage
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 
 		resourceGroup, err := storageClient.FindResourceGroup(ctx, accountName)
 		if err != nil {
@@ -399,6 +401,7 @@ resource "azurerm_storage_share" "test" {
 }
 `, template, rString)
 }
+
 func testAccAzureRMStorageShare_requiresImport(rInt int, rString string, location string) string {
 	template := testAccAzureRMStorageShare_basic(rInt, rString, location)
 	return fmt.Sprintf(`
@@ -446,32 +449,3 @@ resource "azurerm_storage_account" "test" {
 }
 `, rInt, location, rString)
 }
-
-func TestValidateArmStorageShareName(t *testing.T) {
-	validNames := []string{
-		"valid-name",
-		"valid02-name",
-	}
-	for _, v := range validNames {
-		_, errors := validateArmStorageShareName(v, "name")
-		if len(errors) != 0 {
-			t.Fatalf("%q should be a valid Share Name: %q", v, errors)
-		}
-	}
-
-	invalidNames := []string{
-		"InvalidName1",
-		"-invalidname1",
-		"invalid_name",
-		"invalid!",
-		"double-hyphen--invalid",
-		"ww",
-		strings.Repeat("w", 65),
-	}
-	for _, v := range invalidNames {
-		_, errors := validateArmStorageShareName(v, "name")
-		if len(errors) == 0 {
-			t.Fatalf("%q should be an invalid Share Name", v)
-		}
-	}
-}
@@ -1,4 +1,4 @@
-package streamanalytics
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/streamanalytics/mgmt/2016-03-01/streamanalytics"
@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -8,17 +8,19 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
 func TestAccAzureRMStreamAnalyticsOutputServiceBusQueue_avro(t *testing.T) {
 	resourceName := "azurerm_stream_analytics_output_servicebus_queue.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputServiceBusQueueDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -43,11 +45,11 @@ func TestAccAzureRMStreamAnalyticsOutputServiceBusQueue_avro(t *testing.T) {
 func TestAccAzureRMStreamAnalyticsOutputServiceBusQueue_csv(t *testing.T) {
 	resourceName := "azurerm_stream_analytics_output_servicebus_queue.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputServiceBusQueueDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -72,11 +74,11 @@ func TestAccAzureRMStreamAnalyticsOutputServiceBusQueue_csv(t *testing.T) {
 func TestAccAzureRMStreamAnalyticsOutputServiceBusQueue_json(t *testing.T) {
 	resourceName := "azurerm_stream_analytics_output_servicebus_queue.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputServiceBusQueueDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -101,11 +103,11 @@ func TestAccAzureRMStreamAnalyticsOutputServiceBusQueue_json(t *testing.T) {
 func TestAccAzureRMStreamAnalyticsOutputServiceBusQueue_update(t *testing.T) {
 	resourceName := "azurerm_stream_analytics_output_servicebus_queue.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputServiceBusQueueDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -141,11 +143,11 @@ func TestAccAzureRMStreamAnalyticsOutputServiceBusQueue_requiresImport(t *testin
 
 	resourceName := "azurerm_stream_analytics_output_servicebus_queue.test"
 	ri := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
 		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputServiceBusQueueDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -156,7 +158,7 @@ func TestAccAzureRMStreamAnalyticsOutputServiceBusQueue_requiresImport(t *testin
 			},
 			{
 				Config:      testAccAzureRMStreamAnalyticsOutputServiceBusQueue_requiresImport(ri, location),
-				ExpectError: testRequiresImportError("azurerm_stream_analytics_output_servicebus_queue"),
+				ExpectError: acceptance.RequiresImportError("azurerm_stream_analytics_output_servicebus_queue"),
 			},
 		},
 	})
@@ -174,8 +176,8 @@ func testCheckAzureRMStreamAnalyticsOutputServiceBusQueueExists(resourceName str
 		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		conn := testAccProvider.Meta().(*ArmClient).StreamAnalytics.OutputsClient
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		conn := acceptance.AzureProvider.Meta().(*clients.Client).StreamAnalytics.OutputsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 		resp, err := conn.Get(ctx, resourceGroup, jobName, name)
 		if err != nil {
 			return fmt.Errorf("Bad: Get on streamAnalyticsOutputsClient: %+v", err)
@@ -190,7 +192,7 @@ func testCheckAzureRMStreamAnalyticsOutputServiceBusQueueExists(resourceName str
 }
 
 func testCheckAzureRMStreamAnalyticsOutputServiceBusQueueDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).StreamAnalytics.OutputsClient
+	conn := acceptance.AzureProvider.Meta().(*clients.Client).StreamAnalytics.OutputsClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_stream_analytics_output_servicebus_queue" {
@@ -200,7 +202,7 @@ func testCheckAzureRMStreamAnalyticsOutputServiceBusQueueDestroy(s *terraform.St
 		name := rs.Primary.Attributes["name"]
 		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
-		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
 		resp, err := conn.Get(ctx, resourceGroup, jobName, name)
 		if err != nil {
 			return nil
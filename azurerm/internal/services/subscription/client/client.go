@@ -1,4 +1,4 @@
-package subscription
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-06-01/subscriptions"
@@ -1,10 +1,12 @@
-package azurerm
+package subscription
 
 import (
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -16,13 +18,14 @@ func dataSourceArmSubscription() *schema.Resource {
 }
 
 func dataSourceArmSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
+	client := meta.(*clients.Client)
 	groupClient := client.Subscription.Client
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
 
 	subscriptionId := d.Get("subscription_id").(string)
 	if subscriptionId == "" {
-		subscriptionId = client.subscriptionId
+		subscriptionId = client.Account.SubscriptionId
 	}
 
 	resp, err := groupClient.Get(ctx, subscriptionId)
@@ -1,4 +1,4 @@
-package azurerm
+package subscription
 
 import (
 	"fmt"
@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
 func dataSourceArmSubscriptions() *schema.Resource {
@@ -33,9 +35,10 @@ func dataSourceArmSubscriptions() *schema.Resource {
 }
 
 func dataSourceArmSubscriptionsRead(d *schema.ResourceData, meta interface{}) error {
-	armClient := meta.(*ArmClient)
+	armClient := meta.(*clients.Client)
 	subClient := armClient.Subscription.Client
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	displayNamePrefix := strings.ToLower(d.Get("display_name_prefix").(string))
 	displayNameContains := strings.ToLower(d.Get("display_name_contains").(string))
@@ -97,7 +100,7 @@ func dataSourceArmSubscriptionsRead(d *schema.ResourceData, meta interface{}) er
 		subscriptions = append(subscriptions, s)
 	}
 
-	d.SetId("subscriptions-" + armClient.tenantId)
+	d.SetId("subscriptions-" + armClient.Account.TenantId)
 	if err = d.Set("subscriptions", subscriptions); err != nil {
 		return fmt.Errorf("Error setting `subscriptions`: %+v", err)
 	}
@@ -1,4 +1,4 @@
-package trafficmanager
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-04-01/trafficmanager"
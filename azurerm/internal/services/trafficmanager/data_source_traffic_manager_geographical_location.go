@@ -1,4 +1,4 @@
-package azurerm
+package trafficmanager
 
 import (
 	"fmt"
@@ -6,6 +6,8 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-04-01/trafficmanager"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
 func dataSourceArmTrafficManagerGeographicalLocation() *schema.Resource {
@@ -21,8 +23,9 @@ func dataSourceArmTrafficManagerGeographicalLocation() *schema.Resource {
 }
 
 func dataSourceArmTrafficManagerGeographicalLocationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).TrafficManager.GeographialHierarchiesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).TrafficManager.GeographialHierarchiesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	results, err := client.GetDefault(ctx)
 	if err != nil {
@@ -1,4 +1,4 @@
-package web
+package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
@@ -1,4 +1,4 @@
-package azurerm
+package web
 
 import (
 	"fmt"
@@ -8,7 +8,9 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -54,8 +56,9 @@ func resourceArmAppServiceSourceControlToken() *schema.Resource {
 }
 
 func resourceArmAppServiceSourceControlTokenCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).web.BaseClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Web.BaseClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for App Service Source Control Token creation.")
 
@@ -91,8 +94,9 @@ func resourceArmAppServiceSourceControlTokenCreateUpdate(d *schema.ResourceData,
 }
 
 func resourceArmAppServiceSourceControlTokenRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).web.BaseClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Web.BaseClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	scmType := d.Id()
 
@@ -117,8 +121,9 @@ func resourceArmAppServiceSourceControlTokenRead(d *schema.ResourceData, meta in
 }
 
 func resourceArmAppServiceSourceControlTokenDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).web.BaseClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Web.BaseClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	scmType := d.Id()
 
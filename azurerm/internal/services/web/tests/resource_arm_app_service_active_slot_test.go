@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -6,16 +6,17 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 )
 
 func TestAccAzureRMAppServiceActiveSlot_basic(t *testing.T) {
 	resourceName := "azurerm_app_service_active_slot.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMAppServiceActiveSlot_basic(ri, testLocation())
+	config := testAccAzureRMAppServiceActiveSlot_basic(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		// Destroy actually does nothing so we just return nil
 		CheckDestroy: nil,
 		Steps: []resource.TestStep{
@@ -32,12 +33,12 @@ func TestAccAzureRMAppServiceActiveSlot_basic(t *testing.T) {
 func TestAccAzureRMAppServiceActiveSlot_update(t *testing.T) {
 	resourceName := "azurerm_app_service_active_slot.test"
 	ri := tf.AccRandTimeInt()
-	config := testAccAzureRMAppServiceActiveSlot_update(ri, testLocation())
-	config2 := testAccAzureRMAppServiceActiveSlot_updated(ri, testLocation())
+	config := testAccAzureRMAppServiceActiveSlot_update(ri, acceptance.Location())
+	config2 := testAccAzureRMAppServiceActiveSlot_updated(ri, acceptance.Location())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
 		// Destroy actually does nothing so we just return nil
 		CheckDestroy: nil,
 		Steps: []resource.TestStep{
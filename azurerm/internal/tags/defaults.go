@@ -0,0 +1,248 @@
+package tags
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// DefaultsFunc returns the Default Tags configured in the Provider block from the Provider's meta
+type DefaultsFunc func(meta interface{}) map[string]interface{}
+
+// DefaultsSchema returns the Schema used for the `default_tags` block within the Provider configuration
+func DefaultsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:         schema.TypeMap,
+					Optional:     true,
+					ValidateFunc: Validate,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ExpandDefaults parses the `default_tags` block from the Provider configuration
+func ExpandDefaults(input []interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	val := input[0].(map[string]interface{})
+	if raw, ok := val["tags"].(map[string]interface{}); ok {
+		for k, v := range raw {
+			output[k] = v
+		}
+	}
+
+	return output
+}
+
+// AllSchema returns the Schema used for the `tags_all` field, which contains the Tags
+// assigned to the resource - including those inherited from the Provider's Default Tags
+func AllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// Merge returns the Default Tags combined with the Tags defined on the resource,
+// where the Tags defined on the resource take precedence
+func Merge(defaults map[string]interface{}, resourceTags map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(defaults)+len(resourceTags))
+
+	for k, v := range defaults {
+		output[k] = v
+	}
+	for k, v := range resourceTags {
+		output[k] = v
+	}
+
+	return output
+}
+
+// RemoveDefaults returns the Tags which should be stored in the `tags` field - which are those
+// assigned to the resource other than those inherited from the Default Tags, unless these are
+// also defined on the resource (as specified in `resourceTags`)
+func RemoveDefaults(allTags map[string]interface{}, defaults map[string]interface{}, resourceTags map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(allTags))
+
+	for k, v := range allTags {
+		if _, configured := resourceTags[k]; !configured {
+			if defaultValue, isDefault := defaults[k]; isDefault && tagValuesEqual(defaultValue, v) {
+				continue
+			}
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+func tagValuesEqual(first interface{}, second interface{}) bool {
+	firstValue, err := TagValueToString(first)
+	if err != nil {
+		return false
+	}
+
+	secondValue, err := TagValueToString(second)
+	if err != nil {
+		return false
+	}
+
+	return firstValue == secondValue
+}
+
+// SupportsDefaults returns whether the Default Tags can be merged into the specified Resource,
+// which is the case when it exposes a top-level (and user-configurable) `tags` field
+func SupportsDefaults(resource *schema.Resource) bool {
+	if resource == nil || resource.Schema == nil {
+		return false
+	}
+
+	if _, exists := resource.Schema["tags_all"]; exists {
+		return false
+	}
+
+	v, ok := resource.Schema["tags"]
+	return ok && v.Type == schema.TypeMap && v.Optional
+}
+
+// WithDefaults updates the specified Resource so that the Default Tags configured in the Provider
+// are merged into the Tags sent to Azure when the resource is created or updated.
+//
+// Since the Default Tags shouldn't cause a diff on the `tags` field, they're removed from the `tags`
+// field when the resource is read - and are instead exposed in the computed `tags_all` field.
+func WithDefaults(resource *schema.Resource, defaultsFunc DefaultsFunc) {
+	if !SupportsDefaults(resource) {
+		return
+	}
+
+	resource.Schema["tags_all"] = AllSchema()
+
+	if create := resource.Create; create != nil {
+		resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+			return applyDefaults(d, meta, defaultsFunc, create)
+		}
+	}
+
+	if update := resource.Update; update != nil {
+		resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+			return applyDefaults(d, meta, defaultsFunc, update)
+		}
+	}
+
+	if read := resource.Read; read != nil {
+		resource.Read = func(d *schema.ResourceData, meta interface{}) error {
+			// the `tags` field in the State only contains the Tags defined on the resource
+			resourceTags := d.Get("tags").(map[string]interface{})
+
+			if err := read(d, meta); err != nil {
+				return err
+			}
+
+			// the resource has been removed, so there's nothing to set
+			if d.Id() == "" {
+				return nil
+			}
+
+			return setTagsAndTagsAll(d, defaultsFunc(meta), resourceTags)
+		}
+	}
+
+	forceNew := resource.Update == nil
+	customizeDiff := resource.CustomizeDiff
+	resource.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(d, meta); err != nil {
+				return err
+			}
+		}
+
+		if !d.NewValueKnown("tags") {
+			return d.SetNewComputed("tags_all")
+		}
+
+		allTags := Merge(defaultsFunc(meta), d.Get("tags").(map[string]interface{}))
+		existing := d.Get("tags_all").(map[string]interface{})
+		if d.Id() != "" && tagMapsEqual(existing, allTags) {
+			return nil
+		}
+
+		if err := d.SetNew("tags_all", allTags); err != nil {
+			return err
+		}
+
+		// resources which can't be updated need to be recreated when the Default Tags change
+		if forceNew && d.Id() != "" && d.HasChange("tags_all") {
+			return d.ForceNew("tags_all")
+		}
+
+		return nil
+	}
+}
+
+// applyDefaults merges the Default Tags into the `tags` field prior to calling the specified
+// Create/Update function, so that these are sent to Azure when using the `tags` helpers
+func applyDefaults(d *schema.ResourceData, meta interface{}, defaultsFunc DefaultsFunc, f func(*schema.ResourceData, interface{}) error) error {
+	defaults := defaultsFunc(meta)
+	resourceTags := d.Get("tags").(map[string]interface{})
+
+	if err := d.Set("tags", Merge(defaults, resourceTags)); err != nil {
+		return err
+	}
+
+	err := f(d, meta)
+
+	// the State is persisted even when the Create/Update fails, so the Default Tags need
+	// to be removed from the `tags` field regardless to avoid a diff
+	if setErr := setTagsAndTagsAll(d, defaults, resourceTags); setErr != nil && err == nil {
+		err = setErr
+	}
+
+	return err
+}
+
+func setTagsAndTagsAll(d *schema.ResourceData, defaults map[string]interface{}, resourceTags map[string]interface{}) error {
+	allTags := d.Get("tags").(map[string]interface{})
+
+	if err := d.Set("tags", RemoveDefaults(allTags, defaults, resourceTags)); err != nil {
+		return fmt.Errorf("Error setting `tags`: %+v", err)
+	}
+
+	if err := d.Set("tags_all", allTags); err != nil {
+		return fmt.Errorf("Error setting `tags_all`: %+v", err)
+	}
+
+	return nil
+}
+
+func tagMapsEqual(first map[string]interface{}, second map[string]interface{}) bool {
+	if len(first) != len(second) {
+		return false
+	}
+
+	for k, v := range first {
+		other, ok := second[k]
+		if !ok || !tagValuesEqual(v, other) {
+			return false
+		}
+	}
+
+	return true
+}
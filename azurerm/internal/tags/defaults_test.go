@@ -0,0 +1,225 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMerge(t *testing.T) {
+	defaults := map[string]interface{}{
+		"cost-centre": "1234",
+		"owner":       "platform",
+	}
+	resourceTags := map[string]interface{}{
+		"owner":       "networking",
+		"environment": "production",
+	}
+
+	expected := map[string]interface{}{
+		"cost-centre": "1234",
+		"owner":       "networking",
+		"environment": "production",
+	}
+	if actual := Merge(defaults, resourceTags); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestRemoveDefaults(t *testing.T) {
+	defaults := map[string]interface{}{
+		"cost-centre": "1234",
+		"owner":       "platform",
+		"team":        "compute",
+	}
+
+	testData := []struct {
+		name         string
+		allTags      map[string]interface{}
+		resourceTags map[string]interface{}
+		expected     map[string]interface{}
+	}{
+		{
+			name:         "only defaults",
+			allTags:      map[string]interface{}{"cost-centre": "1234", "owner": "platform"},
+			resourceTags: map[string]interface{}{},
+			expected:     map[string]interface{}{},
+		},
+		{
+			name:         "default overridden on the resource",
+			allTags:      map[string]interface{}{"cost-centre": "1234", "owner": "networking"},
+			resourceTags: map[string]interface{}{"owner": "networking"},
+			expected:     map[string]interface{}{"owner": "networking"},
+		},
+		{
+			name:         "default also defined on the resource",
+			allTags:      map[string]interface{}{"cost-centre": "1234", "owner": "platform"},
+			resourceTags: map[string]interface{}{"owner": "platform"},
+			expected:     map[string]interface{}{"owner": "platform"},
+		},
+		{
+			name:         "default changed outside of terraform",
+			allTags:      map[string]interface{}{"cost-centre": "5678"},
+			resourceTags: map[string]interface{}{},
+			expected:     map[string]interface{}{"cost-centre": "5678"},
+		},
+		{
+			name:         "additional tags",
+			allTags:      map[string]interface{}{"cost-centre": "1234", "environment": "production"},
+			resourceTags: map[string]interface{}{},
+			expected:     map[string]interface{}{"environment": "production"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := RemoveDefaults(v.allTags, defaults, v.resourceTags)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("Expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestExpandDefaults(t *testing.T) {
+	if actual := ExpandDefaults([]interface{}{}); len(actual) != 0 {
+		t.Fatalf("Expected no Default Tags but got %+v", actual)
+	}
+
+	input := []interface{}{
+		map[string]interface{}{
+			"tags": map[string]interface{}{
+				"cost-centre": "1234",
+			},
+		},
+	}
+	expected := map[string]interface{}{
+		"cost-centre": "1234",
+	}
+	if actual := ExpandDefaults(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestWithDefaults(t *testing.T) {
+	defaults := map[string]interface{}{
+		"cost-centre": "1234",
+		"owner":       "platform",
+	}
+
+	// remote represents the Tags assigned to the resource in Azure
+	var remote map[string]*string
+	resource := &schema.Resource{
+		Create: func(d *schema.ResourceData, _ interface{}) error {
+			remote = Expand(d.Get("tags").(map[string]interface{}))
+			d.SetId("example")
+			return FlattenAndSet(d, remote)
+		},
+		Read: func(d *schema.ResourceData, _ interface{}) error {
+			return FlattenAndSet(d, remote)
+		},
+		Update: func(d *schema.ResourceData, _ interface{}) error {
+			remote = Expand(d.Get("tags").(map[string]interface{}))
+			return FlattenAndSet(d, remote)
+		},
+		Delete: func(d *schema.ResourceData, _ interface{}) error {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"tags": Schema(),
+		},
+	}
+	WithDefaults(resource, func(_ interface{}) map[string]interface{} {
+		return defaults
+	})
+
+	if _, ok := resource.Schema["tags_all"]; !ok {
+		t.Fatalf("Expected the `tags_all` field to be added to the Resource")
+	}
+	if err := resource.InternalValidate(nil, true); err != nil {
+		t.Fatalf("Expected the Resource to be valid but got: %+v", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"owner":       "networking",
+			"environment": "production",
+		},
+	})
+	if err := resource.Create(d, nil); err != nil {
+		t.Fatalf("Error creating: %+v", err)
+	}
+
+	expectedRemote := map[string]interface{}{
+		"cost-centre": "1234",
+		"owner":       "networking",
+		"environment": "production",
+	}
+	if actual := Flatten(remote); !reflect.DeepEqual(actual, expectedRemote) {
+		t.Fatalf("Expected the Tags sent to Azure to be %+v but got %+v", expectedRemote, actual)
+	}
+
+	expectedTags := map[string]interface{}{
+		"owner":       "networking",
+		"environment": "production",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("Expected `tags` to be %+v but got %+v", expectedTags, actual)
+	}
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expectedRemote) {
+		t.Fatalf("Expected `tags_all` to be %+v but got %+v", expectedRemote, actual)
+	}
+
+	// refreshing the resource shouldn't add the Default Tags into the `tags` field
+	d = resource.Data(d.State())
+	if err := resource.Read(d, nil); err != nil {
+		t.Fatalf("Error reading: %+v", err)
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("Expected `tags` to be %+v after a refresh but got %+v", expectedTags, actual)
+	}
+}
+
+func TestSupportsDefaults(t *testing.T) {
+	testData := []struct {
+		name     string
+		schema   map[string]*schema.Schema
+		expected bool
+	}{
+		{
+			name:     "no tags",
+			schema:   map[string]*schema.Schema{},
+			expected: false,
+		},
+		{
+			name: "tags",
+			schema: map[string]*schema.Schema{
+				"tags": Schema(),
+			},
+			expected: true,
+		},
+		{
+			name: "force new tags",
+			schema: map[string]*schema.Schema{
+				"tags": ForceNewSchema(),
+			},
+			expected: true,
+		},
+		{
+			name: "computed tags",
+			schema: map[string]*schema.Schema{
+				"tags": SchemaDataSource(),
+			},
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		if actual := SupportsDefaults(&schema.Resource{Schema: v.schema}); actual != v.expected {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
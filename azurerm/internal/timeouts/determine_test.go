@@ -0,0 +1,83 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDetermineTimeout(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(40 * time.Minute),
+		},
+	}
+
+	testData := []struct {
+		name     string
+		new      bool
+		function func(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc)
+		expected time.Duration
+	}{
+		{
+			name:     "create",
+			function: ForCreate,
+			expected: 10 * time.Minute,
+		},
+		{
+			name:     "create/update for a new resource",
+			new:      true,
+			function: ForCreateUpdate,
+			expected: 10 * time.Minute,
+		},
+		{
+			name:     "create/update for an existing resource",
+			new:      false,
+			function: ForCreateUpdate,
+			expected: 30 * time.Minute,
+		},
+		{
+			name:     "read",
+			function: ForRead,
+			expected: 20 * time.Minute,
+		},
+		{
+			name:     "update",
+			function: ForUpdate,
+			expected: 30 * time.Minute,
+		},
+		{
+			name:     "delete",
+			function: ForDelete,
+			expected: 40 * time.Minute,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q..", v.name)
+
+		d := r.Data(nil)
+		if v.new {
+			d.MarkNewResource()
+		}
+
+		start := time.Now()
+		ctx, cancel := v.function(context.Background(), d)
+		deadline, ok := ctx.Deadline()
+		cancel()
+		if !ok {
+			t.Fatalf("Expected a deadline to be set but it wasn't")
+		}
+
+		actual := deadline.Sub(start)
+		if actual < v.expected || actual > v.expected+time.Minute {
+			t.Fatalf("Expected a timeout of %s but got %s", v.expected, actual)
+		}
+	}
+}
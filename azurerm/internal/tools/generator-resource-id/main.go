@@ -0,0 +1,470 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// generator-resource-id generates a typed Resource ID struct, alongside a parser, a formatter
+// and a validation function for a given type of Resource ID - using an example ID to determine
+// the shape of the ID, for example:
+//
+//   go run generator-resource-id/main.go -path=./parse -name=VirtualMachine -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/machine1
+//
+// This is intended to be used via `go:generate` within each Service Package.
+
+func main() {
+	path := flag.String("path", "", "The relative path to the directory where the files should be output")
+	name := flag.String("name", "", "The name of this Resource Type, for example `VirtualMachine`")
+	id := flag.String("id", "", "An example Resource ID for this Resource Type")
+	flag.Parse()
+
+	if *path == "" || *name == "" || *id == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	if err := run(*path, *name, *id); err != nil {
+		fmt.Fprintf(os.Stderr, "Error generating Resource ID %q: %+v\n", *name, err)
+		os.Exit(1)
+	}
+}
+
+func run(path, name, id string) error {
+	resourceId, err := newResourceID(name, id)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return fmt.Errorf("creating directory %q: %+v", path, err)
+	}
+
+	fileName := convertToSnakeCase(name)
+	if err := writeFormattedFile(filepath.Join(path, fileName+".go"), resourceId.code()); err != nil {
+		return err
+	}
+
+	return writeFormattedFile(filepath.Join(path, fileName+"_test.go"), resourceId.testCode())
+}
+
+type segment struct {
+	// FieldName is the name of the field within the Resource ID struct, e.g. `VirtualNetworkName`
+	FieldName string
+
+	// SegmentKey is the key for this segment within the Resource ID, e.g. `virtualNetworks`
+	SegmentKey string
+
+	// SegmentValue is the example value for this segment, e.g. `network1`
+	SegmentValue string
+}
+
+type resourceID struct {
+	TypeName    string
+	DisplayName string
+
+	ExampleSubscriptionId string
+	ExampleResourceGroup  string
+	Provider              string
+
+	// IsResourceGroup is set when this ID is for a Resource Group itself, in which
+	// case the Resource Group is exposed as the `Name` field
+	IsResourceGroup bool
+
+	Segments []segment
+}
+
+func newResourceID(name, id string) (*resourceID, error) {
+	components := strings.Split(strings.Trim(id, "/"), "/")
+	if len(components)%2 != 0 {
+		return nil, fmt.Errorf("the number of segments in %q is not divisible by 2", id)
+	}
+
+	out := resourceID{
+		TypeName:    name,
+		DisplayName: convertToDisplayName(name),
+	}
+
+	for i := 0; i < len(components); i += 2 {
+		key := components[i]
+		value := components[i+1]
+
+		switch {
+		case i == 0:
+			if key != "subscriptions" {
+				return nil, fmt.Errorf("expected the first segment of %q to be `subscriptions` but got %q", id, key)
+			}
+			out.ExampleSubscriptionId = value
+
+		case i == 2 && key == "resourceGroups":
+			out.ExampleResourceGroup = value
+
+		case key == "providers":
+			out.Provider = value
+
+		default:
+			out.Segments = append(out.Segments, segment{
+				FieldName:    convertToFieldName(key),
+				SegmentKey:   key,
+				SegmentValue: value,
+			})
+		}
+	}
+
+	if out.ExampleResourceGroup == "" {
+		return nil, fmt.Errorf("only Resource IDs scoped to a Resource Group are supported")
+	}
+
+	if len(out.Segments) == 0 {
+		if out.Provider != "" {
+			return nil, fmt.Errorf("expected %q to contain at least one segment after the provider", id)
+		}
+
+		out.IsResourceGroup = true
+		return &out, nil
+	}
+
+	if out.Provider == "" {
+		return nil, fmt.Errorf("expected %q to contain a `providers` segment", id)
+	}
+
+	// the last segment is the name of the Resource itself
+	out.Segments[len(out.Segments)-1].FieldName = "Name"
+
+	return &out, nil
+}
+
+func (id resourceID) fields() []string {
+	fields := []string{"SubscriptionId"}
+	if id.IsResourceGroup {
+		return append(fields, "Name")
+	}
+
+	fields = append(fields, "ResourceGroup")
+	for _, s := range id.Segments {
+		fields = append(fields, s.FieldName)
+	}
+	return fields
+}
+
+func (id resourceID) formatString() string {
+	if id.IsResourceGroup {
+		return "/subscriptions/%s/resourceGroups/%s"
+	}
+
+	components := []string{
+		"/subscriptions/%s/resourceGroups/%s",
+		"providers",
+		id.Provider,
+	}
+	for _, s := range id.Segments {
+		components = append(components, s.SegmentKey, "%s")
+	}
+	return strings.Join(components, "/")
+}
+
+func (id resourceID) code() string {
+	fields := id.fields()
+
+	structFields := make([]string, 0)
+	arguments := make([]string, 0)
+	assignments := make([]string, 0)
+	formatArguments := make([]string, 0)
+	for _, field := range fields {
+		argument := convertToArgumentName(field)
+		structFields = append(structFields, fmt.Sprintf("\t%s string", field))
+		arguments = append(arguments, argument)
+		assignments = append(assignments, fmt.Sprintf("\t\t%s: %s,", field, argument))
+		formatArguments = append(formatArguments, fmt.Sprintf("id.%s", field))
+	}
+
+	parseSegments := make([]string, 0)
+	if id.IsResourceGroup {
+		parseSegments = append(parseSegments, `	if resourceId.Name = id.ResourceGroup; resourceId.Name == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if id.Provider != "" {
+		return nil, fmt.Errorf("ID %q was expected to be for a Resource Group but contained the Provider %q", input, id.Provider)
+	}
+`)
+	} else {
+		parseSegments = append(parseSegments, fmt.Sprintf(`	if resourceId.ResourceGroup = id.ResourceGroup; resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateProvider(input, %q); err != nil {
+		return nil, err
+	}
+`, id.Provider))
+		for _, s := range id.Segments {
+			parseSegments = append(parseSegments, fmt.Sprintf(`	if resourceId.%s, err = id.PopSegment(%q); err != nil {
+		return nil, err
+	}
+`, s.FieldName, s.SegmentKey))
+		}
+	}
+
+	return fmt.Sprintf(`package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// %[1]sID is a parsed %[2]s ID
+type %[1]sID struct {
+%[3]s
+}
+
+// New%[1]sID returns a new %[1]sID for the specified values
+func New%[1]sID(%[4]s string) %[1]sID {
+	return %[1]sID{
+%[5]s
+	}
+}
+
+// ID returns the formatted %[2]s ID
+func (id %[1]sID) ID() string {
+	fmtString := %[6]q
+	return fmt.Sprintf(fmtString, %[7]s)
+}
+
+// Parse%[1]sID parses a %[2]s ID into a %[1]sID struct
+func Parse%[1]sID(input string) (*%[1]sID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %[2]s ID %%q: %%+v", input, err)
+	}
+
+	resourceId := %[1]sID{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+%[8]s
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// Validate%[1]sID validates that the specified value is a %[2]s ID
+func Validate%[1]sID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %%q to be string", k))
+		return
+	}
+
+	if _, err := Parse%[1]sID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %%q as a %[2]s ID: %%+v", k, err))
+	}
+
+	return
+}
+`, id.TypeName, id.DisplayName, strings.Join(structFields, "\n"), strings.Join(arguments, ", "), strings.Join(assignments, "\n"), id.formatString(), strings.Join(formatArguments, ", "), strings.Join(parseSegments, "\n"))
+}
+
+func (id resourceID) testCode() string {
+	fields := id.fields()
+
+	exampleValues := map[string]string{
+		"SubscriptionId": id.ExampleSubscriptionId,
+		"ResourceGroup":  id.ExampleResourceGroup,
+	}
+	if id.IsResourceGroup {
+		exampleValues["Name"] = id.ExampleResourceGroup
+	}
+	for _, s := range id.Segments {
+		exampleValues[s.FieldName] = s.SegmentValue
+	}
+
+	// build up the test cases by progressively adding the segments of the ID, all but the last being invalid
+	type testCase struct {
+		Name     string
+		Input    string
+		Expected bool
+		Upper    bool
+	}
+	testCases := []testCase{
+		{Name: "Empty", Input: ""},
+		{Name: "No Resource Groups Segment", Input: fmt.Sprintf("/subscriptions/%s", id.ExampleSubscriptionId)},
+		{Name: "No Resource Groups Value", Input: fmt.Sprintf("/subscriptions/%s/resourceGroups/", id.ExampleSubscriptionId)},
+	}
+
+	current := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", id.ExampleSubscriptionId, id.ExampleResourceGroup)
+	if !id.IsResourceGroup {
+		testCases = append(testCases, testCase{Name: "No Provider Segment", Input: current})
+		current = fmt.Sprintf("%s/providers/%s", current, id.Provider)
+		for _, s := range id.Segments {
+			testCases = append(testCases, testCase{Name: fmt.Sprintf("Missing %s Value", s.FieldName), Input: fmt.Sprintf("%s/%s/", current, s.SegmentKey)})
+			current = fmt.Sprintf("%s/%s/%s", current, s.SegmentKey, s.SegmentValue)
+		}
+	}
+
+	testCases = append(testCases,
+		testCase{Name: fmt.Sprintf("%s ID", id.DisplayName), Input: current, Expected: true},
+		testCase{Name: "Upper Cased Segments", Input: current, Expected: true, Upper: true},
+		testCase{Name: "Additional Segments", Input: fmt.Sprintf("%s/extra/value1", current)},
+	)
+
+	lines := make([]string, 0)
+	for _, tc := range testCases {
+		input := fmt.Sprintf("%q", tc.Input)
+		if tc.Upper {
+			input = fmt.Sprintf("%q", upperCaseSegmentKeys(tc.Input))
+		}
+
+		if !tc.Expected {
+			lines = append(lines, fmt.Sprintf(`		{
+			Name:     %q,
+			Input:    %s,
+			Expected: nil,
+		},`, tc.Name, input))
+			continue
+		}
+
+		expectedFields := make([]string, 0)
+		for _, field := range fields {
+			expectedFields = append(expectedFields, fmt.Sprintf("\t\t\t\t%s: %q,", field, exampleValues[field]))
+		}
+		lines = append(lines, fmt.Sprintf(`		{
+			Name:  %q,
+			Input: %s,
+			Expected: &%sID{
+%s
+			},
+		},`, tc.Name, input, id.TypeName, strings.Join(expectedFields, "\n")))
+	}
+
+	comparisons := make([]string, 0)
+	arguments := make([]string, 0)
+	for _, field := range fields {
+		arguments = append(arguments, fmt.Sprintf("%q", exampleValues[field]))
+		comparisons = append(comparisons, fmt.Sprintf(`		if actual.%[1]s != v.Expected.%[1]s {
+			t.Fatalf("Expected %%q but got %%q for %[1]s", v.Expected.%[1]s, actual.%[1]s)
+		}
+`, field))
+	}
+
+	return fmt.Sprintf(`package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+)
+
+func Test%[1]sIDFormatter(t *testing.T) {
+	actual := New%[1]sID(%[2]s).ID()
+	expected := %[3]q
+	if actual != expected {
+		t.Fatalf("Expected %%q but got %%q", expected, actual)
+	}
+}
+
+func Test%[1]sIDParser(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *%[1]sID
+	}{
+%[4]s
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %%q", v.Name)
+
+		actual, err := Parse%[1]sID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %%s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value")
+		}
+
+%[5]s	}
+}
+`, id.TypeName, strings.Join(arguments, ", "), current, strings.Join(lines, "\n"), strings.Join(comparisons, "\n"))
+}
+
+func writeFormattedFile(fileName, contents string) error {
+	formatted, err := format.Source([]byte(contents))
+	if err != nil {
+		return fmt.Errorf("formatting %q: %+v", fileName, err)
+	}
+
+	return ioutil.WriteFile(fileName, formatted, 0644)
+}
+
+// upperCaseSegmentKeys upper-cases the keys (but not the values) within a Resource ID
+func upperCaseSegmentKeys(input string) string {
+	components := strings.Split(input, "/")
+	for i := 1; i < len(components); i += 2 {
+		// the Subscriptions, Resource Groups & Providers segments are handled by the generic parser
+		if components[i] == "subscriptions" || components[i] == "resourceGroups" || components[i] == "providers" {
+			continue
+		}
+
+		components[i] = strings.ToUpper(components[i])
+	}
+	return strings.Join(components, "/")
+}
+
+// convertToFieldName converts a segment key (e.g. `virtualNetworks`) into a field name (e.g. `VirtualNetworkName`)
+func convertToFieldName(key string) string {
+	singular := key
+	switch {
+	case strings.HasSuffix(key, "ies"):
+		singular = strings.TrimSuffix(key, "ies") + "y"
+	case strings.HasSuffix(key, "sses"):
+		singular = strings.TrimSuffix(key, "es")
+	case strings.HasSuffix(key, "s"):
+		singular = strings.TrimSuffix(key, "s")
+	}
+
+	return strings.ToUpper(singular[:1]) + singular[1:] + "Name"
+}
+
+// convertToArgumentName converts a field name (e.g. `ResourceGroup`) into an argument name (e.g. `resourceGroup`)
+func convertToArgumentName(field string) string {
+	return strings.ToLower(field[:1]) + field[1:]
+}
+
+// convertToDisplayName converts a type name (e.g. `VirtualMachine`) into a display name (e.g. `Virtual Machine`)
+func convertToDisplayName(name string) string {
+	runes := []rune(name)
+	output := ""
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			// split on the start of a new word, or the end of an acronym (e.g. `IPAddress` -> `IP Address`)
+			previousIsLower := !unicode.IsUpper(runes[i-1])
+			nextIsLower := i+1 < len(runes) && !unicode.IsUpper(runes[i+1])
+			if previousIsLower || nextIsLower {
+				output += " "
+			}
+		}
+		output += string(r)
+	}
+	return output
+}
+
+// convertToSnakeCase converts a type name (e.g. `VirtualMachine`) into snake case (e.g. `virtual_machine`)
+func convertToSnakeCase(name string) string {
+	return strings.ToLower(strings.Replace(convertToDisplayName(name), " ", "_", -1))
+}
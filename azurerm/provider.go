@@ -5,446 +5,159 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/oidc"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/analysisservices"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimanagement"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/applicationinsights"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/authorization"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/automation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/batch"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/bot"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cdn"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cognitive"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/common"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/databricks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datafactory"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datalake"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/devspace"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/devtestlabs"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/dns"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventgrid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventhub"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/frontdoor"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/graph"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/hdinsight"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/iothub"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/kusto"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/logic"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managementgroup"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/maps"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mariadb"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/media"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mysql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/notificationhub"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/portal"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/postgres"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/privatedns"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/recoveryservices"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/redis"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/relay"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/scheduler"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/search"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/securitycenter"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicefabric"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/signalr"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/streamanalytics"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/subscription"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/trafficmanager"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 // Provider returns a terraform.ResourceProvider.
 func Provider() terraform.ResourceProvider {
-	// NOTE: as part of migrating Data Sources/Resources into Packages - we should be able to use
-	// the Service Registration interface to gradually migrate Data Sources/Resources over to the
-	// new pattern.
-	// However this requires that the following be done first:
+	// NOTE: as part of migrating Data Sources/Resources into Packages - we use the Service Registration
+	// interface to gradually migrate Data Sources/Resources over to the new pattern:
 	//  1. (DONE) Migrating the top level functions into the internal package
 	//	2. (DONE) Finish migrating the SDK Clients into Packages
 	//	3. (DONE) Switch the remaining resources over to the new Storage SDK
 	//		(so we can remove `getBlobStorageClientForStorageAccount` from `config.go`)
 	//  4. (DONE) Introducing a parent struct which becomes a nested field in `config.go`
 	//  	for those properties, to ease migration (probably internal/common/clients.go)
-	//
-	//	5. Making the SDK Clients public in the ArmClient
-	//  6. Migrating the Fields from the `ArmClient` to the new base `Client`
-	//		But leaving the referencing accessing the top-level field e.g.
-	//			type Client struct { // ./azurerm/internal/common/client.go
-	//				Example example.Client
-	//			}
-	//			type ArmClient struct { // ./azurerm/config.go
-	//				common.Client
-	//			}
-	//		Then access the fields using: `meta.(*ArmClient).Example.Inner`
-	//		Rather than `meta.(*ArmClient).Client.Example.Inner`
-	//		This allows us to have less code changes in Step 7
-	//	7. This should allow us to Find+Replace `(*ArmClient)` to `*common.Client`
-	//		Unfortunately this'll need to be in a big-bang, due to the fact this is cast
-	//		All over the place
-	//
-	// For the moment/until that's done, we'll have to continue defining these inline
+	//	5. (DONE) Making the SDK Clients public in the ArmClient
+	//  6. (DONE) Migrating the Fields from the `ArmClient` to the new base `Client`
+	//	7. (DONE) Find+Replace `(*ArmClient)` to `(*clients.Client)` and remove the `ArmClient`
+	//	8. (DONE) Move each Data Source/Resource (and it's Tests) into the Service Package
+	//		at `./azurerm/internal/services/{name}`, registering the Service below. The Tests for
+	//		each Service live in `./azurerm/internal/services/{name}/tests` and use the helpers
+	//		in `./azurerm/internal/acceptance`
 	supportedServices := []common.ServiceRegistration{
+		analysisservices.Registration{},
+		apimanagement.Registration{},
+		applicationinsights.Registration{},
+		authorization.Registration{},
+		automation.Registration{},
+		batch.Registration{},
+		bot.Registration{},
+		cdn.Registration{},
+		cognitive.Registration{},
 		compute.Registration{},
+		containers.Registration{},
+		cosmos.Registration{},
+		databricks.Registration{},
+		datafactory.Registration{},
+		datalake.Registration{},
+		devspace.Registration{},
+		devtestlabs.Registration{},
+		dns.Registration{},
+		eventgrid.Registration{},
+		eventhub.Registration{},
+		frontdoor.Registration{},
+		graph.Registration{},
+		hdinsight.Registration{},
+		iothub.Registration{},
+		keyvault.Registration{},
+		kusto.Registration{},
+		loganalytics.Registration{},
+		logic.Registration{},
+		managementgroup.Registration{},
+		maps.Registration{},
+		mariadb.Registration{},
+		media.Registration{},
+		monitor.Registration{},
+		msi.Registration{},
+		mssql.Registration{},
+		mysql.Registration{},
+		network.Registration{},
+		notificationhub.Registration{},
+		policy.Registration{},
+		portal.Registration{},
+		postgres.Registration{},
+		privatedns.Registration{},
+		recoveryservices.Registration{},
+		redis.Registration{},
+		relay.Registration{},
+		resource.Registration{},
+		scheduler.Registration{},
+		search.Registration{},
+		securitycenter.Registration{},
+		servicebus.Registration{},
+		servicefabric.Registration{},
+		signalr.Registration{},
+		sql.Registration{},
+		storage.Registration{},
+		streamanalytics.Registration{},
+		subscription.Registration{},
+		trafficmanager.Registration{},
+		web.Registration{},
 	}
 
-	dataSources := map[string]*schema.Resource{
-		"azurerm_api_management":                          dataSourceApiManagementService(),
-		"azurerm_api_management_api":                      dataSourceApiManagementApi(),
-		"azurerm_api_management_group":                    dataSourceApiManagementGroup(),
-		"azurerm_api_management_product":                  dataSourceApiManagementProduct(),
-		"azurerm_api_management_user":                     dataSourceArmApiManagementUser(),
-		"azurerm_app_service_plan":                        dataSourceAppServicePlan(),
-		"azurerm_app_service":                             dataSourceArmAppService(),
-		"azurerm_application_insights":                    dataSourceArmApplicationInsights(),
-		"azurerm_application_security_group":              dataSourceArmApplicationSecurityGroup(),
-		"azurerm_automation_variable_bool":                dataSourceArmAutomationVariableBool(),
-		"azurerm_automation_variable_datetime":            dataSourceArmAutomationVariableDateTime(),
-		"azurerm_automation_variable_int":                 dataSourceArmAutomationVariableInt(),
-		"azurerm_automation_variable_string":              dataSourceArmAutomationVariableString(),
-		"azurerm_availability_set":                        dataSourceArmAvailabilitySet(),
-		"azurerm_azuread_application":                     dataSourceArmAzureADApplication(),
-		"azurerm_azuread_service_principal":               dataSourceArmActiveDirectoryServicePrincipal(),
-		"azurerm_batch_account":                           dataSourceArmBatchAccount(),
-		"azurerm_batch_certificate":                       dataSourceArmBatchCertificate(),
-		"azurerm_batch_pool":                              dataSourceArmBatchPool(),
-		"azurerm_builtin_role_definition":                 dataSourceArmBuiltInRoleDefinition(),
-		"azurerm_cdn_profile":                             dataSourceArmCdnProfile(),
-		"azurerm_client_config":                           dataSourceArmClientConfig(),
-		"azurerm_kubernetes_service_versions":             dataSourceArmKubernetesServiceVersions(),
-		"azurerm_container_registry":                      dataSourceArmContainerRegistry(),
-		"azurerm_cosmosdb_account":                        dataSourceArmCosmosDbAccount(),
-		"azurerm_data_lake_store":                         dataSourceArmDataLakeStoreAccount(),
-		"azurerm_dev_test_lab":                            dataSourceArmDevTestLab(),
-		"azurerm_dev_test_virtual_network":                dataSourceArmDevTestVirtualNetwork(),
-		"azurerm_dns_zone":                                dataSourceArmDnsZone(),
-		"azurerm_eventhub_namespace":                      dataSourceEventHubNamespace(),
-		"azurerm_express_route_circuit":                   dataSourceArmExpressRouteCircuit(),
-		"azurerm_firewall":                                dataSourceArmFirewall(),
-		"azurerm_image":                                   dataSourceArmImage(),
-		"azurerm_hdinsight_cluster":                       dataSourceArmHDInsightSparkCluster(),
-		"azurerm_maps_account":                            dataSourceArmMapsAccount(),
-		"azurerm_key_vault_access_policy":                 dataSourceArmKeyVaultAccessPolicy(),
-		"azurerm_key_vault_key":                           dataSourceArmKeyVaultKey(),
-		"azurerm_key_vault_secret":                        dataSourceArmKeyVaultSecret(),
-		"azurerm_key_vault":                               dataSourceArmKeyVault(),
-		"azurerm_kubernetes_cluster":                      dataSourceArmKubernetesCluster(),
-		"azurerm_lb":                                      dataSourceArmLoadBalancer(),
-		"azurerm_lb_backend_address_pool":                 dataSourceArmLoadBalancerBackendAddressPool(),
-		"azurerm_log_analytics_workspace":                 dataSourceLogAnalyticsWorkspace(),
-		"azurerm_logic_app_workflow":                      dataSourceArmLogicAppWorkflow(),
-		"azurerm_managed_disk":                            dataSourceArmManagedDisk(),
-		"azurerm_management_group":                        dataSourceArmManagementGroup(),
-		"azurerm_monitor_action_group":                    dataSourceArmMonitorActionGroup(),
-		"azurerm_monitor_diagnostic_categories":           dataSourceArmMonitorDiagnosticCategories(),
-		"azurerm_monitor_log_profile":                     dataSourceArmMonitorLogProfile(),
-		"azurerm_mssql_elasticpool":                       dataSourceArmMsSqlElasticpool(),
-		"azurerm_network_ddos_protection_plan":            dataSourceNetworkDDoSProtectionPlan(),
-		"azurerm_network_interface":                       dataSourceArmNetworkInterface(),
-		"azurerm_network_security_group":                  dataSourceArmNetworkSecurityGroup(),
-		"azurerm_network_watcher":                         dataSourceArmNetworkWatcher(),
-		"azurerm_notification_hub_namespace":              dataSourceNotificationHubNamespace(),
-		"azurerm_notification_hub":                        dataSourceNotificationHub(),
-		"azurerm_platform_image":                          dataSourceArmPlatformImage(),
-		"azurerm_policy_definition":                       dataSourceArmPolicyDefinition(),
-		"azurerm_proximity_placement_group":               dataSourceArmProximityPlacementGroup(),
-		"azurerm_public_ip":                               dataSourceArmPublicIP(),
-		"azurerm_public_ips":                              dataSourceArmPublicIPs(),
-		"azurerm_public_ip_prefix":                        dataSourceArmPublicIpPrefix(),
-		"azurerm_recovery_services_vault":                 dataSourceArmRecoveryServicesVault(),
-		"azurerm_recovery_services_protection_policy_vm":  dataSourceArmRecoveryServicesProtectionPolicyVm(),
-		"azurerm_redis_cache":                             dataSourceArmRedisCache(),
-		"azurerm_resource_group":                          dataSourceArmResourceGroup(),
-		"azurerm_role_definition":                         dataSourceArmRoleDefinition(),
-		"azurerm_route_table":                             dataSourceArmRouteTable(),
-		"azurerm_scheduler_job_collection":                dataSourceArmSchedulerJobCollection(),
-		"azurerm_servicebus_namespace":                    dataSourceArmServiceBusNamespace(),
-		"azurerm_servicebus_namespace_authorization_rule": dataSourceArmServiceBusNamespaceAuthorizationRule(),
-		"azurerm_shared_image_gallery":                    dataSourceArmSharedImageGallery(),
-		"azurerm_shared_image_version":                    dataSourceArmSharedImageVersion(),
-		"azurerm_shared_image":                            dataSourceArmSharedImage(),
-		"azurerm_snapshot":                                dataSourceArmSnapshot(),
-		"azurerm_sql_server":                              dataSourceSqlServer(),
-		"azurerm_sql_database":                            dataSourceSqlDatabase(),
-		"azurerm_stream_analytics_job":                    dataSourceArmStreamAnalyticsJob(),
-		"azurerm_storage_account_blob_container_sas":      dataSourceArmStorageAccountBlobContainerSharedAccessSignature(),
-		"azurerm_storage_account_sas":                     dataSourceArmStorageAccountSharedAccessSignature(),
-		"azurerm_storage_account":                         dataSourceArmStorageAccount(),
-		"azurerm_subnet":                                  dataSourceArmSubnet(),
-		"azurerm_subscription":                            dataSourceArmSubscription(),
-		"azurerm_subscriptions":                           dataSourceArmSubscriptions(),
-		"azurerm_traffic_manager_geographical_location":   dataSourceArmTrafficManagerGeographicalLocation(),
-		"azurerm_user_assigned_identity":                  dataSourceArmUserAssignedIdentity(),
-		"azurerm_virtual_machine":                         dataSourceArmVirtualMachine(),
-		"azurerm_virtual_network_gateway":                 dataSourceArmVirtualNetworkGateway(),
-		"azurerm_virtual_network_gateway_connection":      dataSourceArmVirtualNetworkGatewayConnection(),
-		"azurerm_virtual_network":                         dataSourceArmVirtualNetwork(),
-	}
-
-	resources := map[string]*schema.Resource{
-		"azurerm_analysis_services_server":                           resourceArmAnalysisServicesServer(),
-		"azurerm_api_management":                                     resourceArmApiManagementService(),
-		"azurerm_api_management_api":                                 resourceArmApiManagementApi(),
-		"azurerm_api_management_api_operation":                       resourceArmApiManagementApiOperation(),
-		"azurerm_api_management_api_operation_policy":                resourceArmApiManagementApiOperationPolicy(),
-		"azurerm_api_management_api_policy":                          resourceArmApiManagementApiPolicy(),
-		"azurerm_api_management_api_schema":                          resourceArmApiManagementApiSchema(),
-		"azurerm_api_management_api_version_set":                     resourceArmApiManagementApiVersionSet(),
-		"azurerm_api_management_authorization_server":                resourceArmApiManagementAuthorizationServer(),
-		"azurerm_api_management_backend":                             resourceArmApiManagementBackend(),
-		"azurerm_api_management_certificate":                         resourceArmApiManagementCertificate(),
-		"azurerm_api_management_group":                               resourceArmApiManagementGroup(),
-		"azurerm_api_management_group_user":                          resourceArmApiManagementGroupUser(),
-		"azurerm_api_management_logger":                              resourceArmApiManagementLogger(),
-		"azurerm_api_management_openid_connect_provider":             resourceArmApiManagementOpenIDConnectProvider(),
-		"azurerm_api_management_product":                             resourceArmApiManagementProduct(),
-		"azurerm_api_management_product_api":                         resourceArmApiManagementProductApi(),
-		"azurerm_api_management_product_group":                       resourceArmApiManagementProductGroup(),
-		"azurerm_api_management_product_policy":                      resourceArmApiManagementProductPolicy(),
-		"azurerm_api_management_property":                            resourceArmApiManagementProperty(),
-		"azurerm_api_management_subscription":                        resourceArmApiManagementSubscription(),
-		"azurerm_api_management_user":                                resourceArmApiManagementUser(),
-		"azurerm_app_service_active_slot":                            resourceArmAppServiceActiveSlot(),
-		"azurerm_app_service_certificate":                            resourceArmAppServiceCertificate(),
-		"azurerm_app_service_custom_hostname_binding":                resourceArmAppServiceCustomHostnameBinding(),
-		"azurerm_app_service_plan":                                   resourceArmAppServicePlan(),
-		"azurerm_app_service_slot":                                   resourceArmAppServiceSlot(),
-		"azurerm_app_service_source_control_token":                   resourceArmAppServiceSourceControlToken(),
-		"azurerm_app_service":                                        resourceArmAppService(),
-		"azurerm_application_gateway":                                resourceArmApplicationGateway(),
-		"azurerm_application_insights_api_key":                       resourceArmApplicationInsightsAPIKey(),
-		"azurerm_application_insights":                               resourceArmApplicationInsights(),
-		"azurerm_application_insights_web_test":                      resourceArmApplicationInsightsWebTests(),
-		"azurerm_application_security_group":                         resourceArmApplicationSecurityGroup(),
-		"azurerm_automation_account":                                 resourceArmAutomationAccount(),
-		"azurerm_automation_credential":                              resourceArmAutomationCredential(),
-		"azurerm_automation_dsc_configuration":                       resourceArmAutomationDscConfiguration(),
-		"azurerm_automation_dsc_nodeconfiguration":                   resourceArmAutomationDscNodeConfiguration(),
-		"azurerm_automation_module":                                  resourceArmAutomationModule(),
-		"azurerm_automation_runbook":                                 resourceArmAutomationRunbook(),
-		"azurerm_automation_schedule":                                resourceArmAutomationSchedule(),
-		"azurerm_automation_variable_bool":                           resourceArmAutomationVariableBool(),
-		"azurerm_automation_variable_datetime":                       resourceArmAutomationVariableDateTime(),
-		"azurerm_automation_variable_int":                            resourceArmAutomationVariableInt(),
-		"azurerm_automation_variable_string":                         resourceArmAutomationVariableString(),
-		"azurerm_autoscale_setting":                                  resourceArmAutoScaleSetting(),
-		"azurerm_availability_set":                                   resourceArmAvailabilitySet(),
-		"azurerm_azuread_application":                                resourceArmActiveDirectoryApplication(),
-		"azurerm_azuread_service_principal_password":                 resourceArmActiveDirectoryServicePrincipalPassword(),
-		"azurerm_azuread_service_principal":                          resourceArmActiveDirectoryServicePrincipal(),
-		"azurerm_batch_account":                                      resourceArmBatchAccount(),
-		"azurerm_batch_application":                                  resourceArmBatchApplication(),
-		"azurerm_batch_certificate":                                  resourceArmBatchCertificate(),
-		"azurerm_bot_channel_slack":                                  resourceArmBotChannelSlack(),
-		"azurerm_bot_channels_registration":                          resourceArmBotChannelsRegistration(),
-		"azurerm_bot_connection":                                     resourceArmBotConnection(),
-		"azurerm_bot_web_app":                                        resourceArmBotWebApp(),
-		"azurerm_batch_pool":                                         resourceArmBatchPool(),
-		"azurerm_cdn_endpoint":                                       resourceArmCdnEndpoint(),
-		"azurerm_cdn_profile":                                        resourceArmCdnProfile(),
-		"azurerm_cognitive_account":                                  resourceArmCognitiveAccount(),
-		"azurerm_connection_monitor":                                 resourceArmConnectionMonitor(),
-		"azurerm_container_group":                                    resourceArmContainerGroup(),
-		"azurerm_container_registry_webhook":                         resourceArmContainerRegistryWebhook(),
-		"azurerm_container_registry":                                 resourceArmContainerRegistry(),
-		"azurerm_container_service":                                  resourceArmContainerService(),
-		"azurerm_cosmosdb_account":                                   resourceArmCosmosDbAccount(),
-		"azurerm_cosmosdb_cassandra_keyspace":                        resourceArmCosmosDbCassandraKeyspace(),
-		"azurerm_cosmosdb_mongo_collection":                          resourceArmCosmosDbMongoCollection(),
-		"azurerm_cosmosdb_mongo_database":                            resourceArmCosmosDbMongoDatabase(),
-		"azurerm_cosmosdb_sql_container":                             resourceArmCosmosDbSQLContainer(),
-		"azurerm_cosmosdb_sql_database":                              resourceArmCosmosDbSQLDatabase(),
-		"azurerm_cosmosdb_table":                                     resourceArmCosmosDbTable(),
-		"azurerm_dashboard":                                          resourceArmDashboard(),
-		"azurerm_data_factory":                                       resourceArmDataFactory(),
-		"azurerm_data_factory_dataset_mysql":                         resourceArmDataFactoryDatasetMySQL(),
-		"azurerm_data_factory_dataset_postgresql":                    resourceArmDataFactoryDatasetPostgreSQL(),
-		"azurerm_data_factory_dataset_sql_server_table":              resourceArmDataFactoryDatasetSQLServerTable(),
-		"azurerm_data_factory_linked_service_data_lake_storage_gen2": resourceArmDataFactoryLinkedServiceDataLakeStorageGen2(),
-		"azurerm_data_factory_linked_service_mysql":                  resourceArmDataFactoryLinkedServiceMySQL(),
-		"azurerm_data_factory_linked_service_postgresql":             resourceArmDataFactoryLinkedServicePostgreSQL(),
-		"azurerm_data_factory_linked_service_sql_server":             resourceArmDataFactoryLinkedServiceSQLServer(),
-		"azurerm_data_factory_pipeline":                              resourceArmDataFactoryPipeline(),
-		"azurerm_data_lake_analytics_account":                        resourceArmDataLakeAnalyticsAccount(),
-		"azurerm_data_lake_analytics_firewall_rule":                  resourceArmDataLakeAnalyticsFirewallRule(),
-		"azurerm_data_lake_store_file":                               resourceArmDataLakeStoreFile(),
-		"azurerm_data_lake_store_firewall_rule":                      resourceArmDataLakeStoreFirewallRule(),
-		"azurerm_data_lake_store":                                    resourceArmDataLakeStore(),
-		"azurerm_databricks_workspace":                               resourceArmDatabricksWorkspace(),
-		"azurerm_ddos_protection_plan":                               resourceArmDDoSProtectionPlan(),
-		"azurerm_dev_test_lab":                                       resourceArmDevTestLab(),
-		"azurerm_dev_test_schedule":                                  resourceArmDevTestLabSchedules(),
-		"azurerm_dev_test_linux_virtual_machine":                     resourceArmDevTestLinuxVirtualMachine(),
-		"azurerm_dev_test_policy":                                    resourceArmDevTestPolicy(),
-		"azurerm_dev_test_virtual_network":                           resourceArmDevTestVirtualNetwork(),
-		"azurerm_dev_test_windows_virtual_machine":                   resourceArmDevTestWindowsVirtualMachine(),
-		"azurerm_devspace_controller":                                resourceArmDevSpaceController(),
-		"azurerm_dns_a_record":                                       resourceArmDnsARecord(),
-		"azurerm_dns_aaaa_record":                                    resourceArmDnsAAAARecord(),
-		"azurerm_dns_caa_record":                                     resourceArmDnsCaaRecord(),
-		"azurerm_dns_cname_record":                                   resourceArmDnsCNameRecord(),
-		"azurerm_dns_mx_record":                                      resourceArmDnsMxRecord(),
-		"azurerm_dns_ns_record":                                      resourceArmDnsNsRecord(),
-		"azurerm_dns_ptr_record":                                     resourceArmDnsPtrRecord(),
-		"azurerm_dns_srv_record":                                     resourceArmDnsSrvRecord(),
-		"azurerm_dns_txt_record":                                     resourceArmDnsTxtRecord(),
-		"azurerm_dns_zone":                                           resourceArmDnsZone(),
-		"azurerm_eventgrid_domain":                                   resourceArmEventGridDomain(),
-		"azurerm_eventgrid_event_subscription":                       resourceArmEventGridEventSubscription(),
-		"azurerm_eventgrid_topic":                                    resourceArmEventGridTopic(),
-		"azurerm_eventhub_authorization_rule":                        resourceArmEventHubAuthorizationRule(),
-		"azurerm_eventhub_consumer_group":                            resourceArmEventHubConsumerGroup(),
-		"azurerm_eventhub_namespace_authorization_rule":              resourceArmEventHubNamespaceAuthorizationRule(),
-		"azurerm_eventhub_namespace_disaster_recovery_config":        resourceArmEventHubNamespaceDisasterRecoveryConfig(),
-		"azurerm_eventhub_namespace":                                 resourceArmEventHubNamespace(),
-		"azurerm_eventhub":                                           resourceArmEventHub(),
-		"azurerm_express_route_circuit_authorization":                resourceArmExpressRouteCircuitAuthorization(),
-		"azurerm_express_route_circuit_peering":                      resourceArmExpressRouteCircuitPeering(),
-		"azurerm_express_route_circuit":                              resourceArmExpressRouteCircuit(),
-		"azurerm_firewall_application_rule_collection":               resourceArmFirewallApplicationRuleCollection(),
-		"azurerm_firewall_nat_rule_collection":                       resourceArmFirewallNatRuleCollection(),
-		"azurerm_firewall_network_rule_collection":                   resourceArmFirewallNetworkRuleCollection(),
-		"azurerm_firewall":                                           resourceArmFirewall(),
-		"azurerm_frontdoor":                                          resourceArmFrontDoor(),
-		"azurerm_frontdoor_firewall_policy":                          resourceArmFrontDoorFirewallPolicy(),
-		"azurerm_function_app":                                       resourceArmFunctionApp(),
-		"azurerm_hdinsight_hadoop_cluster":                           resourceArmHDInsightHadoopCluster(),
-		"azurerm_hdinsight_hbase_cluster":                            resourceArmHDInsightHBaseCluster(),
-		"azurerm_hdinsight_interactive_query_cluster":                resourceArmHDInsightInteractiveQueryCluster(),
-		"azurerm_hdinsight_kafka_cluster":                            resourceArmHDInsightKafkaCluster(),
-		"azurerm_hdinsight_ml_services_cluster":                      resourceArmHDInsightMLServicesCluster(),
-		"azurerm_hdinsight_rserver_cluster":                          resourceArmHDInsightRServerCluster(),
-		"azurerm_hdinsight_spark_cluster":                            resourceArmHDInsightSparkCluster(),
-		"azurerm_hdinsight_storm_cluster":                            resourceArmHDInsightStormCluster(),
-		"azurerm_image":                                              resourceArmImage(),
-		"azurerm_iot_dps":                                            resourceArmIotDPS(),
-		"azurerm_iot_dps_certificate":                                resourceArmIotDPSCertificate(),
-		"azurerm_iothub_consumer_group":                              resourceArmIotHubConsumerGroup(),
-		"azurerm_iothub":                                             resourceArmIotHub(),
-		"azurerm_iothub_shared_access_policy":                        resourceArmIotHubSharedAccessPolicy(),
-		"azurerm_key_vault_access_policy":                            resourceArmKeyVaultAccessPolicy(),
-		"azurerm_key_vault_certificate":                              resourceArmKeyVaultCertificate(),
-		"azurerm_key_vault_key":                                      resourceArmKeyVaultKey(),
-		"azurerm_key_vault_secret":                                   resourceArmKeyVaultSecret(),
-		"azurerm_key_vault":                                          resourceArmKeyVault(),
-		"azurerm_kubernetes_cluster":                                 resourceArmKubernetesCluster(),
-		"azurerm_kusto_cluster":                                      resourceArmKustoCluster(),
-		"azurerm_kusto_database":                                     resourceArmKustoDatabase(),
-		"azurerm_lb_backend_address_pool":                            resourceArmLoadBalancerBackendAddressPool(),
-		"azurerm_lb_nat_pool":                                        resourceArmLoadBalancerNatPool(),
-		"azurerm_lb_nat_rule":                                        resourceArmLoadBalancerNatRule(),
-		"azurerm_lb_probe":                                           resourceArmLoadBalancerProbe(),
-		"azurerm_lb_outbound_rule":                                   resourceArmLoadBalancerOutboundRule(),
-		"azurerm_lb_rule":                                            resourceArmLoadBalancerRule(),
-		"azurerm_lb":                                                 resourceArmLoadBalancer(),
-		"azurerm_local_network_gateway":                              resourceArmLocalNetworkGateway(),
-		"azurerm_log_analytics_solution":                             resourceArmLogAnalyticsSolution(),
-		"azurerm_log_analytics_linked_service":                       resourceArmLogAnalyticsLinkedService(),
-		"azurerm_log_analytics_workspace_linked_service":             resourceArmLogAnalyticsWorkspaceLinkedService(),
-		"azurerm_log_analytics_workspace":                            resourceArmLogAnalyticsWorkspace(),
-		"azurerm_logic_app_action_custom":                            resourceArmLogicAppActionCustom(),
-		"azurerm_logic_app_action_http":                              resourceArmLogicAppActionHTTP(),
-		"azurerm_logic_app_trigger_custom":                           resourceArmLogicAppTriggerCustom(),
-		"azurerm_logic_app_trigger_http_request":                     resourceArmLogicAppTriggerHttpRequest(),
-		"azurerm_logic_app_trigger_recurrence":                       resourceArmLogicAppTriggerRecurrence(),
-		"azurerm_logic_app_workflow":                                 resourceArmLogicAppWorkflow(),
-		"azurerm_managed_disk":                                       resourceArmManagedDisk(),
-		"azurerm_management_group":                                   resourceArmManagementGroup(),
-		"azurerm_management_lock":                                    resourceArmManagementLock(),
-		"azurerm_maps_account":                                       resourceArmMapsAccount(),
-		"azurerm_mariadb_configuration":                              resourceArmMariaDbConfiguration(),
-		"azurerm_mariadb_database":                                   resourceArmMariaDbDatabase(),
-		"azurerm_mariadb_firewall_rule":                              resourceArmMariaDBFirewallRule(),
-		"azurerm_mariadb_server":                                     resourceArmMariaDbServer(),
-		"azurerm_mariadb_virtual_network_rule":                       resourceArmMariaDbVirtualNetworkRule(),
-		"azurerm_marketplace_agreement":                              resourceArmMarketplaceAgreement(),
-		"azurerm_media_services_account":                             resourceArmMediaServicesAccount(),
-		"azurerm_metric_alertrule":                                   resourceArmMetricAlertRule(),
-		"azurerm_monitor_autoscale_setting":                          resourceArmMonitorAutoScaleSetting(),
-		"azurerm_monitor_action_group":                               resourceArmMonitorActionGroup(),
-		"azurerm_monitor_activity_log_alert":                         resourceArmMonitorActivityLogAlert(),
-		"azurerm_monitor_diagnostic_setting":                         resourceArmMonitorDiagnosticSetting(),
-		"azurerm_monitor_log_profile":                                resourceArmMonitorLogProfile(),
-		"azurerm_monitor_metric_alert":                               resourceArmMonitorMetricAlert(),
-		"azurerm_monitor_metric_alertrule":                           resourceArmMonitorMetricAlertRule(),
-		"azurerm_mssql_elasticpool":                                  resourceArmMsSqlElasticPool(),
-		"azurerm_mysql_configuration":                                resourceArmMySQLConfiguration(),
-		"azurerm_mysql_database":                                     resourceArmMySqlDatabase(),
-		"azurerm_mysql_firewall_rule":                                resourceArmMySqlFirewallRule(),
-		"azurerm_mysql_server":                                       resourceArmMySqlServer(),
-		"azurerm_mysql_virtual_network_rule":                         resourceArmMySqlVirtualNetworkRule(),
-		"azurerm_network_connection_monitor":                         resourceArmNetworkConnectionMonitor(),
-		"azurerm_network_ddos_protection_plan":                       resourceArmNetworkDDoSProtectionPlan(),
-		"azurerm_network_interface":                                  resourceArmNetworkInterface(),
-		"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
-		"azurerm_network_interface_application_security_group_association":               resourceArmNetworkInterfaceApplicationSecurityGroupAssociation(),
-		"azurerm_network_interface_backend_address_pool_association":                     resourceArmNetworkInterfaceBackendAddressPoolAssociation(),
-		"azurerm_network_interface_nat_rule_association":                                 resourceArmNetworkInterfaceNatRuleAssociation(),
-		"azurerm_network_packet_capture":                                                 resourceArmNetworkPacketCapture(),
-		"azurerm_network_profile":                                                        resourceArmNetworkProfile(),
-		"azurerm_network_security_group":                                                 resourceArmNetworkSecurityGroup(),
-		"azurerm_network_security_rule":                                                  resourceArmNetworkSecurityRule(),
-		"azurerm_network_watcher":                                                        resourceArmNetworkWatcher(),
-		"azurerm_notification_hub_authorization_rule":                                    resourceArmNotificationHubAuthorizationRule(),
-		"azurerm_notification_hub_namespace":                                             resourceArmNotificationHubNamespace(),
-		"azurerm_notification_hub":                                                       resourceArmNotificationHub(),
-		"azurerm_packet_capture":                                                         resourceArmPacketCapture(),
-		"azurerm_policy_assignment":                                                      resourceArmPolicyAssignment(),
-		"azurerm_policy_definition":                                                      resourceArmPolicyDefinition(),
-		"azurerm_policy_set_definition":                                                  resourceArmPolicySetDefinition(),
-		"azurerm_postgresql_configuration":                                               resourceArmPostgreSQLConfiguration(),
-		"azurerm_postgresql_database":                                                    resourceArmPostgreSQLDatabase(),
-		"azurerm_postgresql_firewall_rule":                                               resourceArmPostgreSQLFirewallRule(),
-		"azurerm_postgresql_server":                                                      resourceArmPostgreSQLServer(),
-		"azurerm_postgresql_virtual_network_rule":                                        resourceArmPostgreSQLVirtualNetworkRule(),
-		"azurerm_private_dns_zone":                                                       resourceArmPrivateDnsZone(),
-		"azurerm_private_dns_a_record":                                                   resourceArmPrivateDnsARecord(),
-		"azurerm_private_dns_cname_record":                                               resourceArmPrivateDnsCNameRecord(),
-		"azurerm_private_dns_zone_virtual_network_link":                                  resourceArmPrivateDnsZoneVirtualNetworkLink(),
-		"azurerm_proximity_placement_group":                                              resourceArmProximityPlacementGroup(),
-		"azurerm_public_ip":                                                              resourceArmPublicIp(),
-		"azurerm_public_ip_prefix":                                                       resourceArmPublicIpPrefix(),
-		"azurerm_recovery_network_mapping":                                               resourceArmRecoveryServicesNetworkMapping(),
-		"azurerm_recovery_replicated_vm":                                                 resourceArmRecoveryServicesReplicatedVm(),
-		"azurerm_recovery_services_fabric":                                               resourceArmRecoveryServicesFabric(),
-		"azurerm_recovery_services_protected_vm":                                         resourceArmRecoveryServicesProtectedVm(),
-		"azurerm_recovery_services_protection_container":                                 resourceArmRecoveryServicesProtectionContainer(),
-		"azurerm_recovery_services_protection_container_mapping":                         resourceArmRecoveryServicesProtectionContainerMapping(),
-		"azurerm_recovery_services_protection_policy_vm":                                 resourceArmRecoveryServicesProtectionPolicyVm(),
-		"azurerm_recovery_services_replication_policy":                                   resourceArmRecoveryServicesReplicationPolicy(),
-		"azurerm_recovery_services_vault":                                                resourceArmRecoveryServicesVault(),
-		"azurerm_redis_cache":                                                            resourceArmRedisCache(),
-		"azurerm_redis_firewall_rule":                                                    resourceArmRedisFirewallRule(),
-		"azurerm_relay_namespace":                                                        resourceArmRelayNamespace(),
-		"azurerm_resource_group":                                                         resourceArmResourceGroup(),
-		"azurerm_role_assignment":                                                        resourceArmRoleAssignment(),
-		"azurerm_role_definition":                                                        resourceArmRoleDefinition(),
-		"azurerm_route_table":                                                            resourceArmRouteTable(),
-		"azurerm_route":                                                                  resourceArmRoute(),
-		"azurerm_scheduler_job_collection":                                               resourceArmSchedulerJobCollection(),
-		"azurerm_scheduler_job":                                                          resourceArmSchedulerJob(),
-		"azurerm_search_service":                                                         resourceArmSearchService(),
-		"azurerm_security_center_contact":                                                resourceArmSecurityCenterContact(),
-		"azurerm_security_center_subscription_pricing":                                   resourceArmSecurityCenterSubscriptionPricing(),
-		"azurerm_security_center_workspace":                                              resourceArmSecurityCenterWorkspace(),
-		"azurerm_service_fabric_cluster":                                                 resourceArmServiceFabricCluster(),
-		"azurerm_servicebus_namespace_authorization_rule":                                resourceArmServiceBusNamespaceAuthorizationRule(),
-		"azurerm_servicebus_namespace":                                                   resourceArmServiceBusNamespace(),
-		"azurerm_servicebus_queue_authorization_rule":                                    resourceArmServiceBusQueueAuthorizationRule(),
-		"azurerm_servicebus_queue":                                                       resourceArmServiceBusQueue(),
-		"azurerm_servicebus_subscription_rule":                                           resourceArmServiceBusSubscriptionRule(),
-		"azurerm_servicebus_subscription":                                                resourceArmServiceBusSubscription(),
-		"azurerm_servicebus_topic_authorization_rule":                                    resourceArmServiceBusTopicAuthorizationRule(),
-		"azurerm_servicebus_topic":                                                       resourceArmServiceBusTopic(),
-		"azurerm_shared_image_gallery":                                                   resourceArmSharedImageGallery(),
-		"azurerm_shared_image_version":                                                   resourceArmSharedImageVersion(),
-		"azurerm_shared_image":                                                           resourceArmSharedImage(),
-		"azurerm_signalr_service":                                                        resourceArmSignalRService(),
-		"azurerm_snapshot":                                                               resourceArmSnapshot(),
-		"azurerm_sql_active_directory_administrator":                                     resourceArmSqlAdministrator(),
-		"azurerm_sql_database":                                                           resourceArmSqlDatabase(),
-		"azurerm_sql_elasticpool":                                                        resourceArmSqlElasticPool(),
-		"azurerm_sql_failover_group":                                                     resourceArmSqlFailoverGroup(),
-		"azurerm_sql_firewall_rule":                                                      resourceArmSqlFirewallRule(),
-		"azurerm_sql_server":                                                             resourceArmSqlServer(),
-		"azurerm_sql_virtual_network_rule":                                               resourceArmSqlVirtualNetworkRule(),
-		"azurerm_storage_account":                                                        resourceArmStorageAccount(),
-		"azurerm_storage_blob":                                                           resourceArmStorageBlob(),
-		"azurerm_storage_container":                                                      resourceArmStorageContainer(),
-		"azurerm_storage_queue":                                                          resourceArmStorageQueue(),
-		"azurerm_storage_share":                                                          resourceArmStorageShare(),
-		"azurerm_storage_share_directory":                                                resourceArmStorageShareDirectory(),
-		"azurerm_storage_table":                                                          resourceArmStorageTable(),
-		"azurerm_storage_table_entity":                                                   resourceArmStorageTableEntity(),
-		"azurerm_stream_analytics_job":                                                   resourceArmStreamAnalyticsJob(),
-		"azurerm_stream_analytics_function_javascript_udf":                               resourceArmStreamAnalyticsFunctionUDF(),
-		"azurerm_stream_analytics_output_blob":                                           resourceArmStreamAnalyticsOutputBlob(),
-		"azurerm_stream_analytics_output_mssql":                                          resourceArmStreamAnalyticsOutputSql(),
-		"azurerm_stream_analytics_output_eventhub":                                       resourceArmStreamAnalyticsOutputEventHub(),
-		"azurerm_stream_analytics_output_servicebus_queue":                               resourceArmStreamAnalyticsOutputServiceBusQueue(),
-		"azurerm_stream_analytics_output_servicebus_topic":                               resourceArmStreamAnalyticsOutputServiceBusTopic(),
-		"azurerm_stream_analytics_stream_input_blob":                                     resourceArmStreamAnalyticsStreamInputBlob(),
-		"azurerm_stream_analytics_stream_input_eventhub":                                 resourceArmStreamAnalyticsStreamInputEventHub(),
-		"azurerm_stream_analytics_stream_input_iothub":                                   resourceArmStreamAnalyticsStreamInputIoTHub(),
-		"azurerm_subnet_network_security_group_association":                              resourceArmSubnetNetworkSecurityGroupAssociation(),
-		"azurerm_subnet_route_table_association":                                         resourceArmSubnetRouteTableAssociation(),
-		"azurerm_subnet":                                                                 resourceArmSubnet(),
-		"azurerm_template_deployment":                                                    resourceArmTemplateDeployment(),
-		"azurerm_traffic_manager_endpoint":                                               resourceArmTrafficManagerEndpoint(),
-		"azurerm_traffic_manager_profile":                                                resourceArmTrafficManagerProfile(),
-		"azurerm_user_assigned_identity":                                                 resourceArmUserAssignedIdentity(),
-		"azurerm_virtual_machine_data_disk_attachment":                                   resourceArmVirtualMachineDataDiskAttachment(),
-		"azurerm_virtual_machine_extension":                                              resourceArmVirtualMachineExtensions(),
-		"azurerm_virtual_machine_scale_set":                                              resourceArmVirtualMachineScaleSet(),
-		"azurerm_virtual_machine":                                                        resourceArmVirtualMachine(),
-		"azurerm_virtual_network_gateway_connection":                                     resourceArmVirtualNetworkGatewayConnection(),
-		"azurerm_virtual_network_gateway":                                                resourceArmVirtualNetworkGateway(),
-		"azurerm_virtual_network_peering":                                                resourceArmVirtualNetworkPeering(),
-		"azurerm_virtual_network":                                                        resourceArmVirtualNetwork(),
-		"azurerm_virtual_wan":                                                            resourceArmVirtualWan(),
-		"azurerm_web_application_firewall_policy":                                        resourceArmWebApplicationFirewallPolicy(),
-	}
+	dataSources := make(map[string]*schema.Resource)
+	resources := make(map[string]*schema.Resource)
 
 	// avoids this showing up in test output
 	var debugLog = func(f string, v ...interface{}) {
@@ -474,6 +187,39 @@ func Provider() terraform.ResourceProvider {
 		}
 	}
 
+	// Resources which haven't defined their own Timeouts fall back to the defaults - which are enough
+	// for most resources, long-running resources (e.g. Virtual Machines) define their own Timeouts
+	for _, v := range resources {
+		if v.Timeouts != nil {
+			continue
+		}
+
+		v.Timeouts = &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(30 * time.Minute),
+			Update:  schema.DefaultTimeout(30 * time.Minute),
+			Delete:  schema.DefaultTimeout(30 * time.Minute),
+			Default: schema.DefaultTimeout(30 * time.Minute),
+
+			// Read is the exception, since if this takes more than 5 minutes something's seriously wrong
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		}
+	}
+
+	// Resources which support Tags have the Default Tags from the Provider block merged in
+	for _, v := range resources {
+		tags.WithDefaults(v, defaultTagsFromMeta)
+	}
+
+	for _, v := range dataSources {
+		if v.Timeouts != nil {
+			continue
+		}
+
+		v.Timeouts = &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		}
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -550,6 +296,27 @@ func Provider() terraform.ResourceProvider {
 				Description: "The path to a custom endpoint for Managed Service Identity - in most circumstances this should be detected automatically. ",
 			},
 
+			// OpenID Connect specific fields
+			"use_oidc": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_OIDC", false),
+				Description: "Allow OpenID Connect to be used for Authentication.",
+			},
+			"oidc_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_TOKEN", ""),
+				Description: "The OIDC Token which should be used. For use When authenticating as a Service Principal using OpenID Connect.",
+			},
+			"oidc_token_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_TOKEN_FILE_PATH", ""),
+				Description: "The path to a file containing an OIDC Token which should be used. For use When authenticating as a Service Principal using OpenID Connect.",
+			},
+
 			// Managed Tracking GUID for User-agent
 			"partner_id": {
 				Type:         schema.TypeString,
@@ -566,6 +333,21 @@ func Provider() terraform.ResourceProvider {
 				Description: "This will disable the x-ms-correlation-request-id header.",
 			},
 
+			"storage_use_azuread": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
+				Description: "Should the AzureRM Provider use AzureAD to access the Storage Data Plane API's?",
+			},
+
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of requests which can be sent to Azure at once. Defaults to `0`, meaning requests aren't limited.",
+			},
+
 			// Advanced feature flags
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
@@ -580,6 +362,10 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 				Description: "Should the AzureRM Provider skip registering all of the Resource Providers that it supports, if they're not already registered?",
 			},
+
+			"features": features.Schema(),
+
+			"default_tags": tags.DefaultsSchema(),
 		},
 
 		DataSourcesMap: dataSources,
@@ -606,51 +392,83 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			return nil, fmt.Errorf("The provider onlt supports 3 auxiliary tenant IDs")
 		}
 
-		builder := &authentication.Builder{
-			SubscriptionID:     d.Get("subscription_id").(string),
-			ClientID:           d.Get("client_id").(string),
-			ClientSecret:       d.Get("client_secret").(string),
-			TenantID:           d.Get("tenant_id").(string),
-			AuxiliaryTenantIDs: auxTenants,
-			Environment:        d.Get("environment").(string),
-			MsiEndpoint:        d.Get("msi_endpoint").(string),
-			ClientCertPassword: d.Get("client_certificate_password").(string),
-			ClientCertPath:     d.Get("client_certificate_path").(string),
-
-			// Feature Toggles
-			SupportsClientCertAuth:         true,
-			SupportsClientSecretAuth:       true,
-			SupportsManagedServiceIdentity: d.Get("use_msi").(bool),
-			SupportsAzureCliToken:          true,
-			SupportsAuxiliaryTenants:       len(auxTenants) > 0,
-
-			// Doc Links
-			ClientSecretDocsLink: "https://www.terraform.io/docs/providers/azurerm/auth/service_principal_client_secret.html",
-		}
+		var config *authentication.Config
+		var oidcConfig *oidc.Config
+		if d.Get("use_oidc").(bool) {
+			if len(auxTenants) > 0 {
+				return nil, fmt.Errorf("Auxiliary Tenants are not supported when authenticating using OpenID Connect")
+			}
 
-		config, err := builder.Build()
-		if err != nil {
-			return nil, fmt.Errorf("Error building AzureRM Client: %s", err)
+			oidcConfig = &oidc.Config{
+				ClientID:       d.Get("client_id").(string),
+				Environment:    d.Get("environment").(string),
+				SubscriptionID: d.Get("subscription_id").(string),
+				TenantID:       d.Get("tenant_id").(string),
+				Token:          d.Get("oidc_token").(string),
+				TokenFilePath:  d.Get("oidc_token_file_path").(string),
+			}
+			if err := oidcConfig.Validate(); err != nil {
+				return nil, fmt.Errorf("Error validating the OpenID Connect configuration: %s", err)
+			}
+
+			config = oidcConfig.AuthConfig()
+		} else {
+			builder := &authentication.Builder{
+				SubscriptionID:     d.Get("subscription_id").(string),
+				ClientID:           d.Get("client_id").(string),
+				ClientSecret:       d.Get("client_secret").(string),
+				TenantID:           d.Get("tenant_id").(string),
+				AuxiliaryTenantIDs: auxTenants,
+				Environment:        d.Get("environment").(string),
+				MsiEndpoint:        d.Get("msi_endpoint").(string),
+				ClientCertPassword: d.Get("client_certificate_password").(string),
+				ClientCertPath:     d.Get("client_certificate_path").(string),
+
+				// Feature Toggles
+				SupportsClientCertAuth:         true,
+				SupportsClientSecretAuth:       true,
+				SupportsManagedServiceIdentity: d.Get("use_msi").(bool),
+				SupportsAzureCliToken:          true,
+				SupportsAuxiliaryTenants:       len(auxTenants) > 0,
+
+				// Doc Links
+				ClientSecretDocsLink: "https://www.terraform.io/docs/providers/azurerm/auth/service_principal_client_secret.html",
+			}
+
+			var err error
+			config, err = builder.Build()
+			if err != nil {
+				return nil, fmt.Errorf("Error building AzureRM Client: %s", err)
+			}
 		}
 
 		partnerId := d.Get("partner_id").(string)
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
 		disableCorrelationRequestID := d.Get("disable_correlation_request_id").(bool)
-
-		client, err := getArmClient(config, skipProviderRegistration, partnerId, disableCorrelationRequestID)
+		maxConcurrentRequests := d.Get("max_concurrent_requests").(int)
+		storageUseAzureAD := d.Get("storage_use_azuread").(bool)
+
+		client, err := clients.Build(clients.ClientBuilder{
+			AuthConfig:                  config,
+			DisableCorrelationRequestID: disableCorrelationRequestID,
+			MaxConcurrentRequests:       maxConcurrentRequests,
+			OIDC:                        oidcConfig,
+			PartnerId:                   partnerId,
+			SkipProviderRegistration:    skipProviderRegistration,
+			StorageUseAzureAD:           storageUseAzureAD,
+		})
 		if err != nil {
 			return nil, err
 		}
 
-		// TODO: clean this up when ArmClient is removed
+		client.Features = features.Expand(d.Get("features").([]interface{}))
+		client.DefaultTags = tags.ExpandDefaults(d.Get("default_tags").([]interface{}))
+
 		client.StopContext = p.StopContext()
-		client.Client.StopContext = p.StopContext()
 
 		// replaces the context between tests
 		p.MetaReset = func() error {
-			// TODO: remove the old reference here
 			client.StopContext = p.StopContext()
-			client.Client.StopContext = p.StopContext()
 			return nil
 		}
 
@@ -659,7 +477,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			// List all the available providers and their registration state to avoid unnecessary
 			// requests. This also lets us check if the provider credentials are correct.
 			ctx := client.StopContext
-			providerList, err := client.resource.ProvidersClient.List(ctx, nil, "")
+			providerList, err := client.Resource.ProvidersClient.List(ctx, nil, "")
 			if err != nil {
 				return nil, fmt.Errorf("Unable to list provider registration status, it is possible that this is due to invalid "+
 					"credentials or the service principal does not have permission to use the Resource Manager API, Azure "+
@@ -670,7 +488,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 				availableResourceProviders := providerList.Values()
 				requiredResourceProviders := requiredResourceProviders()
 
-				err := ensureResourceProvidersAreRegistered(ctx, *client.resource.ProvidersClient, availableResourceProviders, requiredResourceProviders)
+				err := ensureResourceProvidersAreRegistered(ctx, *client.Resource.ProvidersClient, availableResourceProviders, requiredResourceProviders)
 				if err != nil {
 					return nil, fmt.Errorf("Error ensuring Resource Providers are registered: %s", err)
 				}
@@ -680,3 +498,13 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 		return client, nil
 	}
 }
+
+// defaultTagsFromMeta returns the Default Tags configured in the Provider block
+func defaultTagsFromMeta(meta interface{}) map[string]interface{} {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil {
+		return nil
+	}
+
+	return client.DefaultTags
+}
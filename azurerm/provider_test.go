@@ -3,26 +3,17 @@ package azurerm
 import (
 	"fmt"
 	"os"
-	"regexp"
 	"testing"
 
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/hashicorp/terraform/terraform"
-	"github.com/terraform-providers/terraform-provider-azuread/azuread"
 )
 
-var testAccProviders map[string]terraform.ResourceProvider
 var testAccProvider *schema.Provider
 
 func init() {
 	testAccProvider = Provider().(*schema.Provider)
-	testAccProviders = map[string]terraform.ResourceProvider{
-		"azurerm": testAccProvider,
-		"azuread": azuread.Provider().(*schema.Provider),
-	}
 }
 
 func TestProvider(t *testing.T) {
@@ -35,37 +26,6 @@ func TestProvider_impl(t *testing.T) {
 	var _ = Provider()
 }
 
-func testAccPreCheck(t *testing.T) {
-	variables := []string{
-		"ARM_CLIENT_ID",
-		"ARM_CLIENT_SECRET",
-		"ARM_SUBSCRIPTION_ID",
-		"ARM_TENANT_ID",
-		"ARM_TEST_LOCATION",
-		"ARM_TEST_LOCATION_ALT",
-		"ARM_TEST_LOCATION_ALT2",
-	}
-
-	for _, variable := range variables {
-		value := os.Getenv(variable)
-		if value == "" {
-			t.Fatalf("`%s` must be set for acceptance tests!", variable)
-		}
-	}
-}
-
-func testLocation() string {
-	return os.Getenv("ARM_TEST_LOCATION")
-}
-
-func testAltLocation() string {
-	return os.Getenv("ARM_TEST_LOCATION_ALT")
-}
-
-func testAltLocation2() string {
-	return os.Getenv("ARM_TEST_LOCATION_ALT2")
-}
-
 func testArmEnvironmentName() string {
 	envName, exists := os.LookupEnv("ARM_ENVIRONMENT")
 	if !exists {
@@ -75,11 +35,6 @@ func testArmEnvironmentName() string {
 	return envName
 }
 
-func testArmEnvironment() (*azure.Environment, error) {
-	envName := testArmEnvironmentName()
-	return authentication.DetermineEnvironment(envName)
-}
-
 func testGetAzureConfig(t *testing.T) *authentication.Config {
 	if os.Getenv(resource.TestEnvVar) == "" {
 		t.Skip(fmt.Sprintf("Integration test skipped unless env '%s' set", resource.TestEnvVar))
@@ -106,8 +61,3 @@ func testGetAzureConfig(t *testing.T) *authentication.Config {
 
 	return config
 }
-
-func testRequiresImportError(resourceName string) *regexp.Regexp {
-	message := "to be managed via Terraform this resource needs to be imported into the State. Please see the resource documentation for %q for more information."
-	return regexp.MustCompile(fmt.Sprintf(message, resourceName))
-}
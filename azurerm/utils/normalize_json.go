@@ -0,0 +1,21 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NormalizeJson returns the JSON string in a consistent format, so that it can be compared
+// against the value returned from the API without whitespace/ordering causing a diff
+func NormalizeJson(jsonString interface{}) string {
+	if jsonString == nil || jsonString == "" {
+		return ""
+	}
+	var j interface{}
+
+	if err := json.Unmarshal([]byte(jsonString.(string)), &j); err != nil {
+		return fmt.Sprintf("Error parsing JSON: %+v", err)
+	}
+	b, _ := json.Marshal(j)
+	return string(b[:])
+}
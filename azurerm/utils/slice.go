@@ -0,0 +1,12 @@
+package utils
+
+// SliceContainsValue returns whether the specified value exists within the slice
+func SliceContainsValue(input []string, value string) bool {
+	for _, v := range input {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
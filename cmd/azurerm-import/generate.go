@@ -0,0 +1,304 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl2/hcl/hclsyntax"
+	"github.com/hashicorp/hcl2/hclwrite"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// generated contains the Terraform Configuration and Import Commands for a set of resources
+type generated struct {
+	// Configuration is the Terraform Configuration (HCL) for the resources
+	Configuration []byte
+
+	// ImportCommands is a shell script containing a `terraform import` command for each resource
+	ImportCommands []byte
+
+	// Count is the number of resources which were generated
+	Count int
+
+	// Warnings contains information about the resources which couldn't be generated
+	Warnings []string
+}
+
+// generate returns the Terraform Configuration and Import Commands for the specified resources,
+// skipping any resources whose Resource Type isn't supported by the Provider
+func generate(registry resourceRegistry, resources []armResource) generated {
+	output := generated{
+		Warnings: make([]string, 0),
+	}
+
+	file := hclwrite.NewEmptyFile()
+	commands := bytes.NewBufferString("#!/bin/sh\nset -e\n\n")
+	labels := make(map[string]int)
+
+	for _, resource := range resources {
+		resourceName, resourceSchema, ok := registry.resourceForType(resource.Type)
+		if !ok {
+			output.Warnings = append(output.Warnings, fmt.Sprintf("Skipping %q: the Resource Type %q isn't supported", resource.ID, resource.Type))
+			continue
+		}
+
+		label := uniqueLabel(labels, resourceName, resource.Name())
+
+		if output.Count > 0 {
+			file.Body().AppendNewline()
+		}
+		block := file.Body().AppendNewBlock("resource", []string{resourceName, label})
+		populateBlock(block.Body(), resourceSchema, resource)
+
+		commands.WriteString(fmt.Sprintf("terraform import %s.%s '%s'\n", resourceName, label, resource.ID))
+		output.Count++
+	}
+
+	output.Configuration = hclwrite.Format(file.Bytes())
+	output.ImportCommands = commands.Bytes()
+	return output
+}
+
+// populateBlock sets the attributes within the block from the resource, using the shape of
+// the Resource ID for the names of the parent resources and the ARM Properties for any
+// other top-level attributes. Any Required attributes which can't be determined are added
+// as a comment, so that they can be populated by hand.
+func populateBlock(body *hclwrite.Body, resourceSchema *schema.Resource, resource armResource) {
+	missing := make([]string, 0)
+
+	for _, key := range orderedFields(resourceSchema.Schema) {
+		field := resourceSchema.Schema[key]
+		if !field.Optional && !field.Required {
+			continue
+		}
+
+		value, ok := valueForField(key, field, resource)
+		if !ok {
+			if field.Required {
+				missing = append(missing, key)
+			}
+			continue
+		}
+
+		body.SetAttributeValue(key, value)
+	}
+
+	for _, key := range missing {
+		body.AppendUnstructuredTokens(hclwrite.Tokens{
+			{
+				Type:  hclsyntax.TokenComment,
+				Bytes: []byte(fmt.Sprintf("# TODO: %q is Required but couldn't be determined from the ARM Resource\n", key)),
+			},
+		})
+	}
+}
+
+// orderedFields returns the keys in the Schema in the order they should be output, which
+// matches the conventions used in the Provider's documentation
+func orderedFields(input map[string]*schema.Schema) []string {
+	first := []string{"name", "resource_group_name", "location"}
+	last := []string{"tags"}
+
+	output := make([]string, 0, len(input))
+	for _, key := range first {
+		if _, ok := input[key]; ok {
+			output = append(output, key)
+		}
+	}
+
+	remaining := make([]string, 0)
+	for key := range input {
+		if !containsString(first, key) && !containsString(last, key) {
+			remaining = append(remaining, key)
+		}
+	}
+	sort.Strings(remaining)
+	output = append(output, remaining...)
+
+	for _, key := range last {
+		if _, ok := input[key]; ok {
+			output = append(output, key)
+		}
+	}
+
+	return output
+}
+
+func valueForField(key string, field *schema.Schema, resource armResource) (cty.Value, bool) {
+	switch key {
+	case "name":
+		return cty.StringVal(resource.Name()), true
+
+	case "resource_group_name":
+		return cty.StringVal(resource.ResourceGroupName), true
+
+	case "location":
+		if resource.Location == "" {
+			return cty.NilVal, false
+		}
+		return cty.StringVal(strings.ToLower(strings.Replace(resource.Location, " ", "", -1))), true
+
+	case "tags":
+		if len(resource.Tags) == 0 {
+			return cty.NilVal, false
+		}
+		tags := make(map[string]cty.Value, len(resource.Tags))
+		for k, v := range resource.Tags {
+			tags[k] = cty.StringVal(v)
+		}
+		return cty.MapVal(tags), true
+	}
+
+	// the names and ID's of the parent resources can be determined from the Resource ID, for example
+	// `virtual_network_name` for a Subnet or `loadbalancer_id` for a Load Balancer Rule
+	for i, segment := range resource.Segments[:maxInt(len(resource.Segments)-1, 0)] {
+		parent := strings.Replace(singularize(toSnakeCase(segment.Type)), "_", "", -1)
+		switch strings.Replace(key, "_", "", -1) {
+		case parent + "name":
+			return cty.StringVal(segment.Name), true
+		case parent + "id":
+			return cty.StringVal(parentID(resource, i)), true
+		}
+	}
+
+	for k, v := range resource.Properties {
+		if !strings.EqualFold(k, strings.Replace(key, "_", "", -1)) {
+			continue
+		}
+
+		return convertValue(field, v)
+	}
+
+	return cty.NilVal, false
+}
+
+// parentID returns the Resource ID for the parent resource at the specified segment
+func parentID(resource armResource, segment int) string {
+	// each segment is made up of 2 components, e.g. `virtualNetworks/example`
+	components := strings.Split(strings.Trim(resource.ID, "/"), "/")
+	remaining := (len(resource.Segments) - segment - 1) * 2
+	return "/" + strings.Join(components[:len(components)-remaining], "/")
+}
+
+// convertValue converts the value of an ARM Property into the type used in the Schema - only
+// primitive values (and lists/maps of these) are supported, nested blocks need to be populated
+// by hand since the shape of these differs between the ARM API and the Provider
+func convertValue(field *schema.Schema, input interface{}) (cty.Value, bool) {
+	switch field.Type {
+	case schema.TypeString, schema.TypeInt, schema.TypeFloat, schema.TypeBool:
+		return convertPrimitive(field.Type, input)
+
+	case schema.TypeList, schema.TypeSet:
+		elem, ok := field.Elem.(*schema.Schema)
+		if !ok {
+			return cty.NilVal, false
+		}
+
+		items, ok := input.([]interface{})
+		if !ok {
+			return cty.NilVal, false
+		}
+
+		values := make([]cty.Value, 0, len(items))
+		for _, item := range items {
+			value, ok := convertPrimitive(elem.Type, item)
+			if !ok {
+				return cty.NilVal, false
+			}
+			values = append(values, value)
+		}
+		return cty.TupleVal(values), true
+
+	case schema.TypeMap:
+		items, ok := input.(map[string]interface{})
+		if !ok {
+			return cty.NilVal, false
+		}
+
+		values := make(map[string]cty.Value, len(items))
+		for k, v := range items {
+			value, ok := convertPrimitive(schema.TypeString, v)
+			if !ok {
+				return cty.NilVal, false
+			}
+			values[k] = value
+		}
+		return cty.ObjectVal(values), true
+	}
+
+	return cty.NilVal, false
+}
+
+func convertPrimitive(valueType schema.ValueType, input interface{}) (cty.Value, bool) {
+	switch valueType {
+	case schema.TypeString:
+		if v, ok := input.(string); ok {
+			return cty.StringVal(v), true
+		}
+
+	case schema.TypeInt, schema.TypeFloat:
+		if v, ok := input.(float64); ok {
+			return cty.NumberFloatVal(v), true
+		}
+
+	case schema.TypeBool:
+		if v, ok := input.(bool); ok {
+			return cty.BoolVal(v), true
+		}
+	}
+
+	return cty.NilVal, false
+}
+
+// uniqueLabel returns a unique label for the resource within Terraform, based on the name of the resource
+func uniqueLabel(existing map[string]int, resourceType, name string) string {
+	label := sanitizeLabel(name)
+
+	key := resourceType + "." + label
+	existing[key]++
+	if count := existing[key]; count > 1 {
+		return fmt.Sprintf("%s_%d", label, count)
+	}
+
+	return label
+}
+
+// sanitizeLabel converts the name of a resource into a valid identifier for Terraform
+func sanitizeLabel(name string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_' || r == '-' {
+			sb.WriteRune(r)
+			continue
+		}
+
+		sb.WriteRune('_')
+	}
+
+	label := strings.Trim(sb.String(), "_-")
+	if label == "" || label[0] >= '0' && label[0] <= '9' || label[0] == '-' {
+		label = "resource_" + label
+	}
+	return label
+}
+
+func containsString(input []string, value string) bool {
+	for _, v := range input {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+// azurerm-import generates Terraform Configuration (HCL) and the associated `terraform import`
+// commands for a set of existing Azure Resources, using a JSON document describing these
+// resources, for example:
+//
+//   go run ./cmd/azurerm-import -input=./resources.json -hcl=./imported.tf -imports=./import.sh
+//
+// The input can either be an exported ARM Template (e.g. from `az group export`) or a list of
+// resources (e.g. from `az resource list`) - this works entirely offline, the Azure Resource
+// Types are mapped to the Resources supported by the Provider using it's Resource Registry.
+//
+// Since ARM Templates don't contain the Resource ID of each resource, the Subscription ID and
+// Resource Group Name need to be specified via `-subscription-id` and `-resource-group` when
+// using an ARM Template as the input.
+
+func main() {
+	input := flag.String("input", "", "The path to the JSON file containing the ARM Template or list of Resources")
+	hclPath := flag.String("hcl", "imported.tf", "The path where the generated Terraform Configuration should be written")
+	importsPath := flag.String("imports", "import.sh", "The path where the generated `terraform import` commands should be written")
+	subscriptionId := flag.String("subscription-id", "", "The ID of the Subscription containing the resources, required when the input is an ARM Template")
+	resourceGroup := flag.String("resource-group", "", "The name of the Resource Group containing the resources, required when the input is an ARM Template")
+	flag.Parse()
+
+	if *input == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	opts := options{
+		InputPath:         *input,
+		HclPath:           *hclPath,
+		ImportsPath:       *importsPath,
+		SubscriptionId:    *subscriptionId,
+		ResourceGroupName: *resourceGroup,
+	}
+	if err := run(opts); err != nil {
+		fmt.Fprintf(os.Stderr, "Error generating Terraform Configuration from %q: %+v\n", *input, err)
+		os.Exit(1)
+	}
+}
+
+type options struct {
+	InputPath         string
+	HclPath           string
+	ImportsPath       string
+	SubscriptionId    string
+	ResourceGroupName string
+}
+
+func run(opts options) error {
+	contents, err := ioutil.ReadFile(opts.InputPath)
+	if err != nil {
+		return fmt.Errorf("reading %q: %+v", opts.InputPath, err)
+	}
+
+	resources, err := parseResources(contents, opts.SubscriptionId, opts.ResourceGroupName)
+	if err != nil {
+		return err
+	}
+
+	registry := newResourceRegistry(providerResources())
+	output := generate(registry, resources)
+
+	for _, warning := range output.Warnings {
+		fmt.Fprintf(os.Stderr, "[WARN] %s\n", warning)
+	}
+
+	if err := ioutil.WriteFile(opts.HclPath, output.Configuration, 0644); err != nil {
+		return fmt.Errorf("writing Terraform Configuration to %q: %+v", opts.HclPath, err)
+	}
+
+	if err := ioutil.WriteFile(opts.ImportsPath, output.ImportCommands, 0755); err != nil {
+		return fmt.Errorf("writing Import Commands to %q: %+v", opts.ImportsPath, err)
+	}
+
+	fmt.Printf("Generated %d resources into %q - run %q to import them into the State\n", output.Count, opts.HclPath, opts.ImportsPath)
+	return nil
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+const testSubscriptionId = "00000000-0000-0000-0000-000000000000"
+
+func TestGenerate_Template(t *testing.T) {
+	contents, err := ioutil.ReadFile("testdata/template.json")
+	if err != nil {
+		t.Fatalf("Error reading template: %+v", err)
+	}
+
+	resources, err := parseResources(contents, testSubscriptionId, "example-resources")
+	if err != nil {
+		t.Fatalf("Error parsing resources: %+v", err)
+	}
+	if len(resources) != 4 {
+		t.Fatalf("Expected 4 resources but got %d", len(resources))
+	}
+
+	output := generate(newResourceRegistry(providerResources()), resources)
+	if output.Count != 3 {
+		t.Fatalf("Expected 3 resources to be generated but got %d", output.Count)
+	}
+	if len(output.Warnings) != 1 || !strings.Contains(output.Warnings[0], "Microsoft.Unsupported/widgets") {
+		t.Fatalf("Expected a warning for the unsupported resource but got %+v", output.Warnings)
+	}
+
+	configuration := string(output.Configuration)
+	for _, expected := range []string{
+		`resource "azurerm_network_security_group" "example-nsg" {`,
+		`resource "azurerm_virtual_network" "example-network" {`,
+		`resource "azurerm_subnet" "internal" {`,
+		`  location            = "westeurope"`,
+		`  virtual_network_name = "example-network"`,
+		`  address_prefix       = "10.0.1.0/24"`,
+		`  tags                = { environment = "production" }`,
+		`# TODO: "address_space" is Required`,
+	} {
+		if !strings.Contains(configuration, expected) {
+			t.Fatalf("Expected the Configuration to contain %q:\n\n%s", expected, configuration)
+		}
+	}
+
+	commands := string(output.ImportCommands)
+	expected := "terraform import azurerm_subnet.internal '/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Network/virtualNetworks/example-network/subnets/internal'"
+	if !strings.Contains(commands, expected) {
+		t.Fatalf("Expected the Import Commands to contain %q:\n\n%s", expected, commands)
+	}
+}
+
+func TestGenerate_ResourceList(t *testing.T) {
+	input := `[
+  {
+    "id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources",
+    "location": "westeurope"
+  },
+  {
+    "id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Network/loadBalancers/example-lb/probes/http",
+    "properties": {
+      "port": 80,
+      "protocol": "Http",
+      "requestPath": "/health"
+    }
+  }
+]`
+	resources, err := parseResources([]byte(input), "", "")
+	if err != nil {
+		t.Fatalf("Error parsing resources: %+v", err)
+	}
+
+	output := generate(newResourceRegistry(providerResources()), resources)
+	if output.Count != 2 {
+		t.Fatalf("Expected 2 resources to be generated but got %d: %+v", output.Count, output.Warnings)
+	}
+
+	configuration := string(output.Configuration)
+	for _, expected := range []string{
+		`resource "azurerm_resource_group" "example-resources" {`,
+		`resource "azurerm_lb_probe" "http" {`,
+		`  loadbalancer_id     = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Network/loadBalancers/example-lb"`,
+		`  port                = 80`,
+		`  request_path        = "/health"`,
+	} {
+		if !strings.Contains(configuration, expected) {
+			t.Fatalf("Expected the Configuration to contain %q:\n\n%s", expected, configuration)
+		}
+	}
+}
+
+func TestResourceTypeOverrides(t *testing.T) {
+	resources := providerResources()
+
+	for resourceType, name := range resourceTypeOverrides {
+		resource, ok := resources[name]
+		if !ok {
+			t.Fatalf("The Resource %q (for %q) isn't registered in the Provider", name, resourceType)
+		}
+		if resource.Importer == nil {
+			t.Fatalf("The Resource %q (for %q) doesn't support import", name, resourceType)
+		}
+	}
+}
+
+func TestCandidateNames(t *testing.T) {
+	testData := []struct {
+		resourceType string
+		expected     string
+	}{
+		{
+			resourceType: "Microsoft.Network/virtualNetworks",
+			expected:     "azurerm_virtual_network",
+		},
+		{
+			resourceType: "Microsoft.Network/virtualNetworks/virtualNetworkPeerings",
+			expected:     "azurerm_virtual_network_peering",
+		},
+		{
+			resourceType: "Microsoft.KeyVault/vaults",
+			expected:     "azurerm_key_vault",
+		},
+		{
+			resourceType: "Microsoft.Sql/servers/databases",
+			expected:     "azurerm_sql_database",
+		},
+		{
+			resourceType: "Microsoft.Network/publicIPAddresses",
+			expected:     "azurerm_public_ip",
+		},
+	}
+
+	registry := newResourceRegistry(providerResources())
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.resourceType)
+
+		actual, _, ok := registry.resourceForType(v.resourceType)
+		if !ok {
+			t.Fatalf("Expected %q to be supported but it wasn't", v.resourceType)
+		}
+		if actual != v.expected {
+			t.Fatalf("Expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestToSnakeCase(t *testing.T) {
+	testData := map[string]string{
+		"virtualNetworks":   "virtual_networks",
+		"publicIPAddresses": "public_ip_addresses",
+		"KeyVault":          "key_vault",
+		"DBforPostgreSQL":   "d_bfor_postgre_sql",
+	}
+
+	for input, expected := range testData {
+		if actual := toSnakeCase(input); actual != expected {
+			t.Fatalf("Expected %q to be %q but got %q", input, expected, actual)
+		}
+	}
+}
+
+func TestParseResourceID(t *testing.T) {
+	resource, err := parseResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1")
+	if err != nil {
+		t.Fatalf("Error parsing Resource ID: %+v", err)
+	}
+
+	if resource.Type != "Microsoft.Network/virtualNetworks/subnets" {
+		t.Fatalf("Expected the Type to be %q but got %q", "Microsoft.Network/virtualNetworks/subnets", resource.Type)
+	}
+	if resource.ResourceGroupName != "group1" {
+		t.Fatalf("Expected the Resource Group to be %q but got %q", "group1", resource.ResourceGroupName)
+	}
+	if resource.Name() != "subnet1" {
+		t.Fatalf("Expected the Name to be %q but got %q", "subnet1", resource.Name())
+	}
+
+	for _, id := range []string{
+		"",
+		"/subscriptions/00000000-0000-0000-0000-000000000000",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks",
+	} {
+		if _, err := parseResourceID(id); err == nil {
+			t.Fatalf("Expected an error parsing %q but didn't get one", id)
+		}
+	}
+}
+
+func TestTemplateEvaluate(t *testing.T) {
+	ctx := templateContext{
+		Parameters: map[string]interface{}{
+			"name": "example",
+		},
+		Variables: map[string]interface{}{
+			"suffix": "[concat('-', parameters('name'))]",
+		},
+		SubscriptionId:    testSubscriptionId,
+		ResourceGroupName: "group1",
+	}
+
+	testData := []struct {
+		input    string
+		expected string
+		error    bool
+	}{
+		{
+			input:    "literal",
+			expected: "literal",
+		},
+		{
+			input:    "[[escaped]",
+			expected: "[escaped]",
+		},
+		{
+			input:    "[parameters('name')]",
+			expected: "example",
+		},
+		{
+			input:    "[concat(parameters('name'), variables('suffix'), '/default')]",
+			expected: "example-example/default",
+		},
+		{
+			input:    "[toLower('It''s')]",
+			expected: "it's",
+		},
+		{
+			input:    "[resourceId('Microsoft.Network/virtualNetworks', parameters('name'))]",
+			expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/example",
+		},
+		{
+			input: "[resourceGroup().location]",
+			error: true,
+		},
+		{
+			input: "[parameters('missing')]",
+			error: true,
+		},
+		{
+			input: "[concat('unterminated)]",
+			error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		actual, err := ctx.evaluate(v.input)
+		if v.error {
+			if err == nil {
+				t.Fatalf("Expected an error but got %q", actual)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if actual != v.expected {
+			t.Fatalf("Expected %q but got %q", v.expected, actual)
+		}
+	}
+}
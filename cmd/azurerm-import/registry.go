@@ -0,0 +1,161 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm"
+)
+
+// resourceTypeOverrides contains the ARM Resource Types where the name of the Terraform Resource
+// can't be determined from the shape of the Resource ID - all other Resource Types are matched
+// by converting the Resource Type into a Terraform Resource Name (see `candidateNames`)
+var resourceTypeOverrides = map[string]string{
+	"microsoft.cache/redis":                                     "azurerm_redis_cache",
+	"microsoft.compute/virtualmachines/extensions":              "azurerm_virtual_machine_extension",
+	"microsoft.containerinstance/containergroups":               "azurerm_container_group",
+	"microsoft.containerservice/managedclusters":                "azurerm_kubernetes_cluster",
+	"microsoft.dbformariadb/servers":                            "azurerm_mariadb_server",
+	"microsoft.dbformariadb/servers/databases":                  "azurerm_mariadb_database",
+	"microsoft.dbformysql/servers":                              "azurerm_mysql_server",
+	"microsoft.dbformysql/servers/databases":                    "azurerm_mysql_database",
+	"microsoft.dbforpostgresql/servers":                         "azurerm_postgresql_server",
+	"microsoft.dbforpostgresql/servers/databases":               "azurerm_postgresql_database",
+	"microsoft.documentdb/databaseaccounts":                     "azurerm_cosmosdb_account",
+	"microsoft.insights/components":                             "azurerm_application_insights",
+	"microsoft.insights/actiongroups":                           "azurerm_monitor_action_group",
+	"microsoft.insights/autoscalesettings":                      "azurerm_monitor_autoscale_setting",
+	"microsoft.insights/metricalerts":                           "azurerm_monitor_metric_alert",
+	"microsoft.network/applicationgateways":                     "azurerm_application_gateway",
+	"microsoft.network/azurefirewalls":                          "azurerm_firewall",
+	"microsoft.network/connections":                             "azurerm_virtual_network_gateway_connection",
+	"microsoft.network/dnszones":                                "azurerm_dns_zone",
+	"microsoft.network/loadbalancers":                           "azurerm_lb",
+	"microsoft.network/loadbalancers/backendaddresspools":       "azurerm_lb_backend_address_pool",
+	"microsoft.network/loadbalancers/inboundnatrules":           "azurerm_lb_nat_rule",
+	"microsoft.network/loadbalancers/loadbalancingrules":        "azurerm_lb_rule",
+	"microsoft.network/loadbalancers/probes":                    "azurerm_lb_probe",
+	"microsoft.network/networksecuritygroups/securityrules":     "azurerm_network_security_rule",
+	"microsoft.network/privatednszones":                         "azurerm_private_dns_zone",
+	"microsoft.network/publicipaddresses":                       "azurerm_public_ip",
+	"microsoft.network/publicipprefixes":                        "azurerm_public_ip_prefix",
+	"microsoft.network/routetables/routes":                      "azurerm_route",
+	"microsoft.operationalinsights/workspaces":                  "azurerm_log_analytics_workspace",
+	"microsoft.operationsmanagement/solutions":                  "azurerm_log_analytics_solution",
+	"microsoft.recoveryservices/vaults":                         "azurerm_recovery_services_vault",
+	"microsoft.servicebus/namespaces/queues":                    "azurerm_servicebus_queue",
+	"microsoft.servicebus/namespaces/topics":                    "azurerm_servicebus_topic",
+	"microsoft.storage/storageaccounts/blobservices/containers": "azurerm_storage_container",
+	"microsoft.web/serverfarms":                                 "azurerm_app_service_plan",
+	"microsoft.web/sites":                                       "azurerm_app_service",
+	"microsoft.web/sites/slots":                                 "azurerm_app_service_slot",
+}
+
+// resourceRegistry maps ARM Resource Types to the Resources supported by the Provider
+type resourceRegistry struct {
+	resources map[string]*schema.Resource
+}
+
+// providerResources returns the Resources registered in the Provider
+func providerResources() map[string]*schema.Resource {
+	return azurerm.Provider().(*schema.Provider).ResourcesMap
+}
+
+func newResourceRegistry(resources map[string]*schema.Resource) resourceRegistry {
+	return resourceRegistry{
+		resources: resources,
+	}
+}
+
+// resourceForType returns the name and schema of the Terraform Resource used to manage the specified
+// ARM Resource Type - or false if the Provider doesn't support importing this Resource Type
+func (r resourceRegistry) resourceForType(resourceType string) (string, *schema.Resource, bool) {
+	names := candidateNames(resourceType)
+	if override, ok := resourceTypeOverrides[strings.ToLower(resourceType)]; ok {
+		names = []string{override}
+	}
+
+	for _, name := range names {
+		resource, ok := r.resources[name]
+		if !ok || resource.Importer == nil {
+			continue
+		}
+
+		return name, resource, true
+	}
+
+	return "", nil, false
+}
+
+// candidateNames returns the possible names for the Terraform Resource used to manage the specified
+// ARM Resource Type, in order of preference - for example `Microsoft.Network/virtualNetworks/subnets`
+// could be `azurerm_subnet`, `azurerm_virtual_network_subnet` or `azurerm_network_subnet`
+func candidateNames(resourceType string) []string {
+	segments := strings.Split(resourceType, "/")
+	if len(segments) < 2 {
+		return []string{}
+	}
+
+	name := singularize(toSnakeCase(segments[len(segments)-1]))
+	candidates := []string{name}
+
+	if len(segments) > 2 {
+		parent := singularize(toSnakeCase(segments[len(segments)-2]))
+		candidates = append(candidates, parent+"_"+name)
+	}
+
+	// the Resource Type can also be repeated in the namespace, e.g. `Microsoft.KeyVault/vaults`
+	namespace := toSnakeCase(strings.TrimPrefix(segments[0], "Microsoft."))
+	if strings.HasSuffix(namespace, "_"+name) {
+		candidates = append(candidates, namespace)
+	}
+	candidates = append(candidates, namespace+"_"+name)
+
+	output := make([]string, 0, len(candidates))
+	for _, v := range candidates {
+		output = append(output, "azurerm_"+v)
+	}
+	return output
+}
+
+// toSnakeCase converts a camelCased string (e.g. `networkSecurityGroups` or `publicIPAddresses`)
+// into snake_case (e.g. `network_security_groups` and `public_ip_addresses`)
+func toSnakeCase(input string) string {
+	runes := []rune(input)
+	output := make([]rune, 0, len(runes))
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			startOfWord := i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1])))
+			if startOfWord {
+				output = append(output, '_')
+			}
+			r = unicode.ToLower(r)
+		}
+
+		if r == '.' || r == '-' {
+			r = '_'
+		}
+
+		output = append(output, r)
+	}
+
+	return string(output)
+}
+
+// singularize returns the singular form of the (plural) ARM Resource Type
+func singularize(input string) string {
+	switch {
+	case strings.HasSuffix(input, "ies"):
+		return strings.TrimSuffix(input, "ies") + "y"
+	case strings.HasSuffix(input, "sses"), strings.HasSuffix(input, "xes"), strings.HasSuffix(input, "ches"):
+		return strings.TrimSuffix(input, "es")
+	case strings.HasSuffix(input, "ss"):
+		return input
+	case strings.HasSuffix(input, "s"):
+		return strings.TrimSuffix(input, "s")
+	}
+
+	return input
+}
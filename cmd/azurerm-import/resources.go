@@ -0,0 +1,288 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// armResource is an Azure Resource which should be imported into Terraform
+type armResource struct {
+	// ID is the Resource ID of this resource
+	ID string
+
+	// Type is the full ARM Resource Type of this resource, e.g. `Microsoft.Network/virtualNetworks/subnets`
+	Type string
+
+	// Segments contains the Type and Name of this resource and each of it's parents,
+	// e.g. `virtualNetworks` -> `example` and `subnets` -> `internal`
+	Segments []idSegment
+
+	ResourceGroupName string
+	Location          string
+	Tags              map[string]string
+	Properties        map[string]interface{}
+}
+
+// Name returns the name of this resource, which is the last segment in the Resource ID
+func (r armResource) Name() string {
+	if len(r.Segments) == 0 {
+		return r.ResourceGroupName
+	}
+
+	return r.Segments[len(r.Segments)-1].Name
+}
+
+type idSegment struct {
+	Type string
+	Name string
+}
+
+// parseResourceID parses a Resource ID into it's Resource Group, Type and Segments, e.g.
+// `/subscriptions/{id}/resourceGroups/{name}/providers/Microsoft.Network/virtualNetworks/{name}`
+func parseResourceID(id string) (*armResource, error) {
+	components := strings.Split(strings.Trim(id, "/"), "/")
+	if len(components) < 4 || !strings.EqualFold(components[0], "subscriptions") || !strings.EqualFold(components[2], "resourceGroups") {
+		return nil, fmt.Errorf("%q is not a Resource ID within a Resource Group", id)
+	}
+
+	resource := armResource{
+		ID:                id,
+		ResourceGroupName: components[3],
+	}
+
+	remaining := components[4:]
+	if len(remaining) == 0 {
+		resource.Type = "Microsoft.Resources/resourceGroups"
+		return &resource, nil
+	}
+
+	if len(remaining) < 4 || !strings.EqualFold(remaining[0], "providers") || len(remaining)%2 != 0 {
+		return nil, fmt.Errorf("%q is not a valid Resource ID", id)
+	}
+
+	resourceTypes := []string{remaining[1]}
+	for i := 2; i < len(remaining); i += 2 {
+		resource.Segments = append(resource.Segments, idSegment{
+			Type: remaining[i],
+			Name: remaining[i+1],
+		})
+		resourceTypes = append(resourceTypes, remaining[i])
+	}
+	resource.Type = strings.Join(resourceTypes, "/")
+
+	return &resource, nil
+}
+
+// buildResourceID returns the Resource ID for a resource of the specified type, for
+// example `Microsoft.Network/virtualNetworks/subnets` with the names `example` and `internal`
+func buildResourceID(subscriptionId, resourceGroupName, resourceType string, names []string) (string, error) {
+	types := strings.Split(strings.Trim(resourceType, "/"), "/")
+	if strings.EqualFold(resourceType, "Microsoft.Resources/resourceGroups") {
+		return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", subscriptionId, resourceGroupName), nil
+	}
+
+	if len(types)-1 != len(names) {
+		return "", fmt.Errorf("the Resource Type %q expects %d names but got %d (%s)", resourceType, len(types)-1, len(names), strings.Join(names, "/"))
+	}
+
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/%s", subscriptionId, resourceGroupName, types[0])
+	for i, name := range names {
+		id += fmt.Sprintf("/%s/%s", types[i+1], name)
+	}
+	return id, nil
+}
+
+// parseResources parses either an ARM Template, or a list of resources (e.g. the output
+// of `az resource list` or the `value` returned from the Resources API) into armResources
+func parseResources(input []byte, subscriptionId, resourceGroupName string) ([]armResource, error) {
+	var raw interface{}
+	if err := json.Unmarshal(input, &raw); err != nil {
+		return nil, fmt.Errorf("parsing JSON: %+v", err)
+	}
+
+	switch v := raw.(type) {
+	case []interface{}:
+		return parseResourceList(v)
+
+	case map[string]interface{}:
+		if items, ok := v["value"].([]interface{}); ok {
+			return parseResourceList(items)
+		}
+
+		if items, ok := v["resources"].([]interface{}); ok {
+			if subscriptionId == "" || resourceGroupName == "" {
+				return nil, fmt.Errorf("the Subscription ID and Resource Group Name must be specified when the input is an ARM Template")
+			}
+
+			ctx := templateContext{
+				Parameters:        parseTemplateParameters(v["parameters"]),
+				Variables:         toMap(v["variables"]),
+				SubscriptionId:    subscriptionId,
+				ResourceGroupName: resourceGroupName,
+			}
+			return parseTemplateResources(ctx, items, "", nil)
+		}
+	}
+
+	return nil, fmt.Errorf("expected either an ARM Template or a list of resources")
+}
+
+func parseResourceList(input []interface{}) ([]armResource, error) {
+	output := make([]armResource, 0)
+
+	for _, item := range input {
+		v := toMap(item)
+
+		id, ok := v["id"].(string)
+		if !ok || id == "" {
+			return nil, fmt.Errorf("expected each resource to have an `id`")
+		}
+
+		resource, err := parseResourceID(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if location, ok := v["location"].(string); ok {
+			resource.Location = location
+		}
+		resource.Tags = toStringMap(v["tags"])
+		resource.Properties = toMap(v["properties"])
+
+		output = append(output, *resource)
+	}
+
+	return output, nil
+}
+
+func parseTemplateParameters(input interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	for k, v := range toMap(input) {
+		if value, ok := toMap(v)["defaultValue"]; ok {
+			output[k] = value
+		}
+	}
+
+	return output
+}
+
+// parseTemplateResources parses the resources defined within an ARM Template - including the nested
+// resources, which have a Type and Name relative to their parent (e.g. `subnets` and `internal`)
+func parseTemplateResources(ctx templateContext, input []interface{}, parentType string, parentNames []string) ([]armResource, error) {
+	output := make([]armResource, 0)
+
+	for _, item := range input {
+		v := toMap(item)
+
+		resourceType, _ := v["type"].(string)
+		rawName, _ := v["name"].(string)
+		if resourceType == "" || rawName == "" {
+			return nil, fmt.Errorf("expected each resource to have a `type` and `name`")
+		}
+
+		name, err := ctx.evaluate(rawName)
+		if err != nil {
+			return nil, err
+		}
+
+		names := append(append([]string{}, parentNames...), strings.Split(name, "/")...)
+		if parentType != "" {
+			resourceType = parentType + "/" + resourceType
+		}
+
+		id, err := buildResourceID(ctx.SubscriptionId, ctx.ResourceGroupName, resourceType, names)
+		if err != nil {
+			return nil, err
+		}
+
+		resource, err := parseResourceID(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if rawLocation, ok := v["location"].(string); ok {
+			// locations which can't be evaluated (e.g. `[resourceGroup().location]`) need to be populated by hand
+			if location, err := ctx.evaluate(rawLocation); err == nil {
+				resource.Location = location
+			}
+		}
+
+		resource.Tags = make(map[string]string)
+		for key, value := range toStringMap(v["tags"]) {
+			if evaluated, err := ctx.evaluate(value); err == nil {
+				resource.Tags[key] = evaluated
+			}
+		}
+
+		resource.Properties = evaluateProperties(ctx, toMap(v["properties"]))
+		output = append(output, *resource)
+
+		if nested, ok := v["resources"].([]interface{}); ok {
+			children, err := parseTemplateResources(ctx, nested, resourceType, names)
+			if err != nil {
+				return nil, err
+			}
+			output = append(output, children...)
+		}
+	}
+
+	return output, nil
+}
+
+// evaluateProperties evaluates the ARM Template Expressions within the properties of a resource,
+// removing any which can't be evaluated (e.g. `reference(...)`)
+func evaluateProperties(ctx templateContext, input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+
+	for k, v := range input {
+		if value, ok := evaluateProperty(ctx, v); ok {
+			output[k] = value
+		}
+	}
+
+	return output
+}
+
+func evaluateProperty(ctx templateContext, input interface{}) (interface{}, bool) {
+	switch v := input.(type) {
+	case string:
+		value, err := ctx.evaluate(v)
+		return value, err == nil
+
+	case map[string]interface{}:
+		return evaluateProperties(ctx, v), true
+
+	case []interface{}:
+		output := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			if value, ok := evaluateProperty(ctx, item); ok {
+				output = append(output, value)
+			}
+		}
+		return output, true
+	}
+
+	return input, true
+}
+
+func toMap(input interface{}) map[string]interface{} {
+	if v, ok := input.(map[string]interface{}); ok {
+		return v
+	}
+
+	return map[string]interface{}{}
+}
+
+func toStringMap(input interface{}) map[string]string {
+	output := make(map[string]string)
+
+	for k, v := range toMap(input) {
+		if value, ok := v.(string); ok {
+			output[k] = value
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// templateContext contains the information required to evaluate the (subset of) ARM Template
+// Expressions used in exported ARM Templates, for example `[parameters('virtualNetworks_example_name')]`
+type templateContext struct {
+	Parameters        map[string]interface{}
+	Variables         map[string]interface{}
+	SubscriptionId    string
+	ResourceGroupName string
+}
+
+// isExpression returns whether the specified value is an ARM Template Expression - values
+// starting with `[[` are escaped literals rather than expressions
+func isExpression(input string) bool {
+	return strings.HasPrefix(input, "[") && strings.HasSuffix(input, "]") && !strings.HasPrefix(input, "[[")
+}
+
+// evaluate returns the value of the specified string, evaluating it if it's an ARM Template Expression
+func (c templateContext) evaluate(input string) (string, error) {
+	if strings.HasPrefix(input, "[[") {
+		return input[1:], nil
+	}
+
+	if !isExpression(input) {
+		return input, nil
+	}
+
+	p := &expressionParser{
+		input: strings.TrimSpace(input[1 : len(input)-1]),
+	}
+	value, err := p.parse(c)
+	if err != nil {
+		return "", fmt.Errorf("evaluating %q: %+v", input, err)
+	}
+
+	if p.pos != len(p.input) {
+		return "", fmt.Errorf("evaluating %q: unexpected %q", input, p.input[p.pos:])
+	}
+
+	return value, nil
+}
+
+func (c templateContext) call(function string, args []string) (string, error) {
+	switch strings.ToLower(function) {
+	case "concat":
+		return strings.Join(args, ""), nil
+
+	case "tolower":
+		if len(args) != 1 {
+			return "", fmt.Errorf("`toLower` expects 1 argument but got %d", len(args))
+		}
+		return strings.ToLower(args[0]), nil
+
+	case "toupper":
+		if len(args) != 1 {
+			return "", fmt.Errorf("`toUpper` expects 1 argument but got %d", len(args))
+		}
+		return strings.ToUpper(args[0]), nil
+
+	case "parameters":
+		if len(args) != 1 {
+			return "", fmt.Errorf("`parameters` expects 1 argument but got %d", len(args))
+		}
+		raw, ok := c.Parameters[args[0]]
+		if !ok {
+			return "", fmt.Errorf("the parameter %q wasn't found or doesn't have a `defaultValue`", args[0])
+		}
+		return c.stringValue(raw)
+
+	case "variables":
+		if len(args) != 1 {
+			return "", fmt.Errorf("`variables` expects 1 argument but got %d", len(args))
+		}
+		raw, ok := c.Variables[args[0]]
+		if !ok {
+			return "", fmt.Errorf("the variable %q wasn't found", args[0])
+		}
+		return c.stringValue(raw)
+
+	case "resourceid":
+		if len(args) < 2 {
+			return "", fmt.Errorf("`resourceId` expects at least 2 arguments but got %d", len(args))
+		}
+		if c.SubscriptionId == "" || c.ResourceGroupName == "" {
+			return "", fmt.Errorf("the Subscription ID and Resource Group Name are required to evaluate `resourceId`")
+		}
+		return buildResourceID(c.SubscriptionId, c.ResourceGroupName, args[0], args[1:])
+	}
+
+	return "", fmt.Errorf("the function %q isn't supported", function)
+}
+
+func (c templateContext) stringValue(input interface{}) (string, error) {
+	switch v := input.(type) {
+	case string:
+		return c.evaluate(v)
+	case float64, bool:
+		return fmt.Sprintf("%v", v), nil
+	}
+
+	return "", fmt.Errorf("expected a string value but got %T", input)
+}
+
+// expressionParser parses the body of an ARM Template Expression, which is made up of
+// function calls (e.g. `concat(...)`) and string literals (e.g. `'example'`)
+type expressionParser struct {
+	input string
+	pos   int
+}
+
+func (p *expressionParser) parse(c templateContext) (string, error) {
+	p.skipWhitespace()
+	if p.pos >= len(p.input) {
+		return "", fmt.Errorf("unexpected end of expression")
+	}
+
+	if p.input[p.pos] == '\'' {
+		return p.parseLiteral()
+	}
+
+	start := p.pos
+	for p.pos < len(p.input) && isIdentifierChar(p.input[p.pos]) {
+		p.pos++
+	}
+	function := p.input[start:p.pos]
+	if function == "" {
+		return "", fmt.Errorf("unexpected %q", p.input[p.pos:])
+	}
+
+	p.skipWhitespace()
+	if p.pos >= len(p.input) || p.input[p.pos] != '(' {
+		return "", fmt.Errorf("expected `(` after %q", function)
+	}
+	p.pos++
+
+	args := make([]string, 0)
+	for {
+		p.skipWhitespace()
+		if p.pos < len(p.input) && p.input[p.pos] == ')' {
+			p.pos++
+			break
+		}
+
+		arg, err := p.parse(c)
+		if err != nil {
+			return "", err
+		}
+		args = append(args, arg)
+
+		p.skipWhitespace()
+		if p.pos >= len(p.input) {
+			return "", fmt.Errorf("unexpected end of expression within %q", function)
+		}
+		if p.input[p.pos] == ',' {
+			p.pos++
+		}
+	}
+
+	// property access (e.g. `resourceGroup().location`) isn't supported
+	if p.pos < len(p.input) && (p.input[p.pos] == '.' || p.input[p.pos] == '[') {
+		return "", fmt.Errorf("accessing properties of %q isn't supported", function)
+	}
+
+	return c.call(function, args)
+}
+
+func (p *expressionParser) parseLiteral() (string, error) {
+	// skip the opening quote
+	p.pos++
+
+	var sb strings.Builder
+	for p.pos < len(p.input) {
+		ch := p.input[p.pos]
+		p.pos++
+
+		if ch != '\'' {
+			sb.WriteByte(ch)
+			continue
+		}
+
+		// quotes are escaped by doubling them
+		if p.pos < len(p.input) && p.input[p.pos] == '\'' {
+			sb.WriteByte('\'')
+			p.pos++
+			continue
+		}
+
+		return sb.String(), nil
+	}
+
+	return "", fmt.Errorf("unterminated string literal")
+}
+
+func (p *expressionParser) skipWhitespace() {
+	for p.pos < len(p.input) && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func isIdentifierChar(ch byte) bool {
+	return ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch >= '0' && ch <= '9' || ch == '_'
+}